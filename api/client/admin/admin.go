@@ -13,6 +13,7 @@ type Admin struct {
 	Data        *Data
 	Records     *Records
 	Indices     *Indices
+	Diagnostics *Diagnostics
 }
 
 // NewAdmin creates a new admin API.
@@ -25,5 +26,6 @@ func NewAdmin(client adminPb.AdminServiceClient) *Admin {
 		Data:        &Data{client: client},
 		Records:     &Records{client: client},
 		Indices:     &Indices{client: client},
+		Diagnostics: &Diagnostics{client: client},
 	}
 }
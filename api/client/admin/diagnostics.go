@@ -0,0 +1,30 @@
+package admin
+
+import (
+	"context"
+
+	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+)
+
+// Diagnostics provides APIs to inspect the raw Powergate state.
+type Diagnostics struct {
+	client adminPb.AdminServiceClient
+}
+
+// ScanKeys returns at most limit datastore keys with the provided prefix,
+// with their value size and a preview of their value. A zero limit uses
+// the server default.
+func (d *Diagnostics) ScanKeys(ctx context.Context, prefix string, limit int) (*adminPb.ScanKeysResponse, error) {
+	req := &adminPb.ScanKeysRequest{
+		Prefix: prefix,
+		Limit:  int64(limit),
+	}
+	return d.client.ScanKeys(ctx, req)
+}
+
+// PreflightMiner runs the preflight checks of a deal with a miner,
+// reporting the result of every check. If the request wallet is empty,
+// the funds check is skipped.
+func (d *Diagnostics) PreflightMiner(ctx context.Context, req *adminPb.PreflightMinerRequest) (*adminPb.PreflightMinerResponse, error) {
+	return d.client.PreflightMiner(ctx, req)
+}
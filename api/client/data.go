@@ -49,6 +49,30 @@ type WatchLogsEvent struct {
 	Err error
 }
 
+// StreamLogsOption is a function that changes a StreamLogsRequest.
+type StreamLogsOption func(r *userPb.StreamLogsRequest)
+
+// WithLogsJobIDFilter filters only streamed log messages of a Cid
+// related to the Job with id jid.
+func WithLogsJobIDFilter(jobID string) StreamLogsOption {
+	return func(r *userPb.StreamLogsRequest) {
+		r.JobId = jobID
+	}
+}
+
+// WithPageSize sets the max number of log entries in each streamed page.
+func WithPageSize(size int64) StreamLogsOption {
+	return func(r *userPb.StreamLogsRequest) {
+		r.PageSize = size
+	}
+}
+
+// StreamLogsEvent represents an event for streaming cid history logs.
+type StreamLogsEvent struct {
+	Res *userPb.StreamLogsResponse
+	Err error
+}
+
 // Stage allows to temporarily stage data in hot storage in preparation for pushing a cid storage config.
 func (d *Data) Stage(ctx context.Context, data io.Reader) (*userPb.StageResponse, error) {
 	stream, err := d.client.Stage(ctx)
@@ -77,6 +101,12 @@ func (d *Data) Stage(ctx context.Context, data io.Reader) (*userPb.StageResponse
 	return stream.CloseAndRecv()
 }
 
+// StageFromCarURL allows to temporarily stage the root of a CAR file hosted at url in hot storage in
+// preparation for pushing a cid storage config. The CAR file is downloaded by the Powergate server.
+func (d *Data) StageFromCarURL(ctx context.Context, url string) (*userPb.StageFromCarURLResponse, error) {
+	return d.client.StageFromCarURL(ctx, &userPb.StageFromCarURLRequest{Url: url})
+}
+
 // StageFolder allows to temporarily stage a folder in hot storage in preparation for pushing a cid storage config.
 func (d *Data) StageFolder(ctx context.Context, ipfsRevProxyAddr string, folderPath string) (string, error) {
 	ffsToken := ctx.Value(AuthKey).(string)
@@ -200,6 +230,36 @@ func (d *Data) WatchLogs(ctx context.Context, ch chan<- WatchLogsEvent, cid stri
 	return nil
 }
 
+// StreamLogs pushes pages of the history logs of a Cid in chronological order.
+// The channel is closed after the last page is received. The provided channel is
+// owned by the method and must not be closed.
+func (d *Data) StreamLogs(ctx context.Context, ch chan<- StreamLogsEvent, cid string, opts ...StreamLogsOption) error {
+	r := &userPb.StreamLogsRequest{Cid: cid}
+	for _, opt := range opts {
+		opt(r)
+	}
+	stream, err := d.client.StreamLogs(ctx, r)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF || status.Code(err) == codes.Canceled {
+				close(ch)
+				break
+			}
+			if err != nil {
+				ch <- StreamLogsEvent{Err: err}
+				close(ch)
+				break
+			}
+			ch <- StreamLogsEvent{Res: res}
+		}
+	}()
+	return nil
+}
+
 // CidSummary gives a summary of the storage and jobs state of the specified cid.
 func (d *Data) CidSummary(ctx context.Context, cids ...string) (*userPb.CidSummaryResponse, error) {
 	return d.client.CidSummary(ctx, &userPb.CidSummaryRequest{Cids: cids})
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	"google.golang.org/grpc"
+)
+
+func TestStreamLogs(t *testing.T) {
+	t.Parallel()
+
+	var full []*userPb.LogEntry
+	for i := 0; i < 10; i++ {
+		full = append(full, &userPb.LogEntry{Cid: "c", Time: int64(i), Message: fmt.Sprintf("entry %d", i)})
+	}
+	uc := &streamLogsClientMock{pages: [][]*userPb.LogEntry{full[:4], full[4:8], full[8:]}}
+	d := &Data{client: uc}
+
+	ch := make(chan StreamLogsEvent)
+	err := d.StreamLogs(context.Background(), ch, "c", WithPageSize(4), WithLogsJobIDFilter("j"))
+	require.NoError(t, err)
+	require.Equal(t, int64(4), uc.req.PageSize)
+	require.Equal(t, "j", uc.req.JobId)
+
+	var streamed []*userPb.LogEntry
+	for e := range ch {
+		require.NoError(t, e.Err)
+		streamed = append(streamed, e.Res.LogEntries...)
+	}
+	require.Equal(t, full, streamed)
+}
+
+type streamLogsClientMock struct {
+	userPb.UserServiceClient
+
+	req   *userPb.StreamLogsRequest
+	pages [][]*userPb.LogEntry
+}
+
+func (m *streamLogsClientMock) StreamLogs(ctx context.Context, in *userPb.StreamLogsRequest, opts ...grpc.CallOption) (userPb.UserService_StreamLogsClient, error) {
+	m.req = in
+	return &streamLogsStreamMock{pages: m.pages}, nil
+}
+
+type streamLogsStreamMock struct {
+	grpc.ClientStream
+
+	pages [][]*userPb.LogEntry
+}
+
+func (s *streamLogsStreamMock) Recv() (*userPb.StreamLogsResponse, error) {
+	if len(s.pages) == 0 {
+		return nil, io.EOF
+	}
+	res := &userPb.StreamLogsResponse{LogEntries: s.pages[0]}
+	s.pages = s.pages[1:]
+	return res, nil
+}
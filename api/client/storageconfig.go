@@ -45,6 +45,15 @@ func WithNoExec(noExec bool) ApplyOption {
 	}
 }
 
+// WithLenient applies only the valid fields of the provided storage config.
+// Invalid fields keep their current value, and are listed in the rejected
+// fields of the response.
+func WithLenient(lenient bool) ApplyOption {
+	return func(r *userPb.ApplyStorageConfigRequest) {
+		r.Lenient = lenient
+	}
+}
+
 // Default returns the default storage config.
 func (s *StorageConfig) Default(ctx context.Context) (*userPb.DefaultStorageConfigResponse, error) {
 	return s.client.DefaultStorageConfig(ctx, &userPb.DefaultStorageConfigRequest{})
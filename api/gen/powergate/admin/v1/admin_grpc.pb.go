@@ -39,6 +39,9 @@ type AdminServiceClient interface {
 	// Indices
 	GetMiners(ctx context.Context, in *GetMinersRequest, opts ...grpc.CallOption) (*GetMinersResponse, error)
 	GetMinerInfo(ctx context.Context, in *GetMinerInfoRequest, opts ...grpc.CallOption) (*GetMinerInfoResponse, error)
+	// Diagnostics
+	ScanKeys(ctx context.Context, in *ScanKeysRequest, opts ...grpc.CallOption) (*ScanKeysResponse, error)
+	PreflightMiner(ctx context.Context, in *PreflightMinerRequest, opts ...grpc.CallOption) (*PreflightMinerResponse, error)
 }
 
 type adminServiceClient struct {
@@ -193,6 +196,24 @@ func (c *adminServiceClient) GetMinerInfo(ctx context.Context, in *GetMinerInfoR
 	return out, nil
 }
 
+func (c *adminServiceClient) ScanKeys(ctx context.Context, in *ScanKeysRequest, opts ...grpc.CallOption) (*ScanKeysResponse, error) {
+	out := new(ScanKeysResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/ScanKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PreflightMiner(ctx context.Context, in *PreflightMinerRequest, opts ...grpc.CallOption) (*PreflightMinerResponse, error) {
+	out := new(PreflightMinerResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/PreflightMiner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -219,6 +240,9 @@ type AdminServiceServer interface {
 	// Indices
 	GetMiners(context.Context, *GetMinersRequest) (*GetMinersResponse, error)
 	GetMinerInfo(context.Context, *GetMinerInfoRequest) (*GetMinerInfoResponse, error)
+	// Diagnostics
+	ScanKeys(context.Context, *ScanKeysRequest) (*ScanKeysResponse, error)
+	PreflightMiner(context.Context, *PreflightMinerRequest) (*PreflightMinerResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -274,6 +298,12 @@ func (UnimplementedAdminServiceServer) GetMiners(context.Context, *GetMinersRequ
 func (UnimplementedAdminServiceServer) GetMinerInfo(context.Context, *GetMinerInfoRequest) (*GetMinerInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMinerInfo not implemented")
 }
+func (UnimplementedAdminServiceServer) ScanKeys(context.Context, *ScanKeysRequest) (*ScanKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanKeys not implemented")
+}
+func (UnimplementedAdminServiceServer) PreflightMiner(context.Context, *PreflightMinerRequest) (*PreflightMinerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreflightMiner not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -575,6 +605,42 @@ func _AdminService_GetMinerInfo_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ScanKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ScanKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/ScanKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ScanKeys(ctx, req.(*ScanKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PreflightMiner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreflightMinerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PreflightMiner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/PreflightMiner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PreflightMiner(ctx, req.(*PreflightMinerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "powergate.admin.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -643,6 +709,14 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMinerInfo",
 			Handler:    _AdminService_GetMinerInfo_Handler,
 		},
+		{
+			MethodName: "ScanKeys",
+			Handler:    _AdminService_ScanKeys_Handler,
+		},
+		{
+			MethodName: "PreflightMiner",
+			Handler:    _AdminService_PreflightMiner_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "powergate/admin/v1/admin.proto",
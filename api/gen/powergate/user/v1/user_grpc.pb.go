@@ -28,9 +28,11 @@ type UserServiceClient interface {
 	// Data
 	Stage(ctx context.Context, opts ...grpc.CallOption) (UserService_StageClient, error)
 	StageCid(ctx context.Context, in *StageCidRequest, opts ...grpc.CallOption) (*StageCidResponse, error)
+	StageFromCarURL(ctx context.Context, in *StageFromCarURLRequest, opts ...grpc.CallOption) (*StageFromCarURLResponse, error)
 	ReplaceData(ctx context.Context, in *ReplaceDataRequest, opts ...grpc.CallOption) (*ReplaceDataResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (UserService_GetClient, error)
 	WatchLogs(ctx context.Context, in *WatchLogsRequest, opts ...grpc.CallOption) (UserService_WatchLogsClient, error)
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (UserService_StreamLogsClient, error)
 	CidSummary(ctx context.Context, in *CidSummaryRequest, opts ...grpc.CallOption) (*CidSummaryResponse, error)
 	CidInfo(ctx context.Context, in *CidInfoRequest, opts ...grpc.CallOption) (*CidInfoResponse, error)
 	// Wallet
@@ -160,6 +162,15 @@ func (c *userServiceClient) StageCid(ctx context.Context, in *StageCidRequest, o
 	return out, nil
 }
 
+func (c *userServiceClient) StageFromCarURL(ctx context.Context, in *StageFromCarURLRequest, opts ...grpc.CallOption) (*StageFromCarURLResponse, error) {
+	out := new(StageFromCarURLResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/StageFromCarURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ReplaceData(ctx context.Context, in *ReplaceDataRequest, opts ...grpc.CallOption) (*ReplaceDataResponse, error) {
 	out := new(ReplaceDataResponse)
 	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/ReplaceData", in, out, opts...)
@@ -233,6 +244,38 @@ func (x *userServiceWatchLogsClient) Recv() (*WatchLogsResponse, error) {
 	return m, nil
 }
 
+func (c *userServiceClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (UserService_StreamLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[3], "/powergate.user.v1.UserService/StreamLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceStreamLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserService_StreamLogsClient interface {
+	Recv() (*StreamLogsResponse, error)
+	grpc.ClientStream
+}
+
+type userServiceStreamLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceStreamLogsClient) Recv() (*StreamLogsResponse, error) {
+	m := new(StreamLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *userServiceClient) CidSummary(ctx context.Context, in *CidSummaryRequest, opts ...grpc.CallOption) (*CidSummaryResponse, error) {
 	out := new(CidSummaryResponse)
 	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/CidSummary", in, out, opts...)
@@ -360,7 +403,7 @@ func (c *userServiceClient) StorageJobsSummary(ctx context.Context, in *StorageJ
 }
 
 func (c *userServiceClient) WatchStorageJobs(ctx context.Context, in *WatchStorageJobsRequest, opts ...grpc.CallOption) (UserService_WatchStorageJobsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[3], "/powergate.user.v1.UserService/WatchStorageJobs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[4], "/powergate.user.v1.UserService/WatchStorageJobs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -433,9 +476,11 @@ type UserServiceServer interface {
 	// Data
 	Stage(UserService_StageServer) error
 	StageCid(context.Context, *StageCidRequest) (*StageCidResponse, error)
+	StageFromCarURL(context.Context, *StageFromCarURLRequest) (*StageFromCarURLResponse, error)
 	ReplaceData(context.Context, *ReplaceDataRequest) (*ReplaceDataResponse, error)
 	Get(*GetRequest, UserService_GetServer) error
 	WatchLogs(*WatchLogsRequest, UserService_WatchLogsServer) error
+	StreamLogs(*StreamLogsRequest, UserService_StreamLogsServer) error
 	CidSummary(context.Context, *CidSummaryRequest) (*CidSummaryResponse, error)
 	CidInfo(context.Context, *CidInfoRequest) (*CidInfoResponse, error)
 	// Wallet
@@ -489,6 +534,9 @@ func (UnimplementedUserServiceServer) Stage(UserService_StageServer) error {
 func (UnimplementedUserServiceServer) StageCid(context.Context, *StageCidRequest) (*StageCidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StageCid not implemented")
 }
+func (UnimplementedUserServiceServer) StageFromCarURL(context.Context, *StageFromCarURLRequest) (*StageFromCarURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StageFromCarURL not implemented")
+}
 func (UnimplementedUserServiceServer) ReplaceData(context.Context, *ReplaceDataRequest) (*ReplaceDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplaceData not implemented")
 }
@@ -498,6 +546,9 @@ func (UnimplementedUserServiceServer) Get(*GetRequest, UserService_GetServer) er
 func (UnimplementedUserServiceServer) WatchLogs(*WatchLogsRequest, UserService_WatchLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchLogs not implemented")
 }
+func (UnimplementedUserServiceServer) StreamLogs(*StreamLogsRequest, UserService_StreamLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
 func (UnimplementedUserServiceServer) CidSummary(context.Context, *CidSummaryRequest) (*CidSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CidSummary not implemented")
 }
@@ -717,6 +768,24 @@ func _UserService_StageCid_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_StageFromCarURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StageFromCarURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).StageFromCarURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/StageFromCarURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StageFromCarURL(ctx, req.(*StageFromCarURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ReplaceData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReplaceDataRequest)
 	if err := dec(in); err != nil {
@@ -777,6 +846,27 @@ func (x *userServiceWatchLogsServer) Send(m *WatchLogsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _UserService_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).StreamLogs(m, &userServiceStreamLogsServer{stream})
+}
+
+type UserService_StreamLogsServer interface {
+	Send(*StreamLogsResponse) error
+	grpc.ServerStream
+}
+
+type userServiceStreamLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceStreamLogsServer) Send(m *StreamLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _UserService_CidSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CidSummaryRequest)
 	if err := dec(in); err != nil {
@@ -1136,6 +1226,10 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StageCid",
 			Handler:    _UserService_StageCid_Handler,
 		},
+		{
+			MethodName: "StageFromCarURL",
+			Handler:    _UserService_StageFromCarURL_Handler,
+		},
 		{
 			MethodName: "ReplaceData",
 			Handler:    _UserService_ReplaceData_Handler,
@@ -1225,6 +1319,11 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _UserService_WatchLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _UserService_StreamLogs_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "WatchStorageJobs",
 			Handler:       _UserService_WatchStorageJobs_Handler,
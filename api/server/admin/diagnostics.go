@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"context"
+
+	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+	"github.com/textileio/powergate/v2/ffs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultScanKeysLimit = 100
+
+// ScanKeys returns raw datastore keys with the provided prefix.
+func (s *Service) ScanKeys(ctx context.Context, req *adminPb.ScanKeysRequest) (*adminPb.ScanKeysResponse, error) {
+	if req.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit can't be negative")
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultScanKeysLimit
+	}
+	kvs, err := s.dg.ScanKeys(req.Prefix, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "scanning keys: %v", err)
+	}
+	res := &adminPb.ScanKeysResponse{
+		Entries: make([]*adminPb.KeyValueMeta, len(kvs)),
+	}
+	for i, kv := range kvs {
+		res.Entries[i] = &adminPb.KeyValueMeta{
+			Key:     kv.Key,
+			Size:    int64(kv.Size),
+			Preview: kv.Preview,
+		}
+	}
+	return res, nil
+}
+
+// PreflightMiner runs the preflight checks of a deal with a miner, the
+// same checks used by miner selectors.
+func (s *Service) PreflightMiner(ctx context.Context, req *adminPb.PreflightMinerRequest) (*adminPb.PreflightMinerResponse, error) {
+	params := ffs.DealParams{
+		Filter: ffs.MinerSelectorFilter{
+			PieceSize:     req.PieceSize,
+			DealDuration:  req.DealDuration,
+			MaxPrice:      req.MaxPrice,
+			VerifiedDeal:  req.VerifiedDeal,
+			FastRetrieval: req.FastRetrieval,
+		},
+		Wallet: req.Wallet,
+	}
+	pr, err := s.pf.PreflightMiner(ctx, req.Miner, params)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "running preflight checks: %v", err)
+	}
+	res := &adminPb.PreflightMinerResponse{
+		Suitable: pr.Suitable(),
+		Checks:   make([]*adminPb.PreflightCheck, len(pr.Checks)),
+	}
+	for i, c := range pr.Checks {
+		res.Checks[i] = &adminPb.PreflightCheck{
+			Name:    c.Name,
+			Skipped: c.Skipped,
+		}
+		if c.Err != nil {
+			res.Checks[i].Error = c.Err.Error()
+		}
+	}
+	return res, nil
+}
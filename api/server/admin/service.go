@@ -3,6 +3,8 @@ package admin
 import (
 	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
 	dealsModule "github.com/textileio/powergate/v2/deals/module"
+	"github.com/textileio/powergate/v2/diagnostics"
+	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/manager"
 	"github.com/textileio/powergate/v2/ffs/scheduler"
 	askIndex "github.com/textileio/powergate/v2/index/ask/runner"
@@ -19,10 +21,12 @@ type Service struct {
 	dm *dealsModule.Module
 	mi *minerIndex.Index
 	ai *askIndex.Runner
+	dg *diagnostics.Diagnostics
+	pf *ffs.Preflight
 }
 
 // New creates a new AdminService.
-func New(m *manager.Manager, s *scheduler.Scheduler, wm wallet.Module, dm *dealsModule.Module, mi *minerIndex.Index, ai *askIndex.Runner) *Service {
+func New(m *manager.Manager, s *scheduler.Scheduler, wm wallet.Module, dm *dealsModule.Module, mi *minerIndex.Index, ai *askIndex.Runner, dg *diagnostics.Diagnostics, pf *ffs.Preflight) *Service {
 	return &Service{
 		m:  m,
 		s:  s,
@@ -30,5 +34,7 @@ func New(m *manager.Manager, s *scheduler.Scheduler, wm wallet.Module, dm *deals
 		dm: dm,
 		mi: mi,
 		ai: ai,
+		dg: dg,
+		pf: pf,
 	}
 }
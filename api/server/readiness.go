@@ -0,0 +1,126 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// warmupPollInterval is the frequency to check if an index is warm.
+	warmupPollInterval = time.Second
+	// warmupTimeout is the maximum time to wait for an index to be warm.
+	// If exceeded, the index is considered warm to avoid being not-ready
+	// forever if it can't be refreshed.
+	warmupTimeout = time.Minute * 10
+)
+
+// Readiness tracks if the server is ready to accept work. Contrary to
+// liveness, which only reports the process is up, the server isn't
+// ready while starting, warming up indices, or draining on shutdown.
+type Readiness struct {
+	lock     sync.Mutex
+	started  bool
+	draining bool
+	warmups  map[string]struct{}
+	stop     chan struct{}
+}
+
+// NewReadiness returns a new Readiness which reports not-ready until
+// the server started.
+func NewReadiness() *Readiness {
+	return &Readiness{
+		warmups: make(map[string]struct{}),
+		stop:    make(chan struct{}),
+	}
+}
+
+// Ready returns nil if the server is ready to accept work, or an error
+// with the reason if not.
+func (r *Readiness) Ready() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.draining {
+		return fmt.Errorf("draining")
+	}
+	if !r.started {
+		return fmt.Errorf("starting")
+	}
+	if len(r.warmups) > 0 {
+		names := make([]string, 0, len(r.warmups))
+		for name := range r.warmups {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("warming up %s", strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// ServeHTTP responds 200 if the server is ready, and 503 otherwise.
+func (r *Readiness) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	if err := r.Ready(); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	_, _ = fmt.Fprintln(w, "ready")
+}
+
+// ServeLiveness responds 200 while the process is up.
+func ServeLiveness(w http.ResponseWriter, _ *http.Request) {
+	_, _ = fmt.Fprintln(w, "ok")
+}
+
+// start marks the server startup as finished.
+func (r *Readiness) start() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.started = true
+}
+
+// drain marks the server as shutting down, which is never ready again.
+func (r *Readiness) drain() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.draining {
+		return
+	}
+	r.draining = true
+	close(r.stop)
+}
+
+// warmup reports not-ready until warm returns true, polled every
+// interval, or the timeout is exceeded.
+func (r *Readiness) warmup(name string, warm func() bool, interval, timeout time.Duration) {
+	if warm() {
+		return
+	}
+	r.lock.Lock()
+	r.warmups[name] = struct{}{}
+	r.lock.Unlock()
+	go func() {
+		defer func() {
+			r.lock.Lock()
+			delete(r.warmups, name)
+			r.lock.Unlock()
+		}()
+		deadline := time.After(timeout)
+		for {
+			select {
+			case <-r.stop:
+				return
+			case <-deadline:
+				log.Warnf("%s didn't warm up after %s, considering it ready", name, timeout)
+				return
+			case <-time.After(interval):
+				if warm() {
+					log.Infof("%s warmed up", name)
+					return
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadiness(t *testing.T) {
+	t.Parallel()
+
+	r := NewReadiness()
+	requireStatus(t, r, http.StatusServiceUnavailable)
+
+	// Not ready until the index is warm, even if started.
+	var warm int32
+	r.warmup("ask index", func() bool { return atomic.LoadInt32(&warm) == 1 }, time.Millisecond*10, time.Minute)
+	r.start()
+	require.EqualError(t, r.Ready(), "warming up ask index")
+	requireStatus(t, r, http.StatusServiceUnavailable)
+
+	atomic.StoreInt32(&warm, 1)
+	require.Eventually(t, func() bool { return r.Ready() == nil }, time.Second, time.Millisecond*10)
+	requireStatus(t, r, http.StatusOK)
+
+	// Never ready again after draining starts.
+	r.drain()
+	require.EqualError(t, r.Ready(), "draining")
+	requireStatus(t, r, http.StatusServiceUnavailable)
+	r.drain()
+}
+
+func TestReadinessWarmupTimeout(t *testing.T) {
+	t.Parallel()
+
+	r := NewReadiness()
+	r.start()
+	r.warmup("miner index", func() bool { return false }, time.Millisecond*10, time.Millisecond*100)
+	require.Error(t, r.Ready())
+	require.Eventually(t, func() bool { return r.Ready() == nil }, time.Second, time.Millisecond*10)
+}
+
+func TestLiveness(t *testing.T) {
+	t.Parallel()
+
+	rec := httptest.NewRecorder()
+	ServeLiveness(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func requireStatus(t *testing.T, r *Readiness, status int) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, status, rec.Code)
+}
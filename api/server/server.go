@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"net"
@@ -33,12 +34,14 @@ import (
 	"github.com/textileio/powergate/v2/api/server/user"
 	"github.com/textileio/powergate/v2/deals"
 	dealsModule "github.com/textileio/powergate/v2/deals/module"
+	"github.com/textileio/powergate/v2/diagnostics"
 	"github.com/textileio/powergate/v2/fchost"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/coreipfs"
 	"github.com/textileio/powergate/v2/ffs/filcold"
 	"github.com/textileio/powergate/v2/ffs/joblogger"
 	"github.com/textileio/powergate/v2/ffs/manager"
+	"github.com/textileio/powergate/v2/ffs/minerselector/dealsettings"
 	"github.com/textileio/powergate/v2/ffs/minerselector/reptop"
 	"github.com/textileio/powergate/v2/ffs/minerselector/sr2"
 	"github.com/textileio/powergate/v2/ffs/scheduler"
@@ -77,6 +80,7 @@ var (
 		3: migration.V3StorageJobsIndexMigration,
 		4: migration.V4RecordsMigration,
 		5: migration.V5DeleteOldMinerIndex,
+		6: migration.V6NormalizeReputationSources,
 	}
 )
 
@@ -84,6 +88,8 @@ var (
 type Server struct {
 	ds datastore.TxnDatastore
 
+	lotusPool *lotus.Pool
+
 	mm *maxmind.MaxMind
 	ai *ask.Runner
 	mi *minerIndex.Index
@@ -96,6 +102,7 @@ type Server struct {
 	sched      *scheduler.Scheduler
 	hs         ffs.HotStorage
 	l          *joblogger.Logger
+	pf         *ffs.Preflight
 
 	grpcServer *grpc.Server
 
@@ -103,6 +110,8 @@ type Server struct {
 
 	gateway     *gateway.Gateway
 	indexServer *http.Server
+
+	readiness *Readiness
 }
 
 // Config specifies server settings.
@@ -116,11 +125,17 @@ type Config struct {
 	LotusAuthToken         string
 	LotusMasterAddr        string
 	LotusConnectionRetries int
+	LotusCallTimeouts      lotus.CallTimeouts
+	LotusReadRetries       lotus.RetryConfig
+	LotusSkipVersionCheck  bool
+	LotusPoolMaxIdle       int
+	LotusPoolIdleTimeout   time.Duration
 
 	GrpcHostNetwork     string
 	GrpcHostAddress     ma.Multiaddr
 	GrpcServerOpts      []grpc.ServerOption
 	GrpcWebProxyAddress string
+	GrpcStreamChunkSize int
 
 	GatewayBasePath      string
 	GatewayHostAddr      string
@@ -137,17 +152,31 @@ type Config struct {
 	FFSMaxParallelDealPreparing  int
 	FFSGCAutomaticGCInterval     time.Duration
 	FFSGCStageGracePeriod        time.Duration
+	FFSJobRetentionMaxAge        time.Duration
+	FFSJobRetentionMaxCount      int
+	FFSJobLogCompression         string
 	SchedMaxParallel             int
 	MinerSelector                string
 	MinerSelectorParams          string
+	MinerSelectorAskUnavailable  string
 	DealWatchPollDuration        time.Duration
+	DealWatchPollBackoffMax      time.Duration
+	DealWatchClients             []string
+	DealsDisableCollateralTopUp  bool
+	DealsMaxConcurrentTransfers  int
+	DealsTransferRestarts        int
+	DealsMinStartOffset          int64
+	DealsTransferStallTimeout    time.Duration
 	AutocreateMasterAddr         bool
 	WalletInitialFunds           big.Int
+	WalletNonceManagement        bool
 
-	AskIndexQueryAskTimeout time.Duration
-	AskindexMaxParallel     int
-	AskIndexRefreshInterval time.Duration
-	AskIndexRefreshOnStart  bool
+	AskIndexQueryAskTimeout   time.Duration
+	AskindexMaxParallel       int
+	AskIndexRefreshInterval   time.Duration
+	AskIndexRefreshOnStart    bool
+	AskIndexSnapshots         bool
+	AskIndexSnapshotRetention time.Duration
 
 	IndexMinersRefreshOnStart     bool
 	IndexMinersOnChainMaxParallel int
@@ -156,6 +185,12 @@ type Config struct {
 	DisableIndices bool
 
 	DisableNonCompliantAPIs bool
+
+	SelfTestStrict bool
+
+	// Readiness reports if the server is ready to accept work. It's
+	// created by the caller to report not-ready since startup.
+	Readiness *Readiness
 }
 
 // NewServer starts and returns a new server with the given configuration.
@@ -164,11 +199,27 @@ func NewServer(conf Config) (*Server, error) {
 		return nil, fmt.Errorf("FFSUseMasterAddr requires LotusMasterAddr or AutocreateMasterAddr to be provided")
 	}
 
+	readiness := conf.Readiness
+	if readiness == nil {
+		readiness = NewReadiness()
+	}
+
 	var err error
-	clientBuilder, err := lotus.NewBuilder(conf.LotusAddress, conf.LotusAuthToken, conf.LotusConnectionRetries)
+	clientBuilder, err := lotus.NewBuilder(
+		conf.LotusAddress,
+		conf.LotusAuthToken,
+		conf.LotusConnectionRetries,
+		lotus.WithCallTimeouts(conf.LotusCallTimeouts),
+		lotus.WithReadRetries(conf.LotusReadRetries),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("creating lotus client builder: %s", err)
 	}
+	var lotusPool *lotus.Pool
+	if conf.LotusPoolMaxIdle > 0 {
+		lotusPool = lotus.NewPool(clientBuilder, lotus.WithMaxIdle(conf.LotusPoolMaxIdle), lotus.WithIdleTimeout(conf.LotusPoolIdleTimeout))
+		clientBuilder = lotusPool.Builder()
+	}
 	lsm, err := lotus.NewSyncMonitor(clientBuilder)
 	if err != nil {
 		return nil, fmt.Errorf("creating lotus sync monitor: %s", err)
@@ -179,6 +230,11 @@ func NewServer(conf Config) (*Server, error) {
 		return nil, fmt.Errorf("connecting to lotus node: %s", err)
 	}
 
+	if err := checkLotusVersion(c, conf.LotusSkipVersionCheck); err != nil {
+		cls()
+		return nil, err
+	}
+
 	masterAddr, err := evaluateMasterAddr(conf, c)
 	if err != nil {
 		return nil, fmt.Errorf("evaluating ffs master addr: %s", err)
@@ -220,11 +276,13 @@ func NewServer(conf Config) (*Server, error) {
 		return nil, fmt.Errorf("opening maxmind database: %s", err)
 	}
 	askIdxConf := ask.Config{
-		Disable:         conf.DisableIndices,
-		QueryAskTimeout: conf.AskIndexQueryAskTimeout,
-		MaxParallel:     conf.AskindexMaxParallel,
-		RefreshInterval: conf.AskIndexRefreshInterval,
-		RefreshOnStart:  conf.Devnet || conf.AskIndexRefreshOnStart,
+		Disable:           conf.DisableIndices,
+		QueryAskTimeout:   conf.AskIndexQueryAskTimeout,
+		MaxParallel:       conf.AskindexMaxParallel,
+		RefreshInterval:   conf.AskIndexRefreshInterval,
+		RefreshOnStart:    conf.Devnet || conf.AskIndexRefreshOnStart,
+		PersistSnapshots:  conf.AskIndexSnapshots,
+		SnapshotRetention: conf.AskIndexSnapshotRetention,
 	}
 	log.Info("Starting ask index...")
 	ai, err := ask.New(txndstr.Wrap(ds, "index/ask"), clientBuilder, askIdxConf)
@@ -244,6 +302,11 @@ func NewServer(conf Config) (*Server, error) {
 		return nil, fmt.Errorf("creating miner index: %s", err)
 	}
 
+	if !conf.DisableIndices {
+		readiness.warmup("ask index", func() bool { return !ai.Get().LastUpdated.IsZero() }, warmupPollInterval, warmupTimeout)
+		readiness.warmup("miner index", func() bool { return mi.Get().OnChain.LastUpdated > 0 }, warmupPollInterval, warmupTimeout)
+	}
+
 	log.Info("Starting faults index...")
 	si, err := faultsModule.New(txndstr.Wrap(ds, "index/faults"), clientBuilder, conf.DisableIndices)
 	if err != nil {
@@ -254,17 +317,19 @@ func NewServer(conf Config) (*Server, error) {
 	}
 
 	log.Info("Starting deals module...")
-	dm, err := dealsModule.New(txndstr.Wrap(ds, "deals"), clientBuilder, conf.DealWatchPollDuration, conf.FFSDealFinalityTimeout, deals.WithImportPath(filepath.Join(conf.RepoPath, "imports")))
+	dm, err := dealsModule.New(txndstr.Wrap(ds, "deals"), clientBuilder, conf.DealWatchPollDuration, conf.FFSDealFinalityTimeout, deals.WithImportPath(filepath.Join(conf.RepoPath, "imports")), deals.WithCollateralTopUp(!conf.DealsDisableCollateralTopUp), deals.WithMinDealStartOffset(conf.DealsMinStartOffset), deals.WithPollBackoff(conf.DealWatchPollBackoffMax), deals.WithMaxConcurrentTransfers(conf.DealsMaxConcurrentTransfers), deals.WithTransferRestarts(conf.DealsTransferRestarts, conf.DealsTransferStallTimeout), deals.WithWatchedClients(conf.DealWatchClients...))
 	if err != nil {
 		return nil, fmt.Errorf("creating deal module: %s", err)
 	}
 
 	log.Info("Starting wallet module...")
-	wm, err := lotusWallet.New(clientBuilder, masterAddr, conf.WalletInitialFunds, conf.AutocreateMasterAddr, networkName)
+	wm, err := lotusWallet.New(clientBuilder, masterAddr, conf.WalletInitialFunds, conf.AutocreateMasterAddr, networkName, lotusWallet.WithNonceManagement(conf.WalletNonceManagement))
 	if err != nil {
 		return nil, fmt.Errorf("creating wallet module: %s", err)
 	}
 	rm := reputation.New(txndstr.Wrap(ds, "reputation"), mi, si, ai)
+	dsc := dealsettings.New(clientBuilder, dealsettings.DefaultTTL)
+	pf := ffs.NewPreflight(dsc, dsc, wm)
 
 	ipfs, err := httpapi.NewApi(conf.IpfsAPIAddr)
 	if err != nil {
@@ -278,7 +343,11 @@ func NewServer(conf Config) (*Server, error) {
 		return nil, fmt.Errorf("creating miner selector: %s", err)
 	}
 
-	l := joblogger.New(txndstr.Wrap(ds, "ffs/joblogger_v2"))
+	jlc, err := joblogger.ParseCompression(conf.FFSJobLogCompression)
+	if err != nil {
+		return nil, fmt.Errorf("parsing job log compression: %s", err)
+	}
+	l := joblogger.New(txndstr.Wrap(ds, "ffs/joblogger_v2"), joblogger.WithCompression(jlc))
 	if conf.Devnet {
 		conf.FFSMinimumPieceSize = 0
 	}
@@ -293,8 +362,13 @@ func NewServer(conf Config) (*Server, error) {
 	if ms, ok := ms.(*sr2.MinerSelector); ok {
 		sr2rf = ms.GetReplicationFactor
 	}
-	gcConfig := scheduler.GCConfig{StageGracePeriod: conf.FFSGCStageGracePeriod, AutoGCInterval: conf.FFSGCAutomaticGCInterval}
-	sched, err := scheduler.New(txndstr.Wrap(ds, "ffs/scheduler"), l, hs, cs, conf.SchedMaxParallel, conf.FFSDealFinalityTimeout, sr2rf, gcConfig)
+	gcConfig := scheduler.GCConfig{
+		StageGracePeriod:     conf.FFSGCStageGracePeriod,
+		AutoGCInterval:       conf.FFSGCAutomaticGCInterval,
+		JobRetentionMaxAge:   conf.FFSJobRetentionMaxAge,
+		JobRetentionMaxCount: conf.FFSJobRetentionMaxCount,
+	}
+	sched, err := scheduler.New(txndstr.Wrap(ds, "ffs/scheduler"), l, hs, cs, dm, conf.SchedMaxParallel, conf.FFSDealFinalityTimeout, sr2rf, gcConfig)
 	if err != nil {
 		return nil, fmt.Errorf("creating scheduler: %s", err)
 	}
@@ -304,6 +378,10 @@ func NewServer(conf Config) (*Server, error) {
 		return nil, fmt.Errorf("creating ffs instance: %s", err)
 	}
 
+	if err := runSelfTest(ffsManager, sched, dm, mi, conf.SelfTestStrict); err != nil {
+		return nil, err
+	}
+
 	log.Info("Starting gRPC, gateway and index HTTP servers...")
 
 	unaryInterceptors := []grpc.UnaryServerInterceptor{adminAuth(conf)}
@@ -327,6 +405,8 @@ func NewServer(conf Config) (*Server, error) {
 	s := &Server{
 		ds: ds,
 
+		lotusPool: lotusPool,
+
 		mm: mm,
 
 		ai: ai,
@@ -340,18 +420,22 @@ func NewServer(conf Config) (*Server, error) {
 		sched:      sched,
 		hs:         hs,
 		l:          l,
+		pf:         pf,
 
 		grpcServer: grpcServer,
 		webProxy:   webProxy,
 		gateway:    gateway,
+
+		readiness: readiness,
 	}
 
-	if err := startGRPCServices(grpcServer, webProxy, s, conf.GrpcHostNetwork, conf.GrpcHostAddress); err != nil {
+	if err := startGRPCServices(grpcServer, webProxy, s, conf.GrpcHostNetwork, conf.GrpcHostAddress, conf.GrpcStreamChunkSize); err != nil {
 		return nil, fmt.Errorf("starting GRPC services: %s", err)
 	}
 
 	s.indexServer = startIndexHTTPServer(s, conf.IndexRawJSONHostAddr)
 
+	readiness.start()
 	log.Info("Starting finished, serving requests")
 
 	return s, nil
@@ -431,9 +515,9 @@ func wrapGRPCServer(grpcServer *grpc.Server) *grpcweb.WrappedGrpcServer {
 	return wrappedServer
 }
 
-func startGRPCServices(server *grpc.Server, webProxy *http.Server, s *Server, hostNetwork string, hostAddress ma.Multiaddr) error {
-	userService := user.New(s.ffsManager, s.wm, s.hs)
-	adminService := admin.New(s.ffsManager, s.sched, s.wm, s.dm, s.mi, s.ai)
+func startGRPCServices(server *grpc.Server, webProxy *http.Server, s *Server, hostNetwork string, hostAddress ma.Multiaddr, streamChunkSize int) error {
+	userService := user.New(s.ffsManager, s.wm, s.hs, user.WithStreamChunkSize(streamChunkSize))
+	adminService := admin.New(s.ffsManager, s.sched, s.wm, s.dm, s.mi, s.ai, diagnostics.New(s.ds), s.pf)
 
 	hostAddr, err := util.TCPAddrFromMultiAddr(hostAddress)
 	if err != nil {
@@ -504,71 +588,114 @@ func startIndexHTTPServer(s *Server, addr string) *http.Server {
 	return srv
 }
 
-// Close shuts down the server.
-func (s *Server) Close() {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
-
-	if err := s.indexServer.Shutdown(ctx); err != nil {
-		log.Errorf("closing down index server: %s", err)
-	}
-
-	log.Info("closing gRPC endpoints...")
-	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
-	if err := s.webProxy.Shutdown(ctx); err != nil {
-		log.Errorf("closing down proxy: %s", err)
-	}
-	stopped := make(chan struct{})
-	go func() {
-		s.grpcServer.GracefulStop()
-		close(stopped)
-	}()
-	t := time.NewTimer(10 * time.Second)
-	select {
-	case <-t.C:
-		s.grpcServer.Stop()
-	case <-stopped:
-		t.Stop()
-	}
-	log.Info("gRPC endpoints closed")
+// shutdownGraph lists the server modules with the modules they use.
+// Modules without a close func, like the miner selector, are listed so
+// the modules they use are kept open while their users are closing.
+var shutdownGraph = []struct {
+	name string
+	deps []string
+}{
+	{"index server", []string{"ask index", "miner index", "faults index"}},
+	{"grpc proxy", []string{"grpc server", "ffs manager"}},
+	{"grpc server", []string{"ffs manager", "ffs scheduler", "hot storage", "wallet module", "deal module", "ask index", "miner index", "datastore", "lotus client pool"}},
+	{"gateway", []string{"ask index", "miner index", "faults index", "reputation module"}},
+	{"ffs manager", []string{"ffs scheduler", "wallet module", "deal module", "datastore"}},
+	{"ffs scheduler", []string{"joblogger", "hot storage", "cold storage", "deal module", "datastore"}},
+	{"hot storage", []string{"joblogger", "datastore"}},
+	{"cold storage", []string{"miner selector", "deal module", "wallet module", "joblogger", "lotus client pool"}},
+	{"miner selector", []string{"reputation module", "ask index", "lotus client pool"}},
+	{"joblogger", []string{"datastore"}},
+	{"deal module", []string{"lotus client pool", "datastore"}},
+	{"wallet module", []string{"lotus client pool"}},
+	{"reputation module", []string{"ask index", "miner index", "faults index", "datastore"}},
+	{"ask index", []string{"lotus client pool", "datastore"}},
+	{"miner index", []string{"maxmind", "lotus client pool", "datastore"}},
+	{"faults index", []string{"lotus client pool", "datastore"}},
+	{"datastore", nil},
+	{"maxmind", nil},
+	{"lotus client pool", nil},
+}
 
-	if err := s.ffsManager.Close(); err != nil {
-		log.Errorf("closing ffs manager: %s", err)
-	}
-	if err := s.sched.Close(); err != nil {
-		log.Errorf("closing ffs scheduler: %s", err)
-	}
-	if err := s.l.Close(); err != nil {
-		log.Errorf("closing joblogger: %s", err)
-	}
-	if err := s.dm.Close(); err != nil {
-		log.Errorf("closing deal module: %s", err)
-	}
-	if err := s.rm.Close(); err != nil {
-		log.Errorf("closing reputation module: %s", err)
-	}
-	if err := s.ai.Close(); err != nil {
-		log.Errorf("closing ask index: %s", err)
-	}
-	if err := s.mi.Close(); err != nil {
-		log.Errorf("closing miner index: %s", err)
-	}
-	if err := s.fi.Close(); err != nil {
-		log.Errorf("closing faults index: %s", err)
+// closers returns the close func of every module in shutdownGraph.
+func (s *Server) closers() map[string]func() error {
+	nop := func() error { return nil }
+	return map[string]func() error{
+		"index server": func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			return s.indexServer.Shutdown(ctx)
+		},
+		"grpc proxy": func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			return s.webProxy.Shutdown(ctx)
+		},
+		"grpc server": func() error {
+			stopped := make(chan struct{})
+			go func() {
+				s.grpcServer.GracefulStop()
+				close(stopped)
+			}()
+			t := time.NewTimer(10 * time.Second)
+			select {
+			case <-t.C:
+				s.grpcServer.Stop()
+			case <-stopped:
+				t.Stop()
+			}
+			return nil
+		},
+		"gateway":           func() error { return s.gateway.Stop() },
+		"ffs manager":       func() error { return s.ffsManager.Close() },
+		"ffs scheduler":     func() error { return s.sched.Close() },
+		"hot storage":       nop,
+		"cold storage":      nop,
+		"miner selector":    nop,
+		"joblogger":         func() error { return s.l.Close() },
+		"deal module":       func() error { return s.dm.Close() },
+		"wallet module":     nop,
+		"reputation module": func() error { return s.rm.Close() },
+		"ask index":         func() error { return s.ai.Close() },
+		"miner index":       func() error { return s.mi.Close() },
+		"faults index":      func() error { return s.fi.Close() },
+		"datastore":         func() error { return s.ds.Close() },
+		"maxmind":           func() error { return s.mm.Close() },
+		"lotus client pool": func() error {
+			if s.lotusPool == nil {
+				return nil
+			}
+			return s.lotusPool.Close()
+		},
 	}
+}
 
-	log.Info("closing datastore...")
-	if err := s.ds.Close(); err != nil {
-		log.Errorf("closing datastore: %s", err)
+// newShutdownSequence returns the shutdown sequence of the server
+// modules.
+func (s *Server) newShutdownSequence(timeout time.Duration) (*shutdownSequence, error) {
+	closers := s.closers()
+	ss := newShutdownSequence(timeout)
+	for _, m := range shutdownGraph {
+		c, ok := closers[m.name]
+		if !ok {
+			return nil, fmt.Errorf("module %s doesn't have a close func", m.name)
+		}
+		ss.add(m.name, c, m.deps...)
 	}
-	log.Info("datastore closed")
+	return ss, nil
+}
 
-	if err := s.gateway.Stop(); err != nil {
-		log.Errorf("closing gateway: %s", err)
+// Close shuts down the server. The server reports not-ready while
+// draining. Modules are closed after every module
+// using them, so no module is used after it's closed.
+func (s *Server) Close() {
+	s.readiness.drain()
+	ss, err := s.newShutdownSequence(shutdownStageTimeout)
+	if err != nil {
+		log.Errorf("creating shutdown sequence: %s", err)
+		return
 	}
-	if err := s.mm.Close(); err != nil {
-		log.Errorf("closing maxmind: %s", err)
+	if err := ss.run(); err != nil {
+		log.Errorf("shutting down: %s", err)
 	}
 }
 
@@ -608,17 +735,20 @@ func createDatastore(conf Config, longTimeout bool) (datastore.TxnDatastore, err
 }
 
 func getMinerSelector(conf Config, rm *reputation.Module, ai *ask.Runner, cb lotus.ClientBuilder) (ffs.MinerSelector, error) {
+	unavailable, err := ffs.ParseAskUnavailablePolicy(conf.MinerSelectorAskUnavailable)
+	if err != nil {
+		return nil, fmt.Errorf("parsing ask unavailable policy: %s", err)
+	}
 	if conf.Devnet {
-		return reptop.New(cb, rm, ai), nil
+		return reptop.New(cb, rm, ai, reptop.WithAskUnavailablePolicy(unavailable)), nil
 	}
 	var ms ffs.MinerSelector
-	var err error
 
 	switch conf.MinerSelector {
 	case "reputation":
-		ms = reptop.New(cb, rm, ai)
+		ms = reptop.New(cb, rm, ai, reptop.WithAskUnavailablePolicy(unavailable))
 	case "sr2":
-		ms, err = sr2.New(conf.MinerSelectorParams, cb)
+		ms, err = sr2.New(conf.MinerSelectorParams, cb, sr2.WithAskUnavailablePolicy(unavailable))
 		if err != nil {
 			return nil, fmt.Errorf("creating sr2 miner selector: %s", err)
 		}
@@ -629,6 +759,36 @@ func getMinerSelector(conf Config, rm *reputation.Module, ai *ask.Runner, cb lot
 	return ms, nil
 }
 
+// checkLotusVersion verifies the Lotus node API version is supported. If skip
+// is true, an incompatible version is only logged as a warning.
+func checkLotusVersion(c *api.FullNodeStruct, skip bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	v, err := lotus.CheckAPIVersion(ctx, c, lotus.SupportedAPIVersions)
+	if errors.Is(err, lotus.ErrIncompatibleVersion) && skip {
+		log.Warnf("%s; compatibility check skipped, calls may fail", err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("checking lotus version: %s", err)
+	}
+	log.Infof("connected to lotus %s with api version %s", v.Version, v.APIVersion)
+	return nil
+}
+
+// runSelfTest verifies the consistency of the persisted state. Violations
+// are only logged, unless strict is true.
+func runSelfTest(il diagnostics.InstanceLister, jl diagnostics.JobLister, dl diagnostics.DealLister, mi diagnostics.MinerIndex, strict bool) error {
+	vs := diagnostics.SelfTest(
+		diagnostics.JobInstancesCheck(il, jl),
+		diagnostics.DealMinersCheck(dl, mi),
+	)
+	if len(vs) > 0 && strict {
+		return fmt.Errorf("self-test found %d consistency violations", len(vs))
+	}
+	return nil
+}
+
 func evaluateMasterAddr(conf Config, c *api.FullNodeStruct) (address.Address, error) {
 	var res address.Address
 	if conf.Devnet {
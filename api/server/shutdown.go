@@ -0,0 +1,129 @@
+package server
+
+import (
+	"fmt"
+	"time"
+)
+
+// shutdownStageTimeout is the maximum time to wait for a module to close.
+// If exceeded, the shutdown continues with the next module.
+var shutdownStageTimeout = time.Second * 30
+
+// shutdownStage closes a module which depends on other modules.
+type shutdownStage struct {
+	name  string
+	close func() error
+	deps  []string
+}
+
+// shutdownSequence closes modules in dependency order: a module is
+// closed only after every module depending on it was closed.
+type shutdownSequence struct {
+	stages  []shutdownStage
+	timeout time.Duration
+}
+
+func newShutdownSequence(timeout time.Duration) *shutdownSequence {
+	return &shutdownSequence{timeout: timeout}
+}
+
+// add registers a module with its close func and the names of the
+// modules it uses.
+func (ss *shutdownSequence) add(name string, close func() error, deps ...string) {
+	ss.stages = append(ss.stages, shutdownStage{name: name, close: close, deps: deps})
+}
+
+// order returns the stages sorted so dependents are before their
+// dependencies. Independent stages keep their registration order.
+func (ss *shutdownSequence) order() ([]shutdownStage, error) {
+	byName := make(map[string]int, len(ss.stages))
+	for i, s := range ss.stages {
+		if _, ok := byName[s.name]; ok {
+			return nil, fmt.Errorf("duplicated module %s", s.name)
+		}
+		byName[s.name] = i
+	}
+	// dependents counts the modules using each module
+	// which aren't closed yet.
+	dependents := make([]int, len(ss.stages))
+	for _, s := range ss.stages {
+		for _, d := range s.deps {
+			i, ok := byName[d]
+			if !ok {
+				return nil, fmt.Errorf("module %s depends on unknown module %s", s.name, d)
+			}
+			dependents[i]++
+		}
+	}
+
+	ret := make([]shutdownStage, 0, len(ss.stages))
+	done := make([]bool, len(ss.stages))
+	for len(ret) < len(ss.stages) {
+		next := -1
+		for i := range ss.stages {
+			if !done[i] && dependents[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			return nil, fmt.Errorf("modules have cyclic dependencies")
+		}
+		done[next] = true
+		ret = append(ret, ss.stages[next])
+		for _, d := range ss.stages[next].deps {
+			dependents[byName[d]]--
+		}
+	}
+	return ret, nil
+}
+
+// run closes every module in dependency order. Errors are logged, and
+// don't stop closing the rest of the modules. If a module times out
+// closing, it may still be using its dependencies, so they're left open.
+func (ss *shutdownSequence) run() error {
+	stages, err := ss.order()
+	if err != nil {
+		return fmt.Errorf("ordering shutdown: %s", err)
+	}
+	// unfinished are the modules which didn't finish closing, or
+	// weren't closed since a dependent didn't.
+	unfinished := make(map[string]bool)
+	for _, s := range stages {
+		if blocker, ok := ss.unfinishedDependent(s.name, unfinished); ok {
+			log.Errorf("not closing %s since %s didn't finish closing", s.name, blocker)
+			unfinished[s.name] = true
+			continue
+		}
+		log.Infof("closing %s...", s.name)
+		errCh := make(chan error, 1)
+		go func(s shutdownStage) { errCh <- s.close() }(s)
+		select {
+		case err := <-errCh:
+			if err != nil {
+				log.Errorf("closing %s: %s", s.name, err)
+				continue
+			}
+			log.Infof("%s closed", s.name)
+		case <-time.After(ss.timeout):
+			log.Errorf("closing %s timed out after %s", s.name, ss.timeout)
+			unfinished[s.name] = true
+		}
+	}
+	return nil
+}
+
+// unfinishedDependent returns an unfinished module depending on name.
+func (ss *shutdownSequence) unfinishedDependent(name string, unfinished map[string]bool) (string, bool) {
+	for _, s := range ss.stages {
+		if !unfinished[s.name] {
+			continue
+		}
+		for _, d := range s.deps {
+			if d == name {
+				return s.name, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShutdownOrder(t *testing.T) {
+	t.Parallel()
+
+	var lock sync.Mutex
+	var closed []string
+	isClosed := map[string]bool{}
+	deps := map[string][]string{
+		"grpc":      {"scheduler", "deals"},
+		"scheduler": {"deals", "datastore"},
+		"deals":     {"watcher", "datastore"},
+		"watcher":   {},
+		"datastore": {},
+	}
+	ss := newShutdownSequence(time.Second)
+	// Registration order is unrelated to dependencies.
+	for _, name := range []string{"datastore", "watcher", "deals", "scheduler", "grpc"} {
+		name := name
+		ss.add(name, func() error {
+			lock.Lock()
+			defer lock.Unlock()
+			// A module is closed while its dependencies are still usable.
+			for _, d := range deps[name] {
+				if isClosed[d] {
+					return fmt.Errorf("%s used after %s was closed", name, d)
+				}
+			}
+			isClosed[name] = true
+			closed = append(closed, name)
+			return nil
+		}, deps[name]...)
+	}
+	require.NoError(t, ss.run())
+	require.Equal(t, []string{"grpc", "scheduler", "deals", "datastore", "watcher"}, closed)
+}
+
+func TestShutdownStageTimeout(t *testing.T) {
+	t.Parallel()
+
+	var lock sync.Mutex
+	var closed []string
+	record := func(name string) func() error {
+		return func() error {
+			lock.Lock()
+			defer lock.Unlock()
+			closed = append(closed, name)
+			return nil
+		}
+	}
+	block := make(chan struct{})
+	defer close(block)
+	ss := newShutdownSequence(time.Millisecond * 100)
+	ss.add("stuck", func() error { <-block; return nil }, "deals")
+	ss.add("failing", func() error { return fmt.Errorf("boom") }, "maxmind")
+	ss.add("deals", record("deals"), "datastore")
+	ss.add("datastore", record("datastore"))
+	ss.add("maxmind", record("maxmind"))
+
+	start := time.Now()
+	require.NoError(t, ss.run())
+	require.Less(t, int64(time.Since(start)), int64(time.Second))
+	// Stuck or failing stages don't stop the shutdown, but the
+	// dependencies of a stuck stage may still be in use, so they're
+	// left open.
+	require.Equal(t, []string{"maxmind"}, closed)
+}
+
+func TestShutdownInvalidDependencies(t *testing.T) {
+	t.Parallel()
+
+	ss := newShutdownSequence(time.Second)
+	ss.add("a", func() error { return nil }, "missing")
+	require.Error(t, ss.run())
+
+	ss = newShutdownSequence(time.Second)
+	ss.add("a", func() error { return nil }, "b")
+	ss.add("b", func() error { return nil }, "a")
+	require.Error(t, ss.run())
+}
+
+func TestServerShutdownGraph(t *testing.T) {
+	t.Parallel()
+
+	// Every module of the graph has a close func.
+	closers := (&Server{}).closers()
+	require.Len(t, closers, len(shutdownGraph))
+	_, err := (&Server{}).newShutdownSequence(time.Second)
+	require.NoError(t, err)
+
+	var lock sync.Mutex
+	var closed []string
+	isClosed := map[string]bool{}
+	deps := map[string][]string{}
+	for _, m := range shutdownGraph {
+		deps[m.name] = m.deps
+	}
+	ss := newShutdownSequence(time.Second)
+	for _, m := range shutdownGraph {
+		name := m.name
+		ss.add(name, func() error {
+			lock.Lock()
+			defer lock.Unlock()
+			for _, d := range deps[name] {
+				if isClosed[d] {
+					return fmt.Errorf("%s used after %s was closed", name, d)
+				}
+			}
+			isClosed[name] = true
+			closed = append(closed, name)
+			return nil
+		}, m.deps...)
+	}
+	require.NoError(t, ss.run())
+	require.Len(t, closed, len(shutdownGraph))
+
+	pos := make(map[string]int, len(closed))
+	for i, name := range closed {
+		pos[name] = i
+	}
+	for _, d := range []string{"reputation module", "ask index", "miner selector", "hot storage", "cold storage", "deal module", "joblogger", "datastore"} {
+		require.Less(t, pos["ffs scheduler"], pos[d], "scheduler closed after %s", d)
+	}
+	require.Less(t, pos["grpc server"], pos["ffs manager"])
+	require.Less(t, pos["miner selector"], pos["reputation module"])
+}
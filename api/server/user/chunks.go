@@ -0,0 +1,57 @@
+package user
+
+import "fmt"
+
+// DefaultStreamChunkSize is the default size of the data chunks sent in
+// each message of streaming responses.
+const DefaultStreamChunkSize = 32 * 1024
+
+// MaxStreamChunkSize is the max size of the data chunks sent in each
+// message of streaming responses. Messages must fit the default 4MiB max
+// receive size of gRPC clients, including the message encoding overhead.
+const MaxStreamChunkSize = 4*1024*1024 - 1024
+
+// chunkWriter is an io.Writer which coalesces written bytes into chunks
+// of a fixed size, so tiny writes aren't sent as tiny messages. Only the
+// last chunk, sent by Flush, may be smaller.
+type chunkWriter struct {
+	buf  []byte
+	send func([]byte) error
+}
+
+func newChunkWriter(size int, send func([]byte) error) *chunkWriter {
+	return &chunkWriter{
+		buf:  make([]byte, 0, size),
+		send: send,
+	}
+}
+
+// Write buffers p, sending every filled chunk.
+func (cw *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := copy(cw.buf[len(cw.buf):cap(cw.buf)], p)
+		cw.buf = cw.buf[:len(cw.buf)+n]
+		p = p[n:]
+		written += n
+		if len(cw.buf) == cap(cw.buf) {
+			if err := cw.Flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// Flush sends the buffered bytes, if any.
+func (cw *chunkWriter) Flush() error {
+	if len(cw.buf) == 0 {
+		return nil
+	}
+	if err := cw.send(cw.buf); err != nil {
+		return fmt.Errorf("sending chunk: %s", err)
+	}
+	// Messages are serialized when sent, so the buffer can be reused.
+	cw.buf = cw.buf[:0]
+	return nil
+}
@@ -0,0 +1,104 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"strconv"
+	"testing"
+	"testing/iotest"
+
+	"github.com/ipfs/go-car"
+	"github.com/ipfs/go-cid"
+	dag "github.com/ipfs/go-merkledag"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamChunks(t *testing.T) {
+	t.Parallel()
+
+	root, data := newCar(t)
+	for _, size := range []int{1000, 4096, 32 * 1024, len(data) * 2} {
+		size := size
+		t.Run(strconv.Itoa(size), func(t *testing.T) {
+			t.Parallel()
+
+			var msgs [][]byte
+			send := func(chunk []byte) error {
+				msgs = append(msgs, append([]byte(nil), chunk...))
+				return nil
+			}
+			// Tiny reads are coalesced into chunks of the configured size.
+			require.NoError(t, streamChunks(iotest.OneByteReader(bytes.NewReader(data)), size, send))
+			require.NotEmpty(t, msgs)
+			for _, m := range msgs[:len(msgs)-1] {
+				require.Len(t, m, size)
+			}
+			require.LessOrEqual(t, len(msgs[len(msgs)-1]), size)
+			require.NotEmpty(t, msgs[len(msgs)-1])
+
+			reassembled := bytes.Join(msgs, nil)
+			require.Equal(t, data, reassembled)
+			cr, err := car.NewCarReader(bytes.NewReader(reassembled))
+			require.NoError(t, err)
+			require.Equal(t, []cid.Cid{root}, cr.Header.Roots)
+			blocks := 0
+			for {
+				_, err := cr.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				blocks++
+			}
+			require.Equal(t, 101, blocks)
+		})
+	}
+}
+
+func TestStreamChunksSendError(t *testing.T) {
+	t.Parallel()
+
+	send := func([]byte) error { return fmt.Errorf("stream closed") }
+	err := streamChunks(bytes.NewReader(make([]byte, 100)), 10, send)
+	require.Error(t, err)
+}
+
+func newCar(t *testing.T) (cid.Cid, []byte) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+	ds := dstest.Mock()
+
+	root := &dag.ProtoNode{}
+	for i := 0; i < 100; i++ {
+		b := make([]byte, 1000)
+		_, _ = r.Read(b)
+		leaf := dag.NewRawNode(b)
+		require.NoError(t, ds.Add(ctx, leaf))
+		require.NoError(t, root.AddNodeLink(strconv.Itoa(i), leaf))
+	}
+	require.NoError(t, ds.Add(ctx, root))
+
+	var buf bytes.Buffer
+	require.NoError(t, car.WriteCar(ctx, ds, []cid.Cid{root.Cid()}, &buf))
+	return root.Cid(), buf.Bytes()
+}
+
+func TestWithStreamChunkSize(t *testing.T) {
+	t.Parallel()
+
+	for size, expected := range map[int]int{
+		-1:                     DefaultStreamChunkSize,
+		0:                      DefaultStreamChunkSize,
+		1000:                   1000,
+		MaxStreamChunkSize:     MaxStreamChunkSize,
+		MaxStreamChunkSize + 1: MaxStreamChunkSize,
+		64 * 1024 * 1024:       MaxStreamChunkSize,
+	} {
+		s := New(nil, nil, nil, WithStreamChunkSize(size))
+		require.Equal(t, expected, s.streamChunkSize)
+	}
+}
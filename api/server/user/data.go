@@ -15,6 +15,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// defaultLogsPageSize is the number of log entries per page sent by
+// StreamLogs if the request doesn't specify one.
+const defaultLogsPageSize = 100
+
 // Stage allows to stage-pin a stream of data in Hot-Storage in preparation for pushing a storage configuration.
 func (s *Service) Stage(srv userPb.UserService_StageServer) error {
 	// check that an API instance exists so not just anyone can add data to the hot layer
@@ -61,6 +65,27 @@ func (s *Service) StageCid(ctx context.Context, req *userPb.StageCidRequest) (*u
 	return &userPb.StageCidResponse{}, nil
 }
 
+// StageFromCarURL allows to stage-pin the root of a CAR file downloaded from a URL in Hot-Storage in
+// preparation for pushing a storage configuration.
+func (s *Service) StageFromCarURL(ctx context.Context, req *userPb.StageFromCarURLRequest) (*userPb.StageFromCarURLResponse, error) {
+	// check that an API instance exists so not just anyone can add data to the hot layer
+	fapi, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Url == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is empty")
+	}
+
+	c, err := s.hot.StageFromCarURL(ctx, fapi.ID(), req.Url)
+	if err != nil {
+		return nil, fmt.Errorf("staging car from url in hot-storage: %s", err)
+	}
+
+	return &userPb.StageFromCarURLResponse{Cid: util.CidToString(c)}, nil
+}
+
 // ReplaceData calls ffs.Replace.
 func (s *Service) ReplaceData(ctx context.Context, req *userPb.ReplaceDataRequest) (*userPb.ReplaceDataResponse, error) {
 	i, err := s.getInstanceByToken(ctx)
@@ -100,19 +125,19 @@ func (s *Service) Get(req *userPb.GetRequest, srv userPb.UserService_GetServer)
 		return err
 	}
 
-	buffer := make([]byte, 1024*32)
-	for {
-		bytesRead, err := r.Read(buffer)
-		if err != nil && err != io.EOF {
-			return err
-		}
-		if sendErr := srv.Send(&userPb.GetResponse{Chunk: buffer[:bytesRead]}); sendErr != nil {
-			return sendErr
-		}
-		if err == io.EOF {
-			return nil
-		}
+	return streamChunks(r, s.streamChunkSize, func(chunk []byte) error {
+		return srv.Send(&userPb.GetResponse{Chunk: chunk})
+	})
+}
+
+// streamChunks sends the data of r in chunks of the provided size. Reads
+// are coalesced, so chunks are smaller only at the end of the data.
+func streamChunks(r io.Reader, size int, send func([]byte) error) error {
+	cw := newChunkWriter(size, send)
+	if _, err := io.Copy(cw, r); err != nil {
+		return err
 	}
+	return cw.Flush()
 }
 
 // WatchLogs returns a stream of human-readable messages related to executions of a Cid.
@@ -157,6 +182,55 @@ func (s *Service) WatchLogs(req *userPb.WatchLogsRequest, srv userPb.UserService
 	return nil
 }
 
+// StreamLogs returns a stream of pages of the history logs of a Cid in
+// chronological order. The stream ends after the last page is sent.
+func (s *Service) StreamLogs(req *userPb.StreamLogsRequest, srv userPb.UserService_StreamLogsServer) error {
+	i, err := s.getInstanceByToken(srv.Context())
+	if err != nil {
+		return err
+	}
+
+	var opts []api.GetLogsOption
+	if req.JobId != ffs.EmptyJobID.String() {
+		opts = append(opts, api.WithJidFilter(ffs.JobID(req.JobId)))
+	}
+	pageSize := defaultLogsPageSize
+	if req.PageSize > 0 {
+		pageSize = int(req.PageSize)
+	}
+
+	c, err := util.CidFromString(req.Cid)
+	if err != nil {
+		return err
+	}
+	ch := make(chan []ffs.LogEntry)
+	go func() {
+		err = i.StreamLogs(srv.Context(), ch, c, pageSize, opts...)
+		close(ch)
+	}()
+	for page := range ch {
+		reply := &userPb.StreamLogsResponse{
+			LogEntries: make([]*userPb.LogEntry, len(page)),
+		}
+		for j, l := range page {
+			reply.LogEntries[j] = &userPb.LogEntry{
+				Cid:     util.CidToString(c),
+				JobId:   l.Jid.String(),
+				Time:    l.Timestamp.Unix(),
+				Message: l.Msg,
+			}
+		}
+		if err := srv.Send(reply); err != nil {
+			return err
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // CidSummary gives a summary of the storage and jobs state of the specified cid.
 func (s *Service) CidSummary(ctx context.Context, req *userPb.CidSummaryRequest) (*userPb.CidSummaryResponse, error) {
 	i, err := s.getInstanceByToken(ctx)
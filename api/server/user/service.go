@@ -25,15 +25,40 @@ type Service struct {
 	m   *manager.Manager
 	w   wallet.Module
 	hot ffs.HotStorage
+
+	streamChunkSize int
+}
+
+// Option configures a Service.
+type Option func(*Service)
+
+// WithStreamChunkSize configures the size of the data chunks sent in each
+// message of streaming responses. Non-positive values use
+// DefaultStreamChunkSize, and values over MaxStreamChunkSize are capped.
+func WithStreamChunkSize(size int) Option {
+	return func(s *Service) {
+		if size > MaxStreamChunkSize {
+			size = MaxStreamChunkSize
+		}
+		if size > 0 {
+			s.streamChunkSize = size
+		}
+	}
 }
 
 // New creates a new powergate Service.
-func New(m *manager.Manager, w wallet.Module, hot ffs.HotStorage) *Service {
-	return &Service{
+func New(m *manager.Manager, w wallet.Module, hot ffs.HotStorage, opts ...Option) *Service {
+	s := &Service{
 		m:   m,
 		w:   w,
 		hot: hot,
+
+		streamChunkSize: DefaultStreamChunkSize,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // BuildInfo returns information about the powergate build.
@@ -2,11 +2,14 @@ package user
 
 import (
 	"context"
+	"errors"
 
 	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/api"
 	"github.com/textileio/powergate/v2/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // DefaultStorageConfig calls ffs.DefaultStorageConfig.
@@ -71,14 +74,23 @@ func (s *Service) ApplyStorageConfig(ctx context.Context, req *userPb.ApplyStora
 	if req.NoExec {
 		options = append(options, api.WithNoExec(true))
 	}
+	var rejected ffs.FieldErrors
+	if req.Lenient {
+		options = append(options, api.WithLenient(&rejected))
+	}
 
 	jid, err := i.PushStorageConfig(c, options...)
+	var fieldErrs ffs.FieldErrors
+	if errors.As(err, &fieldErrs) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid storage config: %s", fieldErrs)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &userPb.ApplyStorageConfigResponse{
-		JobId: jid.String(),
+		JobId:          jid.String(),
+		RejectedFields: toRPCFieldErrors(rejected),
 	}, nil
 }
 
@@ -43,6 +43,14 @@ func toRPCColdConfig(config ffs.ColdConfig) *userPb.ColdConfig {
 			FastRetrieval:   config.Filecoin.FastRetrieval,
 			DealStartOffset: config.Filecoin.DealStartOffset,
 			VerifiedDeal:    config.Filecoin.VerifiedDeal,
+
+			DealAcceptanceTimeout:          config.Filecoin.DealAcceptanceTimeout,
+			MinVerifiedReplicas:            int64(config.Filecoin.MinVerifiedReplicas),
+			AcceptanceMaxPrice:             config.Filecoin.Acceptance.MaxPrice,
+			AcceptanceRequireVerified:      config.Filecoin.Acceptance.RequireVerified,
+			AcceptanceRequireFastRetrieval: config.Filecoin.Acceptance.RequireFastRetrieval,
+			AcceptanceMinDuration:          config.Filecoin.Acceptance.MinDuration,
+			AcceptanceMaxMinPieceSize:      config.Filecoin.Acceptance.MaxMinPieceSize,
 		},
 	}
 }
@@ -79,6 +87,16 @@ func fromRPCColdConfig(config *userPb.ColdConfig) ffs.ColdConfig {
 				FastRetrieval:   config.Filecoin.FastRetrieval,
 				DealStartOffset: config.Filecoin.DealStartOffset,
 				VerifiedDeal:    config.Filecoin.VerifiedDeal,
+
+				DealAcceptanceTimeout: config.Filecoin.DealAcceptanceTimeout,
+				MinVerifiedReplicas:   int(config.Filecoin.MinVerifiedReplicas),
+				Acceptance: ffs.AcceptancePolicy{
+					MaxPrice:             config.Filecoin.AcceptanceMaxPrice,
+					RequireVerified:      config.Filecoin.AcceptanceRequireVerified,
+					RequireFastRetrieval: config.Filecoin.AcceptanceRequireFastRetrieval,
+					MinDuration:          config.Filecoin.AcceptanceMinDuration,
+					MaxMinPieceSize:      config.Filecoin.AcceptanceMaxMinPieceSize,
+				},
 			}
 			if config.Filecoin.Renew != nil {
 				renew := ffs.FilRenew{
@@ -93,6 +111,17 @@ func fromRPCColdConfig(config *userPb.ColdConfig) ffs.ColdConfig {
 	return res
 }
 
+func toRPCFieldErrors(errs ffs.FieldErrors) []*userPb.FieldError {
+	res := make([]*userPb.FieldError, len(errs))
+	for i, e := range errs {
+		res[i] = &userPb.FieldError{
+			Field:  e.Field,
+			Reason: e.Reason,
+		}
+	}
+	return res
+}
+
 func buildListDealRecordsOptions(conf *userPb.DealRecordsConfig) []deals.DealRecordsOption {
 	var opts []deals.DealRecordsOption
 	if conf != nil {
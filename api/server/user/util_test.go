@@ -0,0 +1,50 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+func TestColdConfigRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	cfg := ffs.ColdConfig{
+		Enabled: true,
+		Filecoin: ffs.FilConfig{
+			RepFactor:             3,
+			DealMinDuration:       1000,
+			Addr:                  "f3client",
+			VerifiedDeal:          true,
+			FastRetrieval:         true,
+			DealAcceptanceTimeout: 600,
+			MinVerifiedReplicas:   2,
+			Renew:                 ffs.FilRenew{Enabled: true, Threshold: 100},
+			Acceptance: ffs.AcceptancePolicy{
+				MaxPrice:             10,
+				RequireVerified:      true,
+				RequireFastRetrieval: true,
+				MinDuration:          500,
+				MaxMinPieceSize:      1 << 20,
+			},
+		},
+	}
+	require.Equal(t, cfg, fromRPCColdConfig(toRPCColdConfig(cfg)))
+}
+
+func TestToRPCFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	errs := ffs.FieldErrors{
+		{Field: "Cold.Filecoin.RepFactor", Reason: "replication factor should be greater than zero"},
+		{Field: "Hot.Ipfs.AddTimeout", Reason: "add timeout should be greater than zero"},
+	}
+	res := toRPCFieldErrors(errs)
+	require.Len(t, res, 2)
+	for i, e := range errs {
+		require.Equal(t, e.Field, res[i].Field)
+		require.Equal(t, e.Reason, res[i].Reason)
+	}
+	require.Empty(t, toRPCFieldErrors(nil))
+}
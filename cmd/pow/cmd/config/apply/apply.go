@@ -28,6 +28,7 @@ func init() {
 	Cmd.Flags().BoolP("noexec", "e", false, "If set, it doesn't create a job to ensure the new configuration")
 	Cmd.Flags().BoolP("watch", "w", false, "Watch the progress of the resulting job")
 	Cmd.Flags().StringSliceP("import-deals", "i", nil, "Comma-separated list of deal ids to import")
+	Cmd.Flags().Bool("lenient", false, "If set, only the valid fields of the provided storage config are applied, and the rejected ones are listed")
 }
 
 // Cmd is the command.
@@ -86,6 +87,10 @@ var Cmd = &cobra.Command{
 			options = append(options, client.WithNoExec(viper.GetBool("noexec")))
 		}
 
+		if viper.IsSet("lenient") {
+			options = append(options, client.WithLenient(viper.GetBool("lenient")))
+		}
+
 		if viper.IsSet("import-deals") {
 			csvDealIDs := viper.GetStringSlice("import-deals")
 			var dealIDs []uint64
@@ -2,7 +2,6 @@ package getdefault
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -11,6 +10,10 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
+func init() {
+	c.AddExportFlags(Cmd)
+}
+
 // Cmd is the command.
 var Cmd = &cobra.Command{
 	Use:   "default",
@@ -30,6 +33,6 @@ var Cmd = &cobra.Command{
 		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res.DefaultStorageConfig)
 		c.CheckErr(err)
 
-		fmt.Println(string(json))
+		c.CheckErr(c.WriteExport(cmd, json))
 	},
 }
@@ -14,10 +14,12 @@ import (
 	"github.com/spf13/viper"
 	c "github.com/textileio/powergate/v2/cmd/pow/common"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 func init() {
 	Cmd.Flags().String("ipfsrevproxy", "127.0.0.1:6002", "Powergate IPFS reverse proxy multiaddr")
+	Cmd.Flags().Bool("car", false, "The url is a CAR file downloaded by the Powergate server, and its root is staged")
 }
 
 // Cmd is the command.
@@ -35,6 +37,12 @@ var Cmd = &cobra.Command{
 		defer cancel()
 
 		if strings.HasPrefix(strings.ToLower(args[0]), "http") {
+			if viper.GetBool("car") {
+				res, err := c.PowClient.Data.StageFromCarURL(c.MustAuthCtx(ctx), args[0])
+				c.CheckErr(err)
+				printResponse(res)
+				return
+			}
 			res, err := http.DefaultClient.Get(args[0])
 			c.CheckErr(err)
 			defer func() { c.CheckErr(res.Body.Close()) }()
@@ -65,7 +73,10 @@ var Cmd = &cobra.Command{
 func stageReader(ctx context.Context, reader io.Reader) {
 	res, err := c.PowClient.Data.Stage(c.MustAuthCtx(ctx), reader)
 	c.CheckErr(err)
+	printResponse(res)
+}
 
+func printResponse(res proto.Message) {
 	json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
 	c.CheckErr(err)
 
@@ -2,7 +2,6 @@ package retrievals
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -16,6 +15,7 @@ func init() {
 	Cmd.Flags().StringSlice("cids", []string{}, "limit the records to deals for the specified data cids")
 	Cmd.Flags().StringSlice("addrs", []string{}, "limit the records to deals initiated from  the specified wallet addresses")
 	Cmd.Flags().BoolP("include-failed", "e", false, "include failed retrievals")
+	c.AddExportFlags(Cmd)
 }
 
 // Cmd is the command.
@@ -52,6 +52,6 @@ var Cmd = &cobra.Command{
 		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
 		c.CheckErr(err)
 
-		fmt.Println(string(json))
+		c.CheckErr(c.WriteExport(cmd, json))
 	},
 }
@@ -2,7 +2,6 @@ package storage
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -18,6 +17,7 @@ func init() {
 	Cmd.Flags().BoolP("include-pending", "p", false, "include pending deals")
 	Cmd.Flags().BoolP("include-final", "f", false, "include final deals")
 	Cmd.Flags().BoolP("include-failed", "e", false, "include failed deals")
+	c.AddExportFlags(Cmd)
 }
 
 // Cmd is the command.
@@ -60,6 +60,6 @@ var Cmd = &cobra.Command{
 		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
 		c.CheckErr(err)
 
-		fmt.Println(string(json))
+		c.CheckErr(c.WriteExport(cmd, json))
 	},
 }
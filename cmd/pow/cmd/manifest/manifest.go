@@ -0,0 +1,76 @@
+package manifest
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"github.com/textileio/powergate/v2/manifest"
+)
+
+func init() {
+	Cmd.AddCommand(keygen, verify)
+
+	verify.Flags().String("signer", "", "hex-encoded public key of the expected signer of the manifest")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "manifest",
+	Short: "Provides commands to sign and verify export manifests",
+	Long: `Provides commands to sign and verify export manifests.
+
+Exports written with the --manifest-key flag have a manifest next to them, with the hashes
+of the exported files signed with the key.`,
+}
+
+var keygen = &cobra.Command{
+	Use:   "keygen [key path]",
+	Short: "Generates a key to sign export manifests",
+	Long:  `Generates a key to sign export manifests, and prints its public key to share with recipients of exports.`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		key, err := manifest.GenerateKey()
+		c.CheckErr(err)
+		c.CheckErr(manifest.SaveKey(args[0], key))
+		c.Success("Public key: %s", hex.EncodeToString(key.Public().(ed25519.PublicKey)))
+	},
+}
+
+var verify = &cobra.Command{
+	Use:   "verify [export path]",
+	Short: "Verifies the manifest of an export",
+	Long:  `Verifies the manifest of an export is signed by the expected signer, and that the exported files weren't modified.`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		signerHex, err := cmd.Flags().GetString("signer")
+		c.CheckErr(err)
+		if signerHex == "" {
+			c.Fatal(errors.New("the --signer flag is required"))
+		}
+		signer, err := hex.DecodeString(strings.TrimSpace(signerHex))
+		if err != nil || len(signer) != ed25519.PublicKeySize {
+			c.Fatal(fmt.Errorf("signer should be a hex-encoded public key of %d bytes", ed25519.PublicKeySize))
+		}
+
+		path := strings.TrimSuffix(args[0], manifest.Suffix)
+		m, err := manifest.ReadFile(path + manifest.Suffix)
+		c.CheckErr(err)
+		c.CheckErr(m.Verify(signer, manifest.DirOpener(filepath.Dir(path))))
+		c.Success("The export was signed by %s and wasn't modified", signerHex)
+	},
+}
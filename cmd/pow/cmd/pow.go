@@ -13,6 +13,7 @@ import (
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/data"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/deals"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/id"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/manifest"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/prepare"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/storageinfo"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/storagejobs"
@@ -27,7 +28,7 @@ func init() {
 	Cmd.PersistentFlags().String("serverAddress", "127.0.0.1:5002", "address of the powergate service api")
 	Cmd.PersistentFlags().StringP("token", "t", "", "user auth token")
 
-	Cmd.AddCommand(admin.Cmd, config.Cmd, data.Cmd, deals.Cmd, id.Cmd, storageinfo.Cmd, storagejobs.Cmd, version.Cmd, wallet.Cmd, docsCmd, prepare.Cmd)
+	Cmd.AddCommand(admin.Cmd, config.Cmd, data.Cmd, deals.Cmd, id.Cmd, manifest.Cmd, storageinfo.Cmd, storagejobs.Cmd, version.Cmd, wallet.Cmd, docsCmd, prepare.Cmd)
 }
 
 func initConfig() {
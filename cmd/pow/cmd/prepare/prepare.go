@@ -3,6 +3,7 @@ package prepare
 import (
 	"bufio"
 	"context"
+	"crypto/ed25519"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -33,6 +34,7 @@ import (
 	"github.com/spf13/viper"
 	c "github.com/textileio/powergate/v2/cmd/pow/common"
 	"github.com/textileio/powergate/v2/dataprep"
+	"github.com/textileio/powergate/v2/manifest"
 )
 
 func init() {
@@ -42,6 +44,9 @@ func init() {
 	prepare.Flags().String("ipfs-api", "", "IPFS HTTP API multiaddress that stores the cid (only for Cid processing instead of file/folder path)")
 	prepare.Flags().Bool("json", false, "avoid pretty output and use json formatting")
 	prepare.Flags().Bool("aggregate", false, "aggregates a folder of files")
+	prepare.Flags().Int("max-buffered-blocks", dataprep.DefaultMaxBufferedBlocks, "maximum number of blocks kept in memory while importing data")
+	prepare.Flags().Int("max-buffered-bytes", dataprep.DefaultMaxBufferedBytes, "maximum size in bytes of the blocks kept in memory while importing data")
+	c.AddManifestKeyFlag(prepare)
 
 	commp.Flags().Bool("json", false, "avoid pretty output and use json formatting")
 	commp.Flags().Bool("skip-car-validation", false, "skips CAR validation when processing a path")
@@ -50,6 +55,10 @@ func init() {
 	genCar.Flags().String("ipfs-api", "", "IPFS HTTP API multiaddress that stores the cid (only for Cid processing instead of file/folder path)")
 	genCar.Flags().Bool("quiet", false, "avoid pretty output")
 	genCar.Flags().Bool("aggregate", false, "aggregates a folder of files")
+	genCar.Flags().Int("max-buffered-blocks", dataprep.DefaultMaxBufferedBlocks, "maximum number of blocks kept in memory while importing data")
+	genCar.Flags().Int("max-buffered-bytes", dataprep.DefaultMaxBufferedBytes, "maximum size in bytes of the blocks kept in memory while importing data")
+	genCar.Flags().Bool("estimate", false, "only prints the size of the CAR file without generating it")
+	c.AddManifestKeyFlag(genCar)
 }
 
 // Cmd is the command.
@@ -76,6 +85,32 @@ If a Cid is provided, an extra --ipfs-api flag should be provided to connect to
 		var err error
 		ctx := context.Background()
 
+		estimate, err := cmd.Flags().GetBool("estimate")
+		if err != nil {
+			c.Fatal(fmt.Errorf("parsing estimate flag: %s", err))
+		}
+		if estimate {
+			root, dagService, _, cls, err := prepareDAGService(cmd, args, true)
+			if err != nil {
+				c.Fatal(fmt.Errorf("creating dag-service: %s", err))
+			}
+			defer func() { _ = cls() }()
+			size, err := dataprep.EstimateCarSize(ctx, dagService, []cid.Cid{root})
+			if err != nil {
+				c.Fatal(fmt.Errorf("estimating car file size: %s", err))
+			}
+			c.Message("The CAR file size is %s (%d bytes)", humanize.IBytes(uint64(size)), size)
+			return
+		}
+
+		manifestKey, err := c.ManifestKey(cmd)
+		if err != nil {
+			c.Fatal(fmt.Errorf("loading manifest key: %s", err))
+		}
+		if manifestKey != nil && len(args) < 2 {
+			c.Fatal(errors.New("the --manifest-key flag requires an output path"))
+		}
+
 		w := os.Stdout
 		if len(args) == 2 {
 			w, err = os.OpenFile(args[1], os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0755)
@@ -88,6 +123,7 @@ If a Cid is provided, an extra --ipfs-api flag should be provided to connect to
 				}
 			}()
 		}
+		out, mb := signedOutput(w, args, manifestKey)
 
 		quiet, err := cmd.Flags().GetBool("quiet")
 		if err != nil {
@@ -99,9 +135,21 @@ If a Cid is provided, an extra --ipfs-api flag should be provided to connect to
 		}
 		defer func() { _ = cls() }()
 
-		if err = car.WriteCar(ctx, dagService, []cid.Cid{dataCid}, w); err != nil {
+		// Progress is only reported if the CAR file isn't written to stdout.
+		progress := func(int64, int) {}
+		if len(args) == 2 && !quiet {
+			progress = func(bytesWritten int64, nodesWritten int) {
+				c.Message("Written %d nodes (%s)...", nodesWritten, humanize.IBytes(uint64(bytesWritten)))
+			}
+		}
+		if err = dataprep.WriteCarWithProgress(ctx, dagService, []cid.Cid{dataCid}, out, progress); err != nil {
 			c.Fatal(fmt.Errorf("generating car file: %s", err))
 		}
+		if mb != nil {
+			if err := c.WriteManifest(mb, manifestKey, args[1]); err != nil {
+				c.Fatal(fmt.Errorf("writing manifest: %s", err))
+			}
+		}
 	},
 }
 
@@ -201,6 +249,13 @@ The piece-size and piece-cid are printed to stderr. For scripting usage, its rec
 		if ipfsAPI != "" && aggregate {
 			c.Fatal(errors.New("the --aggregate flag can't be used with a remote go-ipfs node"))
 		}
+		manifestKey, err := c.ManifestKey(cmd)
+		if err != nil {
+			c.Fatal(fmt.Errorf("loading manifest key: %s", err))
+		}
+		if manifestKey != nil && len(args) < 2 {
+			c.Fatal(errors.New("the --manifest-key flag requires an output path"))
+		}
 
 		payloadCid, dagService, aggrFiles, cls, err := prepareDAGService(cmd, args, json)
 		if err != nil {
@@ -254,7 +309,8 @@ The piece-size and piece-cid are printed to stderr. For scripting usage, its rec
 			defer wg.Done()
 			pieceCid, pieceSize, errCommP = dataprep.CommP(prCommP)
 		}()
-		if _, err := io.Copy(outputFile, teeCAR); err != nil {
+		out, mb := signedOutput(outputFile, args, manifestKey)
+		if _, err := io.Copy(out, teeCAR); err != nil {
 			c.Fatal(fmt.Errorf("writing CAR file to output: %s", err))
 		}
 		if writeCarErr != nil {
@@ -268,6 +324,12 @@ The piece-size and piece-cid are printed to stderr. For scripting usage, its rec
 			c.Fatal(fmt.Errorf("calculating piece-size and PieceCID: %s", err))
 		}
 
+		if mb != nil {
+			if err := c.WriteManifest(mb, manifestKey, args[1]); err != nil {
+				c.Fatal(fmt.Errorf("writing manifest: %s", err))
+			}
+		}
+
 		if aggregate {
 			rootNd, err := dagService.Get(ctx, payloadCid)
 			if err != nil {
@@ -312,6 +374,21 @@ The piece-size and piece-cid are printed to stderr. For scripting usage, its rec
 	},
 }
 
+// signedOutput returns the writer of the CAR file to the output file. If
+// key isn't nil, the returned builder hashes the CAR file while it's
+// written, to sign its manifest.
+func signedOutput(w io.Writer, args []string, key ed25519.PrivateKey) (io.Writer, *manifest.Builder) {
+	if key == nil {
+		return w, nil
+	}
+	mb := manifest.NewBuilder()
+	mw, err := mb.Writer(filepath.Base(args[1]), w)
+	if err != nil {
+		c.Fatal(fmt.Errorf("creating manifest: %s", err))
+	}
+	return mw, mb
+}
+
 type closeFunc func() error
 
 func prepareDAGService(cmd *cobra.Command, args []string, quiet bool) (cid.Cid, ipld.DAGService, []aggregatedFile, closeFunc, error) {
@@ -334,7 +411,15 @@ func prepareDAGService(cmd *cobra.Command, args []string, quiet bool) (cid.Cid,
 		if err != nil {
 			return cid.Undef, nil, nil, nil, fmt.Errorf("creating temporary dag-service: %s", err)
 		}
-		dataCid, aggregatedFiles, err := dagify(context.Background(), dagService, path, quiet, aggregate)
+		maxBufferedBlocks, err := cmd.Flags().GetInt("max-buffered-blocks")
+		if err != nil {
+			return cid.Undef, nil, nil, nil, fmt.Errorf("getting max buffered blocks: %s", err)
+		}
+		maxBufferedBytes, err := cmd.Flags().GetInt("max-buffered-bytes")
+		if err != nil {
+			return cid.Undef, nil, nil, nil, fmt.Errorf("getting max buffered bytes: %s", err)
+		}
+		dataCid, aggregatedFiles, err := dagify(context.Background(), dagService, path, quiet, aggregate, dataprep.WithMaxBufferedBlocks(maxBufferedBlocks), dataprep.WithMaxBufferedBytes(maxBufferedBytes))
 		if err != nil {
 			return cid.Undef, nil, nil, nil, fmt.Errorf("creating dag for data: %s", err)
 		}
@@ -406,7 +491,7 @@ func printJSONResult(pieceSize uint64, payloadCid, pieceCID cid.Cid, aggrFiles [
 	fmt.Fprint(jsonOutput, string(out))
 }
 
-func dagify(ctx context.Context, dagService ipld.DAGService, path string, quiet bool, aggregate bool) (cid.Cid, []aggregatedFile, error) {
+func dagify(ctx context.Context, dagService ipld.DAGService, path string, quiet bool, aggregate bool, opts ...dataprep.DagifyOption) (cid.Cid, []aggregatedFile, error) {
 	var progressChan chan int64
 	if !quiet {
 		f, err := os.Open(path)
@@ -460,7 +545,7 @@ func dagify(ctx context.Context, dagService ipld.DAGService, path string, quiet
 	var dataCid cid.Cid
 	var err error
 	if !aggregate {
-		dataCid, err = dataprep.Dagify(ctx, dagService, path, progressChan)
+		dataCid, err = dataprep.Dagify(ctx, dagService, path, progressChan, opts...)
 		if err != nil {
 			return cid.Undef, nil, fmt.Errorf("creating dag for data: %s", err)
 		}
@@ -473,7 +558,7 @@ func dagify(ctx context.Context, dagService ipld.DAGService, path string, quiet
 			if info.IsDir() {
 				return nil
 			}
-			dcid, err := dataprep.Dagify(ctx, dagService, p, progressChan)
+			dcid, err := dataprep.Dagify(ctx, dagService, p, progressChan, opts...)
 			if err != nil {
 				return err
 			}
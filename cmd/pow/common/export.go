@@ -0,0 +1,84 @@
+package common
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/manifest"
+)
+
+// AddExportFlags adds the flags of commands exporting data: --out to
+// write the export to a file, and --manifest-key to sign it.
+func AddExportFlags(cmd *cobra.Command) {
+	cmd.Flags().String("out", "", "path of the file where the export is written, instead of stdout")
+	AddManifestKeyFlag(cmd)
+}
+
+// AddManifestKeyFlag adds the --manifest-key flag to a command exporting
+// data to a file.
+func AddManifestKeyFlag(cmd *cobra.Command) {
+	cmd.Flags().String("manifest-key", "", "path of the key file to sign a manifest of the exported file, written next to it with the "+manifest.Suffix+" suffix")
+}
+
+// ManifestKey returns the key of the --manifest-key flag, or nil if the
+// flag isn't set.
+func ManifestKey(cmd *cobra.Command) (ed25519.PrivateKey, error) {
+	path, err := cmd.Flags().GetString("manifest-key")
+	if err != nil {
+		return nil, fmt.Errorf("getting manifest-key flag: %s", err)
+	}
+	if path == "" {
+		return nil, nil
+	}
+	return manifest.LoadKey(path)
+}
+
+// WriteExport writes data to the file of the --out flag, or to stdout if
+// the flag isn't set. If the --manifest-key flag is set, a manifest of
+// the file signed with the key is written next to it.
+func WriteExport(cmd *cobra.Command, data []byte) error {
+	key, err := ManifestKey(cmd)
+	if err != nil {
+		return err
+	}
+	out, err := cmd.Flags().GetString("out")
+	if err != nil {
+		return fmt.Errorf("getting out flag: %s", err)
+	}
+	if out == "" {
+		if key != nil {
+			return fmt.Errorf("the --manifest-key flag requires the --out flag")
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if err := ioutil.WriteFile(out, data, 0644); err != nil {
+		return fmt.Errorf("writing export file: %s", err)
+	}
+	if key == nil {
+		return nil
+	}
+	b := manifest.NewBuilder()
+	w, err := b.Writer(filepath.Base(out), ioutil.Discard)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("hashing export: %s", err)
+	}
+	return WriteManifest(b, key, out)
+}
+
+// WriteManifest signs the manifest of the parts added to b with key, and
+// writes it next to the export file at path.
+func WriteManifest(b *manifest.Builder, key ed25519.PrivateKey, path string) error {
+	m, err := b.Sign(key)
+	if err != nil {
+		return fmt.Errorf("signing manifest: %s", err)
+	}
+	return m.WriteFile(path + manifest.Suffix)
+}
@@ -23,7 +23,9 @@ import (
 	"github.com/spf13/viper"
 	metricsOpenTelemetry "github.com/textileio/go-metrics-opentelemetry"
 	"github.com/textileio/powergate/v2/api/server"
+	"github.com/textileio/powergate/v2/api/server/user"
 	"github.com/textileio/powergate/v2/buildinfo"
+	"github.com/textileio/powergate/v2/lotus"
 	"github.com/textileio/powergate/v2/util"
 	"go.opentelemetry.io/contrib/instrumentation/runtime"
 	"go.opentelemetry.io/otel/attribute"
@@ -71,6 +73,11 @@ func main() {
 	}
 	log.Infof("%s", confJSON)
 
+	// Readiness is served since startup, so it's created before the server.
+	conf.Readiness = server.NewReadiness()
+	http.Handle("/readyz", conf.Readiness)
+	http.HandleFunc("/livez", server.ServeLiveness)
+
 	// Start server.
 	log.Info("starting server...")
 	powd, err := server.NewServer(conf)
@@ -116,13 +123,29 @@ func configFromFlags() (server.Config, error) {
 		return server.Config{}, fmt.Errorf("parsing lotus api multiaddr: %s", err)
 	}
 
+	lotusCallTimeouts, err := lotus.ParseCallTimeouts(config.GetString("lotuscalltimeouts"))
+	if err != nil {
+		return server.Config{}, fmt.Errorf("parsing lotus call timeouts: %s", err)
+	}
+
 	walletInitialFunds := *big.NewInt(config.GetInt64("walletinitialfund"))
 	ipfsAPIAddr := util.MustParseAddr(config.GetString("ipfsapiaddr"))
 	lotusMasterAddr := config.GetString("lotusmasteraddr")
 	lotusConnectionRetries := config.GetInt("lotusconnectionretries")
+	lotusSkipVersionCheck := config.GetBool("lotusskipversioncheck")
+	lotusPoolMaxIdle := config.GetInt("lotuspoolmaxidle")
+	lotusPoolIdleTimeout := config.GetDuration("lotuspoolidletimeout")
+	lotusReadRetries := lotus.RetryConfig{
+		MaxAttempts: config.GetInt("lotusreadmaxattempts"),
+		Backoff:     config.GetDuration("lotusreadretrybackoff"),
+	}
 	autocreateMasterAddr := config.GetBool("autocreatemasteraddr")
 	ffsUseMasterAddr := config.GetBool("ffsusemasteraddr")
 	grpcWebProxyAddr := config.GetString("grpcwebproxyaddr")
+	grpcStreamChunkSize := config.GetInt("grpcstreamchunksize")
+	if grpcStreamChunkSize <= 0 || grpcStreamChunkSize > user.MaxStreamChunkSize {
+		return server.Config{}, fmt.Errorf("grpc stream chunk size must be between 1 and %d bytes", user.MaxStreamChunkSize)
+	}
 	gatewayHostAddr := config.GetString("gatewayhostaddr")
 	gatewayBasePath := config.GetString("gatewaybasepath")
 	indexRawJSONHostAddr := config.GetString("indexrawjsonhostaddr")
@@ -131,6 +154,7 @@ func configFromFlags() (server.Config, error) {
 	mongoDB := config.GetString("mongodb")
 	minerSelector := config.GetString("ffsminerselector")
 	minerSelectorParams := config.GetString("ffsminerselectorparams")
+	minerSelectorAskUnavailable := config.GetString("ffsminerselectoraskunavailable")
 	ffsAdminToken := config.GetString("ffsadmintoken")
 	ffsSchedMaxParallel := config.GetInt("ffsschedmaxparallel")
 	ffsDealWatchFinalityTimeout := time.Minute * time.Duration(config.GetInt("ffsdealfinalitytimeout"))
@@ -139,16 +163,33 @@ func configFromFlags() (server.Config, error) {
 	ffsMaxParallelDealPreparing := config.GetInt("ffsmaxparalleldealpreparing")
 	ffsGCInterval := time.Minute * time.Duration(config.GetInt("ffsgcinterval"))
 	ffsGCStagedGracePeriod := time.Minute * time.Duration(config.GetInt("ffsgcstagedgraceperiod"))
+	ffsJobRetentionMaxAge := time.Hour * time.Duration(config.GetInt("ffsjobretentionmaxage"))
+	ffsJobRetentionMaxCount := config.GetInt("ffsjobretentionmaxcount")
+	ffsJobLogCompression := config.GetString("ffsjoblogcompression")
 	dealWatchPollDuration := time.Second * time.Duration(config.GetInt("dealwatchpollduration"))
+	dealWatchPollBackoffMax := time.Second * time.Duration(config.GetInt("dealwatchpollbackoffmax"))
+	var dealWatchClients []string
+	if clients := config.GetString("dealwatchclients"); clients != "" {
+		dealWatchClients = strings.Split(clients, ",")
+	}
+	dealsMaxConcurrentTransfers := config.GetInt("dealsmaxconcurrenttransfers")
+	dealsTransferRestarts := config.GetInt("dealstransferrestarts")
+	dealsMinStartOffset := config.GetInt64("dealsminstartoffset")
+	dealsTransferStallTimeout := time.Second * time.Duration(config.GetInt("dealstransferstalltimeout"))
 	askIndexQueryAskTimeout := time.Second * time.Duration(config.GetInt("askindexqueryasktimeout"))
 	askIndexRefreshInterval := time.Minute * time.Duration(config.GetInt("askindexrefreshinterval"))
 	askIndexRefreshOnStart := config.GetBool("askindexrefreshonstart")
 	askIndexMaxParallel := config.GetInt("askindexmaxparallel")
+	askIndexSnapshots := config.GetBool("askindexsnapshots")
+	askIndexSnapshotRetention := config.GetDuration("askindexsnapshotretention")
 	indexMinersRefreshOnStart := config.GetBool("indexminersrefreshonstart")
 	indexMinersOnChainMaxParallel := config.GetInt("indexminersonchainmaxparallel")
 	indexMinersOnChainFrequency := config.GetDuration("indexminersonchainfrequency")
+	dealsDisableCollateralTopUp := config.GetBool("dealsdisablecollateraltopup")
+	walletNonceManagement := config.GetBool("walletnoncemanagement")
 	disableIndices := config.GetBool("disableindices")
 	disableNonCompliantAPIs := config.GetBool("disablenoncompliantapis")
+	selfTestStrict := config.GetBool("selfteststrict")
 
 	return server.Config{
 		WalletInitialFunds: walletInitialFunds,
@@ -160,12 +201,18 @@ func configFromFlags() (server.Config, error) {
 		LotusAddress:           lotusHost,
 		LotusAuthToken:         lotusToken,
 		LotusConnectionRetries: lotusConnectionRetries,
+		LotusCallTimeouts:      lotusCallTimeouts,
+		LotusReadRetries:       lotusReadRetries,
+		LotusPoolMaxIdle:       lotusPoolMaxIdle,
+		LotusPoolIdleTimeout:   lotusPoolIdleTimeout,
+		LotusSkipVersionCheck:  lotusSkipVersionCheck,
 		LotusMasterAddr:        lotusMasterAddr,
 
 		// ToDo: Support secure gRPC connection
 		GrpcHostNetwork:     "tcp",
 		GrpcHostAddress:     grpcHostMaddr,
 		GrpcWebProxyAddress: grpcWebProxyAddr,
+		GrpcStreamChunkSize: grpcStreamChunkSize,
 
 		GatewayHostAddr:      gatewayHostAddr,
 		GatewayBasePath:      gatewayBasePath,
@@ -182,16 +229,30 @@ func configFromFlags() (server.Config, error) {
 		FFSMaxParallelDealPreparing:  ffsMaxParallelDealPreparing,
 		FFSGCAutomaticGCInterval:     ffsGCInterval,
 		FFSGCStageGracePeriod:        ffsGCStagedGracePeriod,
+		FFSJobRetentionMaxAge:        ffsJobRetentionMaxAge,
+		FFSJobRetentionMaxCount:      ffsJobRetentionMaxCount,
+		FFSJobLogCompression:         ffsJobLogCompression,
 		AutocreateMasterAddr:         autocreateMasterAddr,
 		MinerSelector:                minerSelector,
 		MinerSelectorParams:          minerSelectorParams,
+		MinerSelectorAskUnavailable:  minerSelectorAskUnavailable,
 		SchedMaxParallel:             ffsSchedMaxParallel,
 		DealWatchPollDuration:        dealWatchPollDuration,
-
-		AskIndexQueryAskTimeout: askIndexQueryAskTimeout,
-		AskIndexRefreshInterval: askIndexRefreshInterval,
-		AskIndexRefreshOnStart:  askIndexRefreshOnStart,
-		AskindexMaxParallel:     askIndexMaxParallel,
+		DealWatchPollBackoffMax:      dealWatchPollBackoffMax,
+		DealWatchClients:             dealWatchClients,
+		DealsDisableCollateralTopUp:  dealsDisableCollateralTopUp,
+		DealsMaxConcurrentTransfers:  dealsMaxConcurrentTransfers,
+		DealsTransferRestarts:        dealsTransferRestarts,
+		DealsMinStartOffset:          dealsMinStartOffset,
+		DealsTransferStallTimeout:    dealsTransferStallTimeout,
+		WalletNonceManagement:        walletNonceManagement,
+
+		AskIndexQueryAskTimeout:   askIndexQueryAskTimeout,
+		AskIndexRefreshInterval:   askIndexRefreshInterval,
+		AskIndexRefreshOnStart:    askIndexRefreshOnStart,
+		AskIndexSnapshots:         askIndexSnapshots,
+		AskIndexSnapshotRetention: askIndexSnapshotRetention,
+		AskindexMaxParallel:       askIndexMaxParallel,
 
 		IndexMinersRefreshOnStart:     indexMinersRefreshOnStart,
 		IndexMinersOnChainMaxParallel: indexMinersOnChainMaxParallel,
@@ -200,6 +261,8 @@ func configFromFlags() (server.Config, error) {
 		DisableIndices: disableIndices,
 
 		DisableNonCompliantAPIs: disableNonCompliantAPIs,
+
+		SelfTestStrict: selfTestStrict,
 	}, nil
 }
 
@@ -374,12 +437,19 @@ func setupFlags() error {
 
 	pflag.String("grpchostaddr", "/ip4/0.0.0.0/tcp/5002", "gRPC host listening address.")
 	pflag.String("grpcwebproxyaddr", "0.0.0.0:6002", "gRPC webproxy listening address.")
+	pflag.Int("grpcstreamchunksize", user.DefaultStreamChunkSize, "Size in bytes of the data chunks sent in each message of gRPC streaming responses, up to 4MiB minus 1KiB.")
 	pflag.String("indexrawjsonhostaddr", "0.0.0.0:8889", "Indexes raw json output listening address")
 
 	pflag.String("lotushost", "/ip4/127.0.0.1/tcp/1234", "Lotus client API endpoint multiaddress.")
 	pflag.String("lotustoken", "", "Lotus API authorization token. This flag or --lotustoken file are mandatory.")
 	pflag.String("lotustokenfile", "", "Path of a file that contains the Lotus API authorization token.")
 	pflag.String("lotusmasteraddr", "", "Existing wallet address in Lotus to be used as source of funding for new FFS instances. (Optional)")
+	pflag.String("lotuscalltimeouts", "", "Comma-separated Lotus API method timeouts, e.g: 'Version=5s,StateWaitMsg=1h'. Calls of other methods aren't bounded.")
+	pflag.Int("lotusreadmaxattempts", 1, "Max attempts of idempotent Lotus read calls (State*, Chain*); one disables retries.")
+	pflag.Duration("lotusreadretrybackoff", time.Millisecond*500, "Initial backoff between attempts of idempotent Lotus read calls, doubled on each retry.")
+	pflag.Int("lotuspoolmaxidle", 0, "Max idle Lotus API connections kept for reuse; zero disables connection pooling.")
+	pflag.Duration("lotuspoolidletimeout", lotus.DefaultPoolIdleTimeout, "Time after which idle pooled Lotus API connections are closed.")
+	pflag.Bool("lotusskipversioncheck", false, "Start even if the Lotus node API version isn't supported, only logging a warning.")
 	pflag.Int64("lotusconnectionretries", 180, "Maximum amount of connection retries when making API calls before considering them a failure. Retries are spaced by 10s. (default ~30min).")
 
 	pflag.String("gatewayhostaddr", "0.0.0.0:7000", "Gateway host listening address.")
@@ -397,6 +467,7 @@ func setupFlags() error {
 	pflag.Bool("ffsusemasteraddr", false, "Use the master address as the initial address for all new FFS instances instead of creating a new unique addess for each new FFS instance.")
 	pflag.String("ffsminerselector", "reputation", "Miner selector to be used by FFS: 'sr2', 'reputation'.")
 	pflag.String("ffsminerselectorparams", "", "Miner selector configuration parameter, depends on --ffsminerselector.")
+	pflag.String("ffsminerselectoraskunavailable", "skip", "Behavior for miners whose ask can't be queried: 'skip' them, treat them as 'free' or 'fail' the selection.")
 	pflag.String("ffsminimumpiecesize", "67108864", "Minimum piece size in bytes allowed to be stored in Filecoin.")
 	pflag.Duration("ffsretrievalnexteventtimeout", time.Hour, "Maximum amount of time to wait for the next retrieval event before erroring it.")
 	pflag.String("ffsschedmaxparallel", "1000", "Maximum amount of Jobs executed in parallel.")
@@ -404,12 +475,25 @@ func setupFlags() error {
 	pflag.String("ffsmaxparalleldealpreparing", "2", "Max parallel deal preparing tasks.")
 	pflag.String("ffsgcinterval", "60", "Interval in minutes of Hot Storage GC for staged data; zero is never.")
 	pflag.String("ffsgcstagedgraceperiod", "60", "Duration in minutes where a staged Cid will be considered GCable if scheduled in a Job.")
+	pflag.String("ffsjobretentionmaxage", "0", "Age in hours after which final jobs are pruned from the job history; zero is never.")
+	pflag.String("ffsjobretentionmaxcount", "0", "Max number of final jobs per instance kept in the job history; zero is unlimited.")
+	pflag.String("ffsjoblogcompression", "none", "Compression of persisted job logs: 'none', 'gzip', 'zstd'.")
+	pflag.Bool("dealsdisablecollateraltopup", false, "Fail deal proposals not covered by available market funds instead of adding the missing balance from the client wallet.")
+	pflag.String("dealsmaxconcurrenttransfers", "0", "Max number of storage and retrieval data transfers running at the same time; excess transfers are queued. Zero is unlimited.")
+	pflag.String("dealstransferrestarts", "0", "Max number of times a stalled storage data transfer is restarted, resuming from the data already sent. Zero disables restarts.")
+	pflag.Int64("dealsminstartoffset", util.MinDealStartOffset, "Min number of epochs between a deal proposal and its start epoch; proposals starting sooner are rejected. It should be at least the sealing lead time of miners.")
+	pflag.String("dealstransferstalltimeout", "600", "Time in seconds an ongoing storage data transfer can go without progress before it's restarted.")
+	pflag.Bool("walletnoncemanagement", false, "Assign nonces of messages sent from wallet addresses in Powergate, so concurrent sends get sequential nonces.")
 	pflag.String("dealwatchpollduration", "900", "Poll interval in seconds used by Deals Module watch to detect state changes.")
+	pflag.String("dealwatchpollbackoffmax", "0", "Max poll interval in seconds used by Deals Module watch when backing off checks of unchanged deals. Zero disables the backoff.")
+	pflag.String("dealwatchclients", "", "Comma separated client wallet addresses whose deal updates are processed, dropping updates of deals of other known clients of a shared Lotus node. Empty processes all.")
 
 	pflag.String("askindexqueryasktimeout", "15", "Timeout in seconds for a query ask.")
 	pflag.String("askindexrefreshinterval", "360", "Refresh interval measured in minutes.")
 	pflag.Bool("askindexrefreshonstart", false, "If true it will refresh the index on start.")
 	pflag.String("askindexmaxparallel", "3", "Max parallel query ask to execute while updating index.")
+	pflag.Bool("askindexsnapshots", false, "Persist every refreshed ask index as a historical snapshot for backtesting.")
+	pflag.Duration("askindexsnapshotretention", time.Hour*24*90, "How long ask index snapshots are kept before being pruned. Zero keeps them forever.")
 
 	pflag.Bool("indexminersrefreshonstart", false, "If true it will refresh the miner's on start.")
 	pflag.Int64("indexminersonchainmaxparallel", 20, "Max parallelization for building on-chain sub-index")
@@ -418,6 +502,8 @@ func setupFlags() error {
 	pflag.Bool("disableindices", false, "Disable all indices updates, useful to help Lotus syncing process.")
 	pflag.Bool("disablenoncompliantapis", false, "Disable APIs that may not easily comply with US law.")
 
+	pflag.Bool("selfteststrict", false, "Fail to start if the startup consistency self-test finds violations, instead of only logging them.")
+
 	pflag.Parse()
 
 	config.SetEnvPrefix("POWD")
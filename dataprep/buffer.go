@@ -0,0 +1,165 @@
+package dataprep
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+)
+
+const (
+	// DefaultMaxBufferedBlocks is the default maximum number of blocks kept
+	// in memory while importing data before they're flushed to the DAGService.
+	DefaultMaxBufferedBlocks = 256
+	// DefaultMaxBufferedBytes is the default maximum size of the blocks kept
+	// in memory while importing data before they're flushed to the DAGService.
+	DefaultMaxBufferedBytes = 64 << 20
+)
+
+// DagifyOption configures a Dagify call.
+type DagifyOption func(*dagifyConfig) error
+
+type dagifyConfig struct {
+	maxBufferedBlocks int
+	maxBufferedBytes  int
+}
+
+// WithMaxBufferedBlocks bounds the number of blocks kept in memory while
+// importing data. When the buffer is full, it's flushed to the DAGService.
+func WithMaxBufferedBlocks(n int) DagifyOption {
+	return func(c *dagifyConfig) error {
+		if n <= 0 {
+			return fmt.Errorf("max buffered blocks should be positive")
+		}
+		c.maxBufferedBlocks = n
+		return nil
+	}
+}
+
+// WithMaxBufferedBytes bounds the total size of the blocks kept in memory
+// while importing data. When the buffer is full, it's flushed to the
+// DAGService. A single block bigger than n is flushed on its own.
+func WithMaxBufferedBytes(n int) DagifyOption {
+	return func(c *dagifyConfig) error {
+		if n <= 0 {
+			return fmt.Errorf("max buffered bytes should be positive")
+		}
+		c.maxBufferedBytes = n
+		return nil
+	}
+}
+
+// boundedDAG is a DAGService which buffers added nodes in memory, and
+// flushes them to the underlying DAGService in batches of at most
+// maxBlocks nodes and maxBytes bytes. Other operations flush the buffer
+// before running, so they see every node added so far.
+type boundedDAG struct {
+	ipld.DAGService
+	maxBlocks int
+	maxBytes  int
+
+	lock  sync.Mutex
+	nodes []ipld.Node
+	size  int
+}
+
+var _ ipld.DAGService = (*boundedDAG)(nil)
+
+func newBoundedDAG(ds ipld.DAGService, maxBlocks, maxBytes int) *boundedDAG {
+	return &boundedDAG{
+		DAGService: ds,
+		maxBlocks:  maxBlocks,
+		maxBytes:   maxBytes,
+	}
+}
+
+// Add implements ipld.DAGService.
+func (bd *boundedDAG) Add(ctx context.Context, nd ipld.Node) error {
+	return bd.AddMany(ctx, []ipld.Node{nd})
+}
+
+// AddMany implements ipld.DAGService.
+func (bd *boundedDAG) AddMany(ctx context.Context, nds []ipld.Node) error {
+	bd.lock.Lock()
+	defer bd.lock.Unlock()
+	for _, nd := range nds {
+		size := len(nd.RawData())
+		// Flush first if the node doesn't fit, so the buffer never
+		// holds more than maxBytes unless a single node is bigger.
+		if len(bd.nodes) > 0 && bd.size+size > bd.maxBytes {
+			if err := bd.flush(ctx); err != nil {
+				return err
+			}
+		}
+		bd.nodes = append(bd.nodes, nd)
+		bd.size += size
+		if len(bd.nodes) >= bd.maxBlocks || bd.size >= bd.maxBytes {
+			if err := bd.flush(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Get implements ipld.DAGService.
+func (bd *boundedDAG) Get(ctx context.Context, c cid.Cid) (ipld.Node, error) {
+	if err := bd.Flush(ctx); err != nil {
+		return nil, err
+	}
+	return bd.DAGService.Get(ctx, c)
+}
+
+// GetMany implements ipld.DAGService.
+func (bd *boundedDAG) GetMany(ctx context.Context, cs []cid.Cid) <-chan *ipld.NodeOption {
+	if err := bd.Flush(ctx); err != nil {
+		ch := make(chan *ipld.NodeOption, 1)
+		ch <- &ipld.NodeOption{Err: err}
+		close(ch)
+		return ch
+	}
+	return bd.DAGService.GetMany(ctx, cs)
+}
+
+// Remove implements ipld.DAGService.
+func (bd *boundedDAG) Remove(ctx context.Context, c cid.Cid) error {
+	if err := bd.Flush(ctx); err != nil {
+		return err
+	}
+	return bd.DAGService.Remove(ctx, c)
+}
+
+// RemoveMany implements ipld.DAGService.
+func (bd *boundedDAG) RemoveMany(ctx context.Context, cs []cid.Cid) error {
+	if err := bd.Flush(ctx); err != nil {
+		return err
+	}
+	return bd.DAGService.RemoveMany(ctx, cs)
+}
+
+// Flush writes all buffered nodes to the underlying DAGService.
+func (bd *boundedDAG) Flush(ctx context.Context) error {
+	bd.lock.Lock()
+	defer bd.lock.Unlock()
+	return bd.flush(ctx)
+}
+
+func (bd *boundedDAG) flush(ctx context.Context) error {
+	if len(bd.nodes) == 0 {
+		return nil
+	}
+	if err := bd.DAGService.AddMany(ctx, bd.nodes); err != nil {
+		return fmt.Errorf("flushing %d buffered blocks: %s", len(bd.nodes), err)
+	}
+	bd.nodes = nil
+	bd.size = 0
+	return nil
+}
+
+func (bd *boundedDAG) buffered() (int, int) {
+	bd.lock.Lock()
+	defer bd.lock.Unlock()
+	return len(bd.nodes), bd.size
+}
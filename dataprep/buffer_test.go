@@ -0,0 +1,147 @@
+package dataprep
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	ipld "github.com/ipfs/go-ipld-format"
+	dag "github.com/ipfs/go-merkledag"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDagifyBoundedMemory(t *testing.T) {
+	t.Parallel()
+
+	// 32MiB are chunked in 128 leaves with the default chunker.
+	path := filepath.Join(t.TempDir(), "large")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	_, err = io.CopyN(f, rand.Reader, 32<<20)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	const maxBlocks = 8
+	cds := &countingDAG{DAGService: dstest.Mock()}
+	c, err := Dagify(context.Background(), cds, path, nil, WithMaxBufferedBlocks(maxBlocks))
+	require.NoError(t, err)
+
+	require.Greater(t, cds.total, 128)
+	require.GreaterOrEqual(t, cds.flushes, cds.total/maxBlocks)
+	require.LessOrEqual(t, cds.maxBatch, maxBlocks)
+
+	// Leaves are 256KiB, so a 1MiB bound flushes at most 4 blocks at once.
+	const maxBytes = 1 << 20
+	cds = &countingDAG{DAGService: dstest.Mock()}
+	_, err = Dagify(context.Background(), cds, path, nil, WithMaxBufferedBytes(maxBytes))
+	require.NoError(t, err)
+	require.LessOrEqual(t, cds.maxBatchBytes, maxBytes)
+	require.LessOrEqual(t, cds.maxBatch, 4)
+
+	// Every block was flushed to the underlying DAGService.
+	_, err = cds.DAGService.Get(context.Background(), c)
+	require.NoError(t, err)
+}
+
+func TestBoundedDAGAddMany(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cds := &countingDAG{DAGService: dstest.Mock()}
+	bd := newBoundedDAG(cds, 4, DefaultMaxBufferedBytes)
+
+	nds := make([]ipld.Node, 10)
+	for i := range nds {
+		nds[i] = dag.NodeWithData([]byte{byte(i)})
+	}
+	require.NoError(t, bd.AddMany(ctx, nds))
+	n, _ := bd.buffered()
+	require.Equal(t, 2, n)
+	require.Equal(t, 8, cds.total)
+	require.Equal(t, 4, cds.maxBatch)
+
+	// Reads see buffered nodes.
+	nd, err := bd.Get(ctx, nds[9].Cid())
+	require.NoError(t, err)
+	require.Equal(t, nds[9].Cid(), nd.Cid())
+	n, size := bd.buffered()
+	require.Equal(t, 0, n)
+	require.Equal(t, 0, size)
+	require.Equal(t, 10, cds.total)
+
+	require.Error(t, WithMaxBufferedBlocks(0)(&dagifyConfig{}))
+	require.Error(t, WithMaxBufferedBytes(0)(&dagifyConfig{}))
+}
+
+func TestBoundedDAGMaxBytes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cds := &countingDAG{DAGService: dstest.Mock()}
+	bd := newBoundedDAG(cds, DefaultMaxBufferedBlocks, 100)
+
+	nd := func(size int) ipld.Node {
+		data := make([]byte, size)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+		return dag.NewRawNode(data)
+	}
+
+	require.NoError(t, bd.AddMany(ctx, []ipld.Node{nd(40), nd(40)}))
+	n, size := bd.buffered()
+	require.Equal(t, 2, n)
+	require.Equal(t, 80, size)
+	require.Equal(t, 0, cds.total)
+
+	// The next node doesn't fit, so the buffer is flushed first.
+	require.NoError(t, bd.Add(ctx, nd(40)))
+	n, size = bd.buffered()
+	require.Equal(t, 1, n)
+	require.Equal(t, 40, size)
+	require.Equal(t, 2, cds.total)
+
+	// A node bigger than the bound is flushed on its own.
+	require.NoError(t, bd.Add(ctx, nd(200)))
+	n, _ = bd.buffered()
+	require.Equal(t, 0, n)
+	require.Equal(t, 4, cds.total)
+	require.Equal(t, 200, cds.maxBatchBytes)
+}
+
+type countingDAG struct {
+	ipld.DAGService
+
+	lock     sync.Mutex
+	total    int
+	flushes  int
+	maxBatch int
+
+	maxBatchBytes int
+}
+
+func (cd *countingDAG) Add(ctx context.Context, nd ipld.Node) error {
+	return cd.AddMany(ctx, []ipld.Node{nd})
+}
+
+func (cd *countingDAG) AddMany(ctx context.Context, nds []ipld.Node) error {
+	cd.lock.Lock()
+	cd.total += len(nds)
+	cd.flushes++
+	if len(nds) > cd.maxBatch {
+		cd.maxBatch = len(nds)
+	}
+	var size int
+	for _, nd := range nds {
+		size += len(nd.RawData())
+	}
+	if size > cd.maxBatchBytes {
+		cd.maxBatchBytes = size
+	}
+	cd.lock.Unlock()
+	return cd.DAGService.AddMany(ctx, nds)
+}
@@ -0,0 +1,173 @@
+package dataprep
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ipld/go-car"
+	"github.com/ipld/go-car/util"
+)
+
+// carProgressInterval is the minimum time between progress reports of
+// WriteCarWithProgress.
+var carProgressInterval = time.Second
+
+// WriteCarWithProgress writes the DAGs of roots as a CAR file to w, like
+// car.WriteCar. While blocks are written, progress is called periodically
+// with the bytes and nodes written so far, and a last time with the final
+// counts once the CAR file is complete.
+func WriteCarWithProgress(ctx context.Context, ng ipld.NodeGetter, roots []cid.Cid, w io.Writer, progress func(bytesWritten int64, nodesWritten int)) error {
+	cw := &countingWriter{w: w}
+	var nodes int
+	last := time.Now()
+	// The walk func is called after each node is written.
+	walk := func(nd ipld.Node) ([]*ipld.Link, error) {
+		nodes++
+		if time.Since(last) >= carProgressInterval {
+			progress(cw.n, nodes)
+			last = time.Now()
+		}
+		return car.DefaultWalkFunc(nd)
+	}
+	if err := car.WriteCarWithWalker(ctx, ng, roots, cw, walk); err != nil {
+		return err
+	}
+	progress(cw.n, nodes)
+	return nil
+}
+
+// EstimateCarSize returns the size of the CAR file of the DAGs of roots,
+// including the header and the framing of each block. The DAGs are walked
+// as car.WriteCar does, but blocks are discarded instead of written, so
+// the estimate is the exact size written by car.WriteCar.
+func EstimateCarSize(ctx context.Context, ng ipld.NodeGetter, roots []cid.Cid) (int64, error) {
+	cw := &countingWriter{w: ioutil.Discard}
+	if err := car.WriteCarWithWalker(ctx, ng, roots, cw, car.DefaultWalkFunc); err != nil {
+		return 0, fmt.Errorf("walking dag: %s", err)
+	}
+	return cw.n, nil
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+// WriteCarSplit writes the DAGs of roots as a sequence of CAR files, each
+// of at most maxBytes bytes. The i-th CAR file is written to the writer
+// returned by newFile(i), which is closed after it's written. Every CAR file
+// has the same header with all the roots, and each block is written in
+// exactly one of them, so loading all of them reassembles the full DAGs.
+// It returns the roots of the written CAR files.
+func WriteCarSplit(ctx context.Context, ng ipld.NodeGetter, roots []cid.Cid, newFile func(index int) (io.WriteCloser, error), maxBytes int64) ([]cid.Cid, error) {
+	var header bytes.Buffer
+	if err := car.WriteHeader(&car.CarHeader{Roots: roots, Version: 1}, &header); err != nil {
+		return nil, fmt.Errorf("writing car header: %s", err)
+	}
+	sw := &splitWriter{
+		header:   header.Bytes(),
+		newFile:  newFile,
+		maxBytes: maxBytes,
+	}
+
+	seen := cid.NewSet()
+	var walk func(c cid.Cid) error
+	walk = func(c cid.Cid) error {
+		if !seen.Visit(c) {
+			return nil
+		}
+		nd, err := ng.Get(ctx, c)
+		if err != nil {
+			return fmt.Errorf("getting node %s: %s", c, err)
+		}
+		if err := sw.writeBlock(nd.Cid(), nd.RawData()); err != nil {
+			return err
+		}
+		for _, l := range nd.Links() {
+			if err := walk(l.Cid); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, r := range roots {
+		if err := walk(r); err != nil {
+			_ = sw.close()
+			return nil, err
+		}
+	}
+	if err := sw.close(); err != nil {
+		return nil, err
+	}
+	return roots, nil
+}
+
+// splitWriter writes blocks to a sequence of CAR files of bounded size.
+type splitWriter struct {
+	header   []byte
+	newFile  func(index int) (io.WriteCloser, error)
+	maxBytes int64
+
+	count int
+	curr  io.WriteCloser
+	size  int64
+}
+
+func (sw *splitWriter) writeBlock(c cid.Cid, data []byte) error {
+	size := int64(util.LdSize(c.Bytes(), data))
+	if int64(len(sw.header))+size > sw.maxBytes {
+		return fmt.Errorf("block %s of %d bytes doesn't fit in a car file of %d bytes", c, size, sw.maxBytes)
+	}
+	if sw.curr == nil || sw.size+size > sw.maxBytes {
+		if err := sw.next(); err != nil {
+			return err
+		}
+	}
+	if err := util.LdWrite(sw.curr, c.Bytes(), data); err != nil {
+		return fmt.Errorf("writing block %s: %s", c, err)
+	}
+	sw.size += size
+	return nil
+}
+
+func (sw *splitWriter) next() error {
+	if err := sw.close(); err != nil {
+		return err
+	}
+	w, err := sw.newFile(sw.count)
+	if err != nil {
+		return fmt.Errorf("creating car file %d: %s", sw.count, err)
+	}
+	sw.count++
+	sw.curr = w
+	if _, err := sw.curr.Write(sw.header); err != nil {
+		return fmt.Errorf("writing car header: %s", err)
+	}
+	sw.size = int64(len(sw.header))
+	return nil
+}
+
+func (sw *splitWriter) close() error {
+	if sw.curr == nil {
+		return nil
+	}
+	w := sw.curr
+	sw.curr = nil
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("closing car file %d: %s", sw.count-1, err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package dataprep
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	blockstore "github.com/ipfs/go-ipfs-blockstore"
+	ipld "github.com/ipfs/go-ipld-format"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/ipld/go-car"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCarSplit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dag := dstest.Mock()
+	root := dagifyRandomFile(t, dag, 3<<20)
+
+	const maxBytes = 600 << 10
+	var files []*bufferCloser
+	newFile := func(index int) (io.WriteCloser, error) {
+		require.Equal(t, len(files), index)
+		f := &bufferCloser{}
+		files = append(files, f)
+		return f, nil
+	}
+	roots, err := WriteCarSplit(ctx, dag, []cid.Cid{root}, newFile, maxBytes)
+	require.NoError(t, err)
+	require.Equal(t, []cid.Cid{root}, roots)
+	require.Greater(t, len(files), 1)
+
+	bs := blockstore.NewBlockstore(dssync.MutexWrap(ds.NewMapDatastore()))
+	for _, f := range files {
+		require.True(t, f.closed)
+		require.LessOrEqual(t, f.Len(), maxBytes)
+		h, err := car.LoadCar(bs, f)
+		require.NoError(t, err)
+		require.Equal(t, []cid.Cid{root}, h.Roots)
+	}
+
+	// Every block of the DAG is in the reassembled blockstore.
+	cids := allCids(t, dag, root)
+	require.Greater(t, len(cids), len(files))
+	for _, c := range cids {
+		ok, err := bs.Has(c)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+}
+
+func TestWriteCarSplitBlockTooBig(t *testing.T) {
+	t.Parallel()
+
+	dag := dstest.Mock()
+	root := dagifyRandomFile(t, dag, 1<<20)
+	newFile := func(int) (io.WriteCloser, error) { return &bufferCloser{}, nil }
+	_, err := WriteCarSplit(context.Background(), dag, []cid.Cid{root}, newFile, 1<<10)
+	require.Error(t, err)
+}
+
+func TestWriteCarWithProgress(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dag := dstest.Mock()
+	root := dagifyRandomFile(t, dag, 3<<20)
+
+	type report struct {
+		bytes int64
+		nodes int
+	}
+	var reports []report
+	var buf bytes.Buffer
+	err := WriteCarWithProgress(ctx, dag, []cid.Cid{root}, &buf, func(bytesWritten int64, nodesWritten int) {
+		reports = append(reports, report{bytesWritten, nodesWritten})
+	})
+	require.NoError(t, err)
+
+	// The CAR file is the same written by car.WriteCar.
+	var expected bytes.Buffer
+	require.NoError(t, car.WriteCar(ctx, dag, []cid.Cid{root}, &expected))
+	require.Equal(t, expected.Bytes(), buf.Bytes())
+
+	// Reports only grow, and the last one has the final counts.
+	require.NotEmpty(t, reports)
+	for i := 1; i < len(reports); i++ {
+		require.GreaterOrEqual(t, reports[i].bytes, reports[i-1].bytes)
+		require.GreaterOrEqual(t, reports[i].nodes, reports[i-1].nodes)
+	}
+	last := reports[len(reports)-1]
+	require.Equal(t, int64(buf.Len()), last.bytes)
+	require.Equal(t, len(allCids(t, dag, root)), last.nodes)
+}
+
+func TestEstimateCarSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dag := dstest.Mock()
+	root := dagifyRandomFile(t, dag, 3<<20)
+	other := dagifyRandomFile(t, dag, 1<<10)
+
+	for _, roots := range [][]cid.Cid{{root}, {root, other}} {
+		estimate, err := EstimateCarSize(ctx, dag, roots)
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		require.NoError(t, car.WriteCar(ctx, dag, roots, &buf))
+		require.Equal(t, int64(buf.Len()), estimate)
+	}
+
+	_, err := EstimateCarSize(ctx, dstest.Mock(), []cid.Cid{root})
+	require.Error(t, err)
+}
+
+func dagifyRandomFile(t *testing.T, dag ipld.DAGService, size int64) cid.Cid {
+	path := filepath.Join(t.TempDir(), "data")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	_, err = io.CopyN(f, rand.Reader, size)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	c, err := Dagify(context.Background(), dag, path, nil)
+	require.NoError(t, err)
+	return c
+}
+
+func allCids(t *testing.T, ng ipld.NodeGetter, root cid.Cid) []cid.Cid {
+	nd, err := ng.Get(context.Background(), root)
+	require.NoError(t, err)
+	cids := []cid.Cid{root}
+	for _, l := range nd.Links() {
+		cids = append(cids, allCids(t, ng, l.Cid)...)
+	}
+	return cids
+}
+
+type bufferCloser struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (bc *bufferCloser) Close() error {
+	bc.closed = true
+	return nil
+}
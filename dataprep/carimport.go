@@ -0,0 +1,215 @@
+package dataprep
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	blockstore "github.com/ipfs/go-ipfs-blockstore"
+	"github.com/ipld/go-car"
+)
+
+// Checkpoint persists the progress of a CAR import.
+type Checkpoint interface {
+	// Load returns the offset of the first block which wasn't imported,
+	// or zero if the import didn't start.
+	Load() (int64, error)
+	// Save saves the offset of the first block which wasn't imported.
+	Save(offset int64) error
+}
+
+// ImportCar imports the blocks of a CAR file into a store, saving the
+// progress in cp after each imported block. If a previous import of the
+// same CAR file was interrupted, it resumes from the saved checkpoint
+// without reading or importing again the blocks imported before.
+func ImportCar(ctx context.Context, s car.Store, r io.ReadSeeker, cp Checkpoint) (*car.CarHeader, error) {
+	offset, err := cp.Load()
+	if err != nil {
+		return nil, fmt.Errorf("loading checkpoint: %s", err)
+	}
+
+	cr := &offsetReader{r: bufio.NewReader(r)}
+	hb, err := cr.readSection()
+	if err != nil {
+		return nil, fmt.Errorf("reading car header: %s", err)
+	}
+	h, err := car.ReadHeader(bufio.NewReader(bytes.NewReader(ldBytes(hb))))
+	if err != nil {
+		return nil, fmt.Errorf("decoding car header: %s", err)
+	}
+
+	if offset > cr.offset {
+		if _, err := r.Seek(offset, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("seeking to checkpoint %d: %s", offset, err)
+		}
+		cr = &offsetReader{r: bufio.NewReader(r), offset: offset}
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		data, err := cr.readSection()
+		if err == io.EOF {
+			return h, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading block at offset %d: %s", cr.offset, err)
+		}
+		n, c, err := cid.CidFromBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("decoding block cid: %s", err)
+		}
+		b, err := blocks.NewBlockWithCid(data[n:], c)
+		if err != nil {
+			return nil, fmt.Errorf("creating block %s: %s", c, err)
+		}
+		if err := s.Put(b); err != nil {
+			return nil, fmt.Errorf("importing block %s: %s", c, err)
+		}
+		if err := cp.Save(cr.offset); err != nil {
+			return nil, fmt.Errorf("saving checkpoint: %s", err)
+		}
+	}
+}
+
+// LoadCarDedup imports the blocks of a CAR file into a blockstore,
+// skipping blocks which are already in it, so importing CAR files with
+// overlapping DAGs doesn't write the same blocks again. Blocks are read
+// one at a time. It returns the CAR header and the number of blocks
+// which were added.
+func LoadCarDedup(ctx context.Context, bs blockstore.Blockstore, r io.Reader) (*car.CarHeader, int, error) {
+	cr, err := car.NewCarReader(r)
+	if err != nil {
+		return nil, 0, fmt.Errorf("reading car header: %s", err)
+	}
+	var added int
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, added, err
+		}
+		b, err := cr.Next()
+		if err == io.EOF {
+			return cr.Header, added, nil
+		}
+		if err != nil {
+			return nil, added, fmt.Errorf("reading block: %s", err)
+		}
+		ok, err := bs.Has(b.Cid())
+		if err != nil {
+			return nil, added, fmt.Errorf("checking block %s: %s", b.Cid(), err)
+		}
+		if ok {
+			continue
+		}
+		if err := bs.Put(b); err != nil {
+			return nil, added, fmt.Errorf("importing block %s: %s", b.Cid(), err)
+		}
+		added++
+	}
+}
+
+// FileCheckpoint is a Checkpoint saved in a file.
+type FileCheckpoint struct {
+	path string
+}
+
+var _ Checkpoint = (*FileCheckpoint)(nil)
+
+// NewFileCheckpoint returns a Checkpoint saved in the file at path.
+func NewFileCheckpoint(path string) *FileCheckpoint {
+	return &FileCheckpoint{path: path}
+}
+
+// Load implements Checkpoint.
+func (fc *FileCheckpoint) Load() (int64, error) {
+	b, err := ioutil.ReadFile(fc.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(b)), 10, 64)
+}
+
+// Save implements Checkpoint. The offset is written to a temporary file
+// which replaces the previous checkpoint, so an interrupted save never
+// leaves a truncated checkpoint.
+func (fc *FileCheckpoint) Save(offset int64) error {
+	f, err := ioutil.TempFile(filepath.Dir(fc.path), filepath.Base(fc.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("creating temp file: %s", err)
+	}
+	tmp := f.Name()
+	if _, err := f.WriteString(strconv.FormatInt(offset, 10)); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return fmt.Errorf("writing temp file: %s", err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return fmt.Errorf("syncing temp file: %s", err)
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("closing temp file: %s", err)
+	}
+	if err := os.Rename(tmp, fc.path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("replacing checkpoint: %s", err)
+	}
+	return nil
+}
+
+// offsetReader reads length-prefixed CAR sections, keeping track of the
+// offset of the next one. If maxSection isn't zero, longer sections fail
+// before being read.
+type offsetReader struct {
+	r          *bufio.Reader
+	offset     int64
+	maxSection uint64
+}
+
+func (o *offsetReader) ReadByte() (byte, error) {
+	b, err := o.r.ReadByte()
+	if err == nil {
+		o.offset++
+	}
+	return b, err
+}
+
+func (o *offsetReader) readSection() ([]byte, error) {
+	l, err := binary.ReadUvarint(o)
+	if err != nil {
+		return nil, err
+	}
+	if o.maxSection > 0 && l > o.maxSection {
+		return nil, fmt.Errorf("section of %d bytes is longer than the maximum %d", l, o.maxSection)
+	}
+	data := make([]byte, l)
+	n, err := io.ReadFull(o.r, data)
+	o.offset += int64(n)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func ldBytes(data []byte) []byte {
+	buf := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(data))
+	n := binary.PutUvarint(buf, uint64(len(data)))
+	return append(buf[:n], data...)
+}
@@ -0,0 +1,159 @@
+package dataprep
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	blockstore "github.com/ipfs/go-ipfs-blockstore"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/ipld/go-car"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportCarResume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dag := dstest.Mock()
+	root := dagifyRandomFile(t, dag, 3<<20)
+	carPath := filepath.Join(t.TempDir(), "data.car")
+	f, err := os.Create(carPath)
+	require.NoError(t, err)
+	require.NoError(t, car.WriteCar(ctx, dag, []cid.Cid{root}, f))
+	require.NoError(t, f.Close())
+	fi, err := os.Stat(carPath)
+	require.NoError(t, err)
+
+	cids := allCids(t, dag, root)
+	cp := NewFileCheckpoint(filepath.Join(t.TempDir(), "checkpoint"))
+	s := &countingStore{
+		Blockstore: blockstore.NewBlockstore(dssync.MutexWrap(ds.NewMapDatastore())),
+		failAfter:  len(cids) / 2,
+	}
+
+	// The first import is interrupted halfway.
+	f, err = os.Open(carPath)
+	require.NoError(t, err)
+	_, err = ImportCar(ctx, s, f, cp)
+	require.Error(t, err)
+	require.NoError(t, f.Close())
+	offset, err := cp.Load()
+	require.NoError(t, err)
+	require.Greater(t, offset, int64(0))
+	require.Less(t, offset, fi.Size())
+
+	// The second import resumes from the checkpoint.
+	s.failAfter = 0
+	f, err = os.Open(carPath)
+	require.NoError(t, err)
+	cr := &countingReader{ReadSeeker: f}
+	h, err := ImportCar(ctx, s, cr, cp)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, []cid.Cid{root}, h.Roots)
+	require.LessOrEqual(t, cr.read, fi.Size()-offset+4096)
+
+	offset, err = cp.Load()
+	require.NoError(t, err)
+	require.Equal(t, fi.Size(), offset)
+	for _, c := range cids {
+		require.Equal(t, 1, s.puts[c])
+		ok, err := s.Has(c)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+}
+
+func TestLoadCarDedup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dag := dstest.Mock()
+	root := dagifyRandomFile(t, dag, 1<<20)
+	var buf bytes.Buffer
+	require.NoError(t, car.WriteCar(ctx, dag, []cid.Cid{root}, &buf))
+	data := buf.Bytes()
+	cids := allCids(t, dag, root)
+
+	s := &countingStore{Blockstore: blockstore.NewBlockstore(dssync.MutexWrap(ds.NewMapDatastore()))}
+	h, added, err := LoadCarDedup(ctx, s, bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, []cid.Cid{root}, h.Roots)
+	require.Equal(t, len(cids), added)
+
+	// Importing the same car again doesn't write any block.
+	h, added, err = LoadCarDedup(ctx, s, bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, []cid.Cid{root}, h.Roots)
+	require.Zero(t, added)
+	require.Equal(t, len(cids), s.total)
+	for _, c := range cids {
+		require.Equal(t, 1, s.puts[c])
+	}
+}
+
+type countingStore struct {
+	blockstore.Blockstore
+	failAfter int
+
+	lock  sync.Mutex
+	total int
+	puts  map[cid.Cid]int
+}
+
+func (cs *countingStore) Put(b blocks.Block) error {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	if cs.failAfter > 0 && cs.total == cs.failAfter {
+		return errors.New("interrupted")
+	}
+	if cs.puts == nil {
+		cs.puts = make(map[cid.Cid]int)
+	}
+	cs.total++
+	cs.puts[b.Cid()]++
+	return cs.Blockstore.Put(b)
+}
+
+type countingReader struct {
+	io.ReadSeeker
+	read int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.ReadSeeker.Read(p)
+	cr.read += int64(n)
+	return n, err
+}
+
+func TestFileCheckpoint(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	cp := NewFileCheckpoint(filepath.Join(dir, "checkpoint"))
+
+	offset, err := cp.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), offset)
+
+	require.NoError(t, cp.Save(100))
+	require.NoError(t, cp.Save(2048))
+	offset, err = cp.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(2048), offset)
+
+	// Saves don't leave temporary files behind.
+	entries, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
@@ -0,0 +1,60 @@
+package dataprep
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	ipldformat "github.com/ipfs/go-ipld-format"
+	"github.com/ipld/go-car"
+	ipld "github.com/ipld/go-ipld-prime"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/traversal/selector"
+	"github.com/ipld/go-ipld-prime/traversal/selector/builder"
+)
+
+// WriteCarSelective writes a CAR file with root as its only root, and only
+// the blocks of the DAG traversed by the IPLD selector sel, instead of
+// every block linked from root.
+func WriteCarSelective(ctx context.Context, ng ipldformat.NodeGetter, root cid.Cid, sel ipld.Node, w io.Writer) error {
+	sc := car.NewSelectiveCar(ctx, &nodeGetterStore{ctx: ctx, ng: ng}, []car.Dag{{Root: root, Selector: sel}})
+	if err := sc.Write(w); err != nil {
+		return fmt.Errorf("writing selective car: %s", err)
+	}
+	return nil
+}
+
+// SelectAll returns a selector of the whole DAG.
+func SelectAll() ipld.Node {
+	ssb := builder.NewSelectorSpecBuilder(basicnode.Prototype.Any)
+	return ssb.ExploreRecursive(selector.RecursionLimitNone(), ssb.ExploreAll(ssb.ExploreRecursiveEdge())).Node()
+}
+
+// SelectLinkPath returns a selector of the subgraph reached following the
+// links of DAG-PB nodes at the provided indexes, such as a file within
+// a UnixFS directory. The nodes along the path are selected too.
+func SelectLinkPath(indexes ...int) ipld.Node {
+	ssb := builder.NewSelectorSpecBuilder(basicnode.Prototype.Any)
+	spec := ssb.ExploreRecursive(selector.RecursionLimitNone(), ssb.ExploreAll(ssb.ExploreRecursiveEdge()))
+	for i := len(indexes) - 1; i >= 0; i-- {
+		idx, next := indexes[i], spec
+		spec = ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+			efsb.Insert("Links", ssb.ExploreIndex(int64(idx), ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+				efsb.Insert("Hash", next)
+			})))
+		})
+	}
+	return spec.Node()
+}
+
+// nodeGetterStore is a car.ReadStore reading blocks from a NodeGetter.
+type nodeGetterStore struct {
+	ctx context.Context
+	ng  ipldformat.NodeGetter
+}
+
+func (s *nodeGetterStore) Get(c cid.Cid) (blocks.Block, error) {
+	return s.ng.Get(s.ctx, c)
+}
@@ -0,0 +1,80 @@
+package dataprep
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	dag "github.com/ipfs/go-merkledag"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/ipld/go-car"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCarSelective(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ds := dstest.Mock()
+	root, files := newDirectoryDAG(t, ds, 3, 10)
+
+	var full bytes.Buffer
+	require.NoError(t, car.WriteCar(ctx, ds, []cid.Cid{root}, &full))
+
+	// Selecting the whole DAG exports the same blocks.
+	var all bytes.Buffer
+	require.NoError(t, WriteCarSelective(ctx, ds, root, SelectAll(), &all))
+	require.Equal(t, full.Len(), all.Len())
+	require.ElementsMatch(t, allCids(t, ds, root), carCids(t, &all, root))
+
+	// Selecting a file only exports the root and the file DAG.
+	var file bytes.Buffer
+	require.NoError(t, WriteCarSelective(ctx, ds, root, SelectLinkPath(1), &file))
+	require.Less(t, file.Len(), full.Len()/2)
+	expected := append([]cid.Cid{root}, allCids(t, ds, files[1])...)
+	require.ElementsMatch(t, expected, carCids(t, &file, root))
+}
+
+// newDirectoryDAG returns a DAG whose root links to files of leaves
+// blocks each.
+func newDirectoryDAG(t *testing.T, ds ipld.DAGService, files, leaves int) (cid.Cid, []cid.Cid) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+	root := &dag.ProtoNode{}
+	var fileCids []cid.Cid
+	for i := 0; i < files; i++ {
+		file := &dag.ProtoNode{}
+		for j := 0; j < leaves; j++ {
+			data := make([]byte, 1000)
+			_, _ = r.Read(data)
+			leaf := dag.NewRawNode(data)
+			require.NoError(t, ds.Add(ctx, leaf))
+			require.NoError(t, file.AddNodeLink(strconv.Itoa(j), leaf))
+		}
+		require.NoError(t, ds.Add(ctx, file))
+		require.NoError(t, root.AddNodeLink(strconv.Itoa(i), file))
+		fileCids = append(fileCids, file.Cid())
+	}
+	require.NoError(t, ds.Add(ctx, root))
+	return root.Cid(), fileCids
+}
+
+func carCids(t *testing.T, r io.Reader, root cid.Cid) []cid.Cid {
+	cr, err := car.NewCarReader(r)
+	require.NoError(t, err)
+	require.Equal(t, []cid.Cid{root}, cr.Header.Roots)
+	var cids []cid.Cid
+	for {
+		b, err := cr.Next()
+		if err == io.EOF {
+			return cids
+		}
+		require.NoError(t, err)
+		cids = append(cids, b.Cid())
+	}
+}
@@ -0,0 +1,113 @@
+package dataprep
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ipld/go-car"
+	carv2 "github.com/ipld/go-car/v2"
+	"github.com/ipld/go-car/v2/blockstore"
+	"github.com/ipld/go-car/v2/index"
+)
+
+// WriteCarV2 writes the DAGs of roots as a CARv2 file to w, including an
+// index of its blocks. The CARv2 header has the size of the data, so the
+// data is first written as a CARv1 file to a temporary file.
+func WriteCarV2(ctx context.Context, ng ipld.NodeGetter, roots []cid.Cid, w io.Writer) error {
+	tmp, err := os.CreateTemp("", "carv1-*")
+	if err != nil {
+		return fmt.Errorf("creating temporary car file: %s", err)
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if err := car.WriteCar(ctx, ng, roots, tmp); err != nil {
+		return fmt.Errorf("writing car v1 data: %s", err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("rewinding temporary car file: %s", err)
+	}
+	if err := carv2.WrapV1(tmp, w); err != nil {
+		return fmt.Errorf("wrapping car v1 data: %s", err)
+	}
+	return nil
+}
+
+// CarV2Reader reads a CARv2 file. Blocks are read by Cid using the index
+// of the file, without scanning the data.
+type CarV2Reader struct {
+	r     *carv2.Reader
+	idx   index.Index
+	bs    *blockstore.ReadOnly
+	roots []cid.Cid
+}
+
+// NewCarV2Reader returns a new CarV2Reader. If the file doesn't have an
+// index, it's generated from the data.
+func NewCarV2Reader(r io.ReaderAt) (*CarV2Reader, error) {
+	cr, err := carv2.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading car v2 header: %s", err)
+	}
+	roots, err := cr.Roots()
+	if err != nil {
+		return nil, fmt.Errorf("reading roots: %s", err)
+	}
+	var idx index.Index
+	if ir := cr.IndexReader(); ir != nil {
+		idx, err = index.ReadFrom(ir)
+		if err != nil {
+			return nil, fmt.Errorf("reading index: %s", err)
+		}
+	} else {
+		idx, err = carv2.GenerateIndex(cr.DataReader())
+		if err != nil {
+			return nil, fmt.Errorf("generating index: %s", err)
+		}
+	}
+	bs, err := blockstore.NewReadOnly(r, idx)
+	if err != nil {
+		return nil, fmt.Errorf("creating blockstore: %s", err)
+	}
+	return &CarV2Reader{r: cr, idx: idx, bs: bs, roots: roots}, nil
+}
+
+// Roots returns the roots of the CAR file.
+func (cr *CarV2Reader) Roots() []cid.Cid {
+	return cr.roots
+}
+
+// Index returns the index of the CAR file, which maps Cids to the offsets
+// of their blocks in the data.
+func (cr *CarV2Reader) Index() index.Index {
+	return cr.idx
+}
+
+// Get returns the block with the provided Cid.
+func (cr *CarV2Reader) Get(c cid.Cid) (blocks.Block, error) {
+	b, err := cr.bs.Get(c)
+	if err != nil {
+		return nil, fmt.Errorf("getting block %s: %s", c, err)
+	}
+	return b, nil
+}
+
+// Has returns true if the CAR file has the block with the provided Cid.
+func (cr *CarV2Reader) Has(c cid.Cid) (bool, error) {
+	return cr.bs.Has(c)
+}
+
+// Close closes the reader.
+func (cr *CarV2Reader) Close() error {
+	if err := cr.bs.Close(); err != nil {
+		return fmt.Errorf("closing blockstore: %s", err)
+	}
+	return cr.r.Close()
+}
@@ -0,0 +1,47 @@
+package dataprep
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCarV2RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dag := dstest.Mock()
+	root := dagifyRandomFile(t, dag, 3<<20)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCarV2(ctx, dag, []cid.Cid{root}, &buf))
+
+	cr, err := NewCarV2Reader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, cr.Close()) })
+	require.Equal(t, []cid.Cid{root}, cr.Roots())
+	require.NotNil(t, cr.Index())
+
+	// Every block of the DAG is read by Cid with the index.
+	cids := allCids(t, dag, root)
+	for _, c := range cids {
+		nd, err := dag.Get(ctx, c)
+		require.NoError(t, err)
+		b, err := cr.Get(c)
+		require.NoError(t, err)
+		require.Equal(t, c, b.Cid())
+		require.Equal(t, nd.RawData(), b.RawData())
+	}
+
+	// Blocks of other DAGs aren't found.
+	other := dagifyRandomFile(t, dstest.Mock(), 1<<10)
+	ok, err := cr.Has(other)
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, err = cr.Get(other)
+	require.Error(t, err)
+}
@@ -0,0 +1,80 @@
+package dataprep
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-car"
+)
+
+// maxCarSectionSize is the maximum size of the header or a block of a
+// validated CAR file, so a corrupted length can't exhaust memory.
+const maxCarSectionSize = 32 << 20
+
+// ValidateCar reads a CAR file checking its header has the expected roots,
+// in the same order, and that the data of every block hashes to its Cid.
+// Blocks are read one at a time, so the CAR file isn't buffered in memory.
+// It returns an error describing the first mismatch.
+func ValidateCar(r io.Reader, expectedRoots []cid.Cid) error {
+	checkRoots := func(h *car.CarHeader) error {
+		if len(h.Roots) != len(expectedRoots) {
+			return fmt.Errorf("car has %d roots, expected %d", len(h.Roots), len(expectedRoots))
+		}
+		for i, c := range h.Roots {
+			if !c.Equals(expectedRoots[i]) {
+				return fmt.Errorf("car root %d is %s, expected %s", i, c, expectedRoots[i])
+			}
+		}
+		return nil
+	}
+	return readValidatedCar(r, checkRoots, func(cid.Cid, []byte) error { return nil })
+}
+
+// readValidatedCar reads a CAR file calling onHeader with its header, and
+// onBlock with every block after checking its data hashes to its Cid.
+// Blocks are read one at a time, so the CAR file isn't buffered in memory.
+func readValidatedCar(r io.Reader, onHeader func(*car.CarHeader) error, onBlock func(cid.Cid, []byte) error) error {
+	cr := &offsetReader{r: bufio.NewReader(r), maxSection: maxCarSectionSize}
+	hb, err := cr.readSection()
+	if err != nil {
+		return fmt.Errorf("reading car header: %s", err)
+	}
+	h, err := car.ReadHeader(bufio.NewReader(bytes.NewReader(ldBytes(hb))))
+	if err != nil {
+		return fmt.Errorf("decoding car header: %s", err)
+	}
+	if h.Version != 1 {
+		return fmt.Errorf("unsupported car version %d", h.Version)
+	}
+	if err := onHeader(h); err != nil {
+		return err
+	}
+
+	for {
+		offset := cr.offset
+		data, err := cr.readSection()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading block at offset %d: %s", offset, err)
+		}
+		n, c, err := cid.CidFromBytes(data)
+		if err != nil {
+			return fmt.Errorf("decoding cid of block at offset %d: %s", offset, err)
+		}
+		sum, err := c.Prefix().Sum(data[n:])
+		if err != nil {
+			return fmt.Errorf("hashing block %s at offset %d: %s", c, offset, err)
+		}
+		if !bytes.Equal(sum.Hash(), c.Hash()) {
+			return fmt.Errorf("data of block %s at offset %d doesn't match its cid", c, offset)
+		}
+		if err := onBlock(c, data[n:]); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package dataprep
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/ipld/go-car"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCar(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dag := dstest.Mock()
+	root := dagifyRandomFile(t, dag, 1<<20)
+	var buf bytes.Buffer
+	require.NoError(t, car.WriteCar(ctx, dag, []cid.Cid{root}, &buf))
+	data := buf.Bytes()
+
+	t.Run("Valid", func(t *testing.T) {
+		t.Parallel()
+		require.NoError(t, ValidateCar(bytes.NewReader(data), []cid.Cid{root}))
+	})
+
+	t.Run("CorruptedBlock", func(t *testing.T) {
+		t.Parallel()
+		corrupted := append([]byte(nil), data...)
+		// The last byte is data of the last block.
+		corrupted[len(corrupted)-1] ^= 0xff
+		err := ValidateCar(bytes.NewReader(corrupted), []cid.Cid{root})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "doesn't match its cid")
+	})
+
+	t.Run("UnexpectedRoots", func(t *testing.T) {
+		t.Parallel()
+		other := dagifyRandomFile(t, dstest.Mock(), 1<<10)
+		require.Error(t, ValidateCar(bytes.NewReader(data), []cid.Cid{other}))
+		require.Error(t, ValidateCar(bytes.NewReader(data), []cid.Cid{root, other}))
+		require.Error(t, ValidateCar(bytes.NewReader(data), nil))
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		t.Parallel()
+		require.Error(t, ValidateCar(bytes.NewReader(data[:len(data)-10]), []cid.Cid{root}))
+	})
+}
@@ -0,0 +1,108 @@
+package dataprep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	blocks "github.com/ipfs/go-block-format"
+	bsrv "github.com/ipfs/go-blockservice"
+	"github.com/ipfs/go-cid"
+	badger "github.com/ipfs/go-ds-badger2"
+	blockstore "github.com/ipfs/go-ipfs-blockstore"
+	offline "github.com/ipfs/go-ipfs-exchange-offline"
+	dag "github.com/ipfs/go-merkledag"
+	"github.com/ipld/go-car"
+)
+
+// ErrMissingBlock is returned when a CAR file doesn't contain a block
+// linked from its root.
+var ErrMissingBlock = errors.New("block missing from car")
+
+// ImportVerifiedCar imports the blocks of a CAR file into a store after
+// verifying that expectedRoot is one of its roots, that the data of every
+// block hashes to its Cid, and that every block linked from expectedRoot
+// is contained in the CAR file. Blocks are staged in a temporary on-disk
+// blockstore until the DAG is verified, so nothing is imported from an
+// incomplete CAR file. Only the blocks of the DAG of expectedRoot are
+// imported. A missing block fails with an error wrapping ErrMissingBlock
+// which includes its Cid.
+func ImportVerifiedCar(ctx context.Context, s car.Store, r io.Reader, expectedRoot cid.Cid) error {
+	dir, err := ioutil.TempDir("", "car-import-*")
+	if err != nil {
+		return fmt.Errorf("creating staging dir: %s", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	sds, err := badger.NewDatastore(dir, &badger.DefaultOptions)
+	if err != nil {
+		return fmt.Errorf("creating staging datastore: %s", err)
+	}
+	defer func() { _ = sds.Close() }()
+	bs := blockstore.NewBlockstore(sds)
+
+	checkRoot := func(h *car.CarHeader) error {
+		for _, c := range h.Roots {
+			if c.Equals(expectedRoot) {
+				return nil
+			}
+		}
+		return fmt.Errorf("car roots %v don't include %s", h.Roots, expectedRoot)
+	}
+	stage := func(c cid.Cid, data []byte) error {
+		b, err := blocks.NewBlockWithCid(data, c)
+		if err != nil {
+			return fmt.Errorf("creating block %s: %s", c, err)
+		}
+		if err := bs.Put(b); err != nil {
+			return fmt.Errorf("staging block %s: %s", c, err)
+		}
+		return ctx.Err()
+	}
+	if err := readValidatedCar(r, checkRoot, stage); err != nil {
+		return err
+	}
+
+	ng := dag.NewDAGService(bsrv.New(bs, offline.Exchange(bs)))
+	visited := cid.NewSet()
+	var reachable []cid.Cid
+	pending := []cid.Cid{expectedRoot}
+	for len(pending) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		c := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if !visited.Visit(c) {
+			continue
+		}
+		ok, err := bs.Has(c)
+		if err != nil {
+			return fmt.Errorf("checking block %s: %s", c, err)
+		}
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrMissingBlock, c)
+		}
+		nd, err := ng.Get(ctx, c)
+		if err != nil {
+			return fmt.Errorf("decoding block %s: %s", c, err)
+		}
+		reachable = append(reachable, c)
+		for _, l := range nd.Links() {
+			pending = append(pending, l.Cid)
+		}
+	}
+
+	for _, c := range reachable {
+		b, err := bs.Get(c)
+		if err != nil {
+			return fmt.Errorf("getting block %s: %s", c, err)
+		}
+		if err := s.Put(b); err != nil {
+			return fmt.Errorf("importing block %s: %s", c, err)
+		}
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,114 @@
+package dataprep
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	blockstore "github.com/ipfs/go-ipfs-blockstore"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/ipld/go-car"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportVerifiedCar(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dag := dstest.Mock()
+	root := dagifyRandomFile(t, dag, 1<<20)
+	cids := allCids(t, dag, root)
+	require.Greater(t, len(cids), 2)
+
+	t.Run("Complete", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		require.NoError(t, car.WriteCar(ctx, dag, []cid.Cid{root}, &buf))
+		bs := blockstore.NewBlockstore(dssync.MutexWrap(ds.NewMapDatastore()))
+		require.NoError(t, ImportVerifiedCar(ctx, bs, &buf, root))
+		for _, c := range cids {
+			ok, err := bs.Has(c)
+			require.NoError(t, err)
+			require.True(t, ok)
+		}
+	})
+
+	t.Run("MissingBlock", func(t *testing.T) {
+		t.Parallel()
+		missing := cids[len(cids)/2]
+		var buf bytes.Buffer
+		require.NoError(t, car.WriteHeader(&car.CarHeader{Roots: []cid.Cid{root}, Version: 1}, &buf))
+		for _, c := range cids {
+			if c.Equals(missing) {
+				continue
+			}
+			nd, err := dag.Get(ctx, c)
+			require.NoError(t, err)
+			buf.Write(ldBytes(append(c.Bytes(), nd.RawData()...)))
+		}
+
+		bs := blockstore.NewBlockstore(dssync.MutexWrap(ds.NewMapDatastore()))
+		err := ImportVerifiedCar(ctx, bs, &buf, root)
+		require.ErrorIs(t, err, ErrMissingBlock)
+		require.Contains(t, err.Error(), missing.String())
+		// Nothing is imported from an incomplete car.
+		ok, err := bs.Has(root)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("UnreachableBlock", func(t *testing.T) {
+		t.Parallel()
+		other := dstest.Mock()
+		extra := dagifyRandomFile(t, other, 1<<10)
+		extraNd, err := other.Get(ctx, extra)
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		require.NoError(t, car.WriteCar(ctx, dag, []cid.Cid{root}, &buf))
+		buf.Write(ldBytes(append(extra.Bytes(), extraNd.RawData()...)))
+
+		bs := blockstore.NewBlockstore(dssync.MutexWrap(ds.NewMapDatastore()))
+		require.NoError(t, ImportVerifiedCar(ctx, bs, &buf, root))
+		ok, err := bs.Has(root)
+		require.NoError(t, err)
+		require.True(t, ok)
+		// Blocks not linked from the root aren't imported.
+		ok, err = bs.Has(extra)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("CorruptedBlock", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		require.NoError(t, car.WriteHeader(&car.CarHeader{Roots: []cid.Cid{root}, Version: 1}, &buf))
+		for i, c := range cids {
+			nd, err := dag.Get(ctx, c)
+			require.NoError(t, err)
+			data := append([]byte(nil), nd.RawData()...)
+			if i == len(cids)/2 {
+				data[len(data)-1] ^= 0xff
+			}
+			buf.Write(ldBytes(append(c.Bytes(), data...)))
+		}
+
+		bs := blockstore.NewBlockstore(dssync.MutexWrap(ds.NewMapDatastore()))
+		err := ImportVerifiedCar(ctx, bs, &buf, root)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), cids[len(cids)/2].String())
+		ok, err := bs.Has(root)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("UnexpectedRoot", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		require.NoError(t, car.WriteCar(ctx, dag, []cid.Cid{root}, &buf))
+		bs := blockstore.NewBlockstore(dssync.MutexWrap(ds.NewMapDatastore()))
+		require.Error(t, ImportVerifiedCar(ctx, bs, &buf, cids[1]))
+	})
+}
@@ -0,0 +1,62 @@
+package dataprep
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+)
+
+// DagStat describes the block and link structure of a DAG.
+type DagStat struct {
+	// Blocks is the number of distinct blocks in the DAG.
+	Blocks int
+	// Size is the total size in bytes of the distinct blocks.
+	Size uint64
+	// Depth is the number of blocks in the longest path from
+	// the root to a leaf. A single block DAG has depth 1.
+	Depth int
+	// FanOut maps a number of links to the number of blocks
+	// having that many links.
+	FanOut map[int]int
+}
+
+// Stat walks the DAG of root and returns its DagStat. Blocks linked
+// more than once are only accounted once.
+func Stat(ctx context.Context, ng ipld.NodeGetter, root cid.Cid) (DagStat, error) {
+	ds := DagStat{FanOut: map[int]int{}}
+	depths := map[cid.Cid]int{}
+	var walk func(c cid.Cid) (int, error)
+	walk = func(c cid.Cid) (int, error) {
+		if d, ok := depths[c]; ok {
+			return d, nil
+		}
+		nd, err := ng.Get(ctx, c)
+		if err != nil {
+			return 0, fmt.Errorf("getting node %s: %s", c, err)
+		}
+		ds.Blocks++
+		ds.Size += uint64(len(nd.RawData()))
+		ds.FanOut[len(nd.Links())]++
+
+		var maxChild int
+		for _, l := range nd.Links() {
+			d, err := walk(l.Cid)
+			if err != nil {
+				return 0, err
+			}
+			if d > maxChild {
+				maxChild = d
+			}
+		}
+		depths[c] = maxChild + 1
+		return maxChild + 1, nil
+	}
+	depth, err := walk(root)
+	if err != nil {
+		return DagStat{}, err
+	}
+	ds.Depth = depth
+	return ds, nil
+}
@@ -0,0 +1,53 @@
+package dataprep
+
+import (
+	"context"
+	"testing"
+
+	ipld "github.com/ipfs/go-ipld-format"
+	dag "github.com/ipfs/go-merkledag"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStat(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ds := dstest.Mock()
+
+	// root -> {a, b}, a -> {leaf1, leaf2}, b -> {leaf2}
+	leaf1 := dag.NodeWithData([]byte("leaf1"))
+	leaf2 := dag.NodeWithData([]byte("leaf2"))
+	a := linked(t, "a", leaf1, leaf2)
+	b := linked(t, "b", leaf2)
+	root := linked(t, "root", a, b)
+	nds := []ipld.Node{leaf1, leaf2, a, b, root}
+	require.NoError(t, ds.AddMany(ctx, nds))
+
+	st, err := Stat(ctx, ds, root.Cid())
+	require.NoError(t, err)
+	require.Equal(t, 5, st.Blocks)
+	var size uint64
+	for _, nd := range nds {
+		size += uint64(len(nd.RawData()))
+	}
+	require.Equal(t, size, st.Size)
+	require.Equal(t, 3, st.Depth)
+	require.Equal(t, map[int]int{0: 2, 1: 1, 2: 2}, st.FanOut)
+
+	st, err = Stat(ctx, ds, leaf1.Cid())
+	require.NoError(t, err)
+	require.Equal(t, DagStat{Blocks: 1, Size: uint64(len(leaf1.RawData())), Depth: 1, FanOut: map[int]int{0: 1}}, st)
+
+	require.NoError(t, ds.Remove(ctx, leaf2.Cid()))
+	_, err = Stat(ctx, ds, root.Cid())
+	require.Error(t, err)
+}
+
+func linked(t *testing.T, data string, children ...ipld.Node) *dag.ProtoNode {
+	nd := dag.NodeWithData([]byte(data))
+	for i, c := range children {
+		require.NoError(t, nd.AddNodeLink(string(rune('a'+i)), c))
+	}
+	return nd
+}
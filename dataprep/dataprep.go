@@ -35,9 +35,23 @@ func CommP(r io.Reader) (cid.Cid, uint64, error) {
 	return pieceCid, pieceSize, nil
 }
 
-// Dagify creates a UnixFS DAG from the provided file.
-func Dagify(ctx context.Context, dagService ipld.DAGService, path string, progressBytes chan<- int64) (cid.Cid, error) {
-	fileAdder, err := coreunix.NewAdder(ctx, nil, nil, dagService)
+// Dagify creates a UnixFS DAG from the provided file. At most
+// DefaultMaxBufferedBlocks blocks and DefaultMaxBufferedBytes bytes are
+// kept in memory while importing, which can be changed with
+// WithMaxBufferedBlocks and WithMaxBufferedBytes.
+func Dagify(ctx context.Context, dagService ipld.DAGService, path string, progressBytes chan<- int64, opts ...DagifyOption) (cid.Cid, error) {
+	cfg := dagifyConfig{
+		maxBufferedBlocks: DefaultMaxBufferedBlocks,
+		maxBufferedBytes:  DefaultMaxBufferedBytes,
+	}
+	for _, o := range opts {
+		if err := o(&cfg); err != nil {
+			return cid.Undef, fmt.Errorf("applying option: %s", err)
+		}
+	}
+	bufferedDAG := newBoundedDAG(dagService, cfg.maxBufferedBlocks, cfg.maxBufferedBytes)
+
+	fileAdder, err := coreunix.NewAdder(ctx, nil, nil, bufferedDAG)
 	if err != nil {
 		return cid.Undef, fmt.Errorf("creating unixfs adder: %s", err)
 	}
@@ -98,6 +112,9 @@ func Dagify(ctx context.Context, dagService ipld.DAGService, path string, progre
 	if dagifyErr != nil {
 		return cid.Undef, fmt.Errorf("creating dag for data: %s", dagifyErr)
 	}
+	if err := bufferedDAG.Flush(ctx); err != nil {
+		return cid.Undef, fmt.Errorf("flushing imported blocks: %s", err)
+	}
 
 	return dataCid, nil
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/filecoin-project/go-address"
@@ -19,13 +21,51 @@ import (
 
 const (
 	defaultDealStartOffset = 48 * 60 * 60 / util.EpochDurationSeconds // 48hs
+	liveAskTimeout         = time.Second * 20
+	// marketMsgConfidence is the number of epochs waited after a market
+	// balance message is on-chain, the same as Lotus does.
+	marketMsgConfidence = 5
+
+	// dealStartRetryMargin is the number of epochs added to the start
+	// epoch of a proposal retried after the miner rejected it for
+	// starting too soon.
+	dealStartRetryMargin = 6 * 60 * 60 / util.EpochDurationSeconds // 6hs
+	// proposalRetryWindow is how long a final proposal can be retried.
+	proposalRetryWindow = time.Minute * 10
 )
 
+// startEpochRejections are parts of the messages of deals rejected by
+// miners because their start epoch is too soon.
+var startEpochRejections = []string{
+	"deal start epoch is too soon",
+	"cannot seal a sector before",
+	"has already elapsed",
+}
+
 var (
 	// ErrDealNotFound indicates a particular ProposalCid from a deal isn't found on-chain. Currently,
 	// in Lotus this indicates that it may never existed on-chain, or it existed but it already expired
 	// (currEpoch > StartEpoch+Duration).
 	ErrDealNotFound = errors.New("deal not found on-chain")
+
+	// ErrAskAboveMaxPrice indicates the miner's live ask price is higher than
+	// the maximum price allowed for the deal, usually because it changed
+	// after the miner was selected.
+	ErrAskAboveMaxPrice = errors.New("miner ask price is above max price")
+
+	// ErrCollateralShortfall indicates the client available market funds
+	// don't cover the payment of a deal, or the miner ones don't cover its
+	// provider collateral, so it would fail to be published and never be
+	// activated.
+	ErrCollateralShortfall = errors.New("insufficient market funds for deal")
+
+	// ErrDealStartOffsetTooShort indicates the deal start offset doesn't
+	// leave miners enough time to seal the deal.
+	ErrDealStartOffsetTooShort = errors.New("deal start offset is too short")
+
+	// ErrProposalNotRetriable indicates a proposal can't be retried, since
+	// it was already retried or it wasn't started by this module instance.
+	ErrProposalNotRetriable = errors.New("proposal can't be retried")
 )
 
 // Store create Deal Proposals with all miners indicated in dcfgs. The epoch price
@@ -49,6 +89,7 @@ func (m *Module) Store(ctx context.Context, waddr string, dataCid cid.Cid, dataS
 	if err != nil {
 		return nil, fmt.Errorf("getting chaing head: %s", err)
 	}
+	funds := &marketFunds{addr: addr, topUp: m.cfg.CollateralTopUp}
 	res := make([]deals.StoreResult, len(dcfgs))
 	for i, c := range dcfgs {
 		maddr, err := address.NewFromString(c.Miner)
@@ -59,27 +100,54 @@ func (m *Module) Store(ctx context.Context, waddr string, dataCid cid.Cid, dataS
 			}
 			continue
 		}
-		dealStartOffset := c.DealStartOffset
-		if dealStartOffset == 0 {
-			dealStartOffset = defaultDealStartOffset
-		}
-		params := &api.StartDealParams{
-			Data: &storagemarket.DataRef{
-				TransferType: storagemarket.TTGraphsync,
-				Root:         dataCid,
-				PieceCid:     &pieceCid,
-				PieceSize:    pieceSize.Unpadded(),
-			},
-			MinBlocksDuration: minDuration,
-			EpochPrice:        big.Div(big.Mul(big.NewIntUnsigned(c.EpochPrice), big.NewIntUnsigned(uint64(pieceSize))), abi.NewTokenAmount(1<<30)),
-			Miner:             maddr,
-			Wallet:            addr,
-			FastRetrieval:     c.FastRetrieval,
-			DealStartEpoch:    ts.Height() + abi.ChainEpoch(dealStartOffset),
-			VerifiedDeal:      c.VerifiedDeal,
+		if c.MaxPrice > 0 {
+			if err := checkLiveAsk(ctx, lapi, maddr, c); err != nil {
+				log.Warnf("skipping proposal to miner %s: %s", c.Miner, err)
+				res[i] = deals.StoreResult{
+					Config:  c,
+					Message: err.Error(),
+				}
+				continue
+			}
+		}
+		params, err := startDealParams(addr, maddr, ts.Height(), dataCid, pieceSize, pieceCid, c, minDuration, m.cfg.MinDealStartOffset)
+		if err != nil {
+			log.Warnf("skipping proposal to miner %s: %s", c.Miner, err)
+			res[i] = deals.StoreResult{
+				Config:  c,
+				Message: err.Error(),
+			}
+			continue
+		}
+		if err := checkProviderCollateral(ctx, lapi, maddr, pieceSize, c.VerifiedDeal); err != nil {
+			log.Warnf("skipping proposal to miner %s: %s", c.Miner, err)
+			res[i] = deals.StoreResult{
+				Config:  c,
+				Message: err.Error(),
+			}
+			continue
+		}
+		required := big.Mul(params.EpochPrice, big.NewIntUnsigned(minDuration))
+		if err := funds.reserve(ctx, lapi, required); err != nil {
+			log.Warnf("skipping proposal to miner %s: %s", c.Miner, err)
+			res[i] = deals.StoreResult{
+				Config:  c,
+				Message: err.Error(),
+			}
+			continue
+		}
+		// The slot is released when the data transfer of the deal finishes.
+		release, err := m.transfers.acquire(ctx)
+		if err != nil {
+			res[i] = deals.StoreResult{
+				Config:  c,
+				Message: fmt.Sprintf("waiting for a data transfer slot: %s", err),
+			}
+			continue
 		}
 		p, err := lapi.ClientStartDeal(ctx, params)
 		if err != nil {
+			release()
 			log.Errorf("starting deal with %v: %s", c, err)
 			res[i] = deals.StoreResult{
 				Config:  c,
@@ -92,12 +160,254 @@ func (m *Module) Store(ctx context.Context, waddr string, dataCid cid.Cid, dataS
 			ProposalCid: *p,
 			Success:     true,
 		}
-		m.recordDeal(params, *p, dataSize)
+		m.proposals.put(*p, startedProposal{params: *params, height: ts.Height(), dataSize: dataSize})
+		m.recordDeal(params, *p, dataSize, release)
+		m.dealWatcher.SetDealClient(*p, waddr)
 	}
 
 	return res, nil
 }
 
+// IsStartEpochRejection returns true if the deal failed because the miner
+// rejected its start epoch as too soon, so it can be retried with
+// RetryProposal.
+func IsStartEpochRejection(di deals.StorageDealInfo) bool {
+	switch di.StateID {
+	case storagemarket.StorageDealProposalRejected, storagemarket.StorageDealFailing, storagemarket.StorageDealError:
+	default:
+		return false
+	}
+	for _, r := range startEpochRejections {
+		if strings.Contains(di.Message, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryProposal proposes again a deal rejected by the miner because its
+// start epoch was too soon, with a later start epoch recomputed from the
+// current chain height. Each proposal started by Store can be retried once,
+// and the returned proposal can't be retried; otherwise it returns
+// ErrProposalNotRetriable.
+func (m *Module) RetryProposal(ctx context.Context, proposal cid.Cid) (cid.Cid, error) {
+	sp, ok := m.proposals.take(proposal)
+	if !ok {
+		return cid.Undef, ErrProposalNotRetriable
+	}
+	lapi, cls, err := m.clientBuilder(ctx)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+	ts, err := lapi.ChainHead(ctx)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("getting chain head: %s", err)
+	}
+	params := sp.params
+	params.DealStartEpoch = retryStartEpoch(ts.Height(), sp.height, sp.params.DealStartEpoch)
+	log.Warnf("retrying proposal %s to miner %s with start epoch %d instead of %d", proposal, params.Miner, params.DealStartEpoch, sp.params.DealStartEpoch)
+
+	release, err := m.transfers.acquire(ctx)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("waiting for a data transfer slot: %s", err)
+	}
+	p, err := lapi.ClientStartDeal(ctx, &params)
+	if err != nil {
+		release()
+		return cid.Undef, fmt.Errorf("starting deal: %s", err)
+	}
+	m.recordDeal(&params, *p, sp.dataSize, release)
+	m.dealWatcher.SetDealClient(*p, params.Wallet.String())
+	return *p, nil
+}
+
+// retryStartEpoch returns the start epoch of a retried proposal at height,
+// which keeps the offset of the original proposal made at origHeight with
+// origStart, plus a margin.
+func retryStartEpoch(height, origHeight, origStart abi.ChainEpoch) abi.ChainEpoch {
+	return height + (origStart - origHeight) + dealStartRetryMargin
+}
+
+// startedProposal has what's needed to retry a started proposal.
+type startedProposal struct {
+	params   api.StartDealParams
+	height   abi.ChainEpoch
+	dataSize int64
+}
+
+// startedProposals keeps started proposals which can still be retried.
+type startedProposals struct {
+	lock      sync.Mutex
+	proposals map[cid.Cid]startedProposal
+}
+
+func newStartedProposals() *startedProposals {
+	return &startedProposals{proposals: make(map[cid.Cid]startedProposal)}
+}
+
+func (sp *startedProposals) put(proposal cid.Cid, p startedProposal) {
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+	sp.proposals[proposal] = p
+}
+
+// take returns and forgets a started proposal.
+func (sp *startedProposals) take(proposal cid.Cid) (startedProposal, bool) {
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+	p, ok := sp.proposals[proposal]
+	delete(sp.proposals, proposal)
+	return p, ok
+}
+
+// startDealParams returns the parameters to start a deal with the miner
+// maddr at the provided chain height. The deal start offset can't be lower
+// than minStartOffset.
+func startDealParams(addr, maddr address.Address, height abi.ChainEpoch, dataCid cid.Cid, pieceSize abi.PaddedPieceSize, pieceCid cid.Cid, c deals.StorageDealConfig, minDuration uint64, minStartOffset int64) (*api.StartDealParams, error) {
+	startEpoch, err := dealStartEpoch(height, c.DealStartOffset, minStartOffset)
+	if err != nil {
+		return nil, err
+	}
+	return &api.StartDealParams{
+		Data: &storagemarket.DataRef{
+			TransferType: storagemarket.TTGraphsync,
+			Root:         dataCid,
+			PieceCid:     &pieceCid,
+			PieceSize:    pieceSize.Unpadded(),
+		},
+		MinBlocksDuration: minDuration,
+		EpochPrice:        big.Div(big.Mul(big.NewIntUnsigned(c.EpochPrice), big.NewIntUnsigned(uint64(pieceSize))), abi.NewTokenAmount(1<<30)),
+		Miner:             maddr,
+		Wallet:            addr,
+		FastRetrieval:     c.FastRetrieval,
+		DealStartEpoch:    startEpoch,
+		VerifiedDeal:      c.VerifiedDeal,
+	}, nil
+}
+
+// dealStartEpoch returns the start epoch of a deal proposed at height with
+// the provided start offset. A zero offset uses the default one, or the
+// minimum if it's greater.
+func dealStartEpoch(height abi.ChainEpoch, offset, minOffset int64) (abi.ChainEpoch, error) {
+	if offset == 0 {
+		offset = defaultDealStartOffset
+		if offset < minOffset {
+			offset = minOffset
+		}
+	}
+	if offset < minOffset {
+		return 0, fmt.Errorf("%w: %d epochs is lower than the minimum %d", ErrDealStartOffsetTooShort, offset, minOffset)
+	}
+	return height + abi.ChainEpoch(offset), nil
+}
+
+// checkLiveAsk queries the current ask of the miner and returns an error if
+// its price exceeds the deal max price.
+func checkLiveAsk(ctx context.Context, lapi *api.FullNodeStruct, maddr address.Address, c deals.StorageDealConfig) error {
+	ctx, cancel := context.WithTimeout(ctx, liveAskTimeout)
+	defer cancel()
+	mi, err := lapi.StateMinerInfo(ctx, maddr, types.EmptyTSK)
+	if err != nil {
+		return fmt.Errorf("getting miner info: %s", err)
+	}
+	if mi.PeerId == nil {
+		return fmt.Errorf("miner doesn't specify a peer id")
+	}
+	sask, err := lapi.ClientQueryAsk(ctx, *mi.PeerId, maddr)
+	if err != nil {
+		return fmt.Errorf("querying miner ask: %s", err)
+	}
+	price := sask.Price.Uint64()
+	if c.VerifiedDeal {
+		price = sask.VerifiedPrice.Uint64()
+	}
+	if price > c.MaxPrice {
+		return fmt.Errorf("%w: %d > %d", ErrAskAboveMaxPrice, price, c.MaxPrice)
+	}
+	return nil
+}
+
+// marketFunds tracks the client market funds committed to the deals
+// proposed in a single Store call.
+type marketFunds struct {
+	addr      address.Address
+	topUp     bool
+	available *abi.TokenAmount
+	committed abi.TokenAmount
+}
+
+// reserve verifies the client has enough available market funds to
+// cover required, considering the funds already committed to other
+// proposals. Any shortfall is added to the market balance if topUp is
+// enabled, or else ErrCollateralShortfall is returned.
+func (mf *marketFunds) reserve(ctx context.Context, lapi *api.FullNodeStruct, required abi.TokenAmount) error {
+	if mf.available == nil {
+		bal, err := lapi.StateMarketBalance(ctx, mf.addr, types.EmptyTSK)
+		if err != nil {
+			return fmt.Errorf("getting market balance: %s", err)
+		}
+		reserved, err := lapi.MarketGetReserved(ctx, mf.addr)
+		if err != nil {
+			return fmt.Errorf("getting reserved market funds: %s", err)
+		}
+		available := big.Sub(big.Sub(bal.Escrow, bal.Locked), reserved)
+		if available.LessThan(big.Zero()) {
+			available = big.Zero()
+		}
+		mf.available = &available
+		mf.committed = big.Zero()
+	}
+
+	committed := big.Add(mf.committed, required)
+	if shortfall := big.Sub(committed, *mf.available); shortfall.GreaterThan(big.Zero()) {
+		if !mf.topUp {
+			return fmt.Errorf("%w: available %s, required %s", ErrCollateralShortfall, types.FIL(big.Sub(*mf.available, mf.committed)), types.FIL(required))
+		}
+		msg, err := lapi.MarketAddBalance(ctx, mf.addr, mf.addr, shortfall)
+		if err != nil {
+			return fmt.Errorf("adding %s to market balance: %s", types.FIL(shortfall), err)
+		}
+		// The added balance is only available to the deal once the
+		// message is on-chain. No message is pushed if the funds are
+		// already being added.
+		if msg.Defined() {
+			lookup, err := lapi.StateWaitMsg(ctx, msg, marketMsgConfidence, api.LookbackNoLimit, true)
+			if err != nil {
+				return fmt.Errorf("waiting for market balance message %s: %s", msg, err)
+			}
+			if !lookup.Receipt.ExitCode.IsSuccess() {
+				return fmt.Errorf("market balance message %s failed with exit code %d", msg, lookup.Receipt.ExitCode)
+			}
+		}
+		log.Infof("added %s to market balance of %s to cover deal", types.FIL(shortfall), mf.addr)
+		available := big.Add(*mf.available, shortfall)
+		mf.available = &available
+	}
+	mf.committed = committed
+	return nil
+}
+
+// checkProviderCollateral verifies the miner available market funds
+// cover the minimum provider collateral of a deal of pieceSize, which
+// the miner locks when publishing it. Otherwise it returns
+// ErrCollateralShortfall.
+func checkProviderCollateral(ctx context.Context, lapi *api.FullNodeStruct, maddr address.Address, pieceSize abi.PaddedPieceSize, verified bool) error {
+	bounds, err := lapi.StateDealProviderCollateralBounds(ctx, pieceSize, verified, types.EmptyTSK)
+	if err != nil {
+		return fmt.Errorf("getting provider collateral bounds: %s", err)
+	}
+	bal, err := lapi.StateMarketBalance(ctx, maddr, types.EmptyTSK)
+	if err != nil {
+		return fmt.Errorf("getting miner market balance: %s", err)
+	}
+	available := big.Sub(bal.Escrow, bal.Locked)
+	if available.LessThan(bounds.Min) {
+		return fmt.Errorf("%w: miner available %s, provider collateral %s", ErrCollateralShortfall, types.FIL(available), types.FIL(bounds.Min))
+	}
+	return nil
+}
+
 // Watch returns a channel with state changes of indicated proposals.
 func (m *Module) Watch(ctx context.Context, proposal cid.Cid) (<-chan deals.StorageDealInfo, error) {
 	updates := make(chan deals.StorageDealInfo)
@@ -123,12 +433,13 @@ func (m *Module) Watch(ctx context.Context, proposal cid.Cid) (<-chan deals.Stor
 		}
 		updates <- last
 
-		// Then notify every m.pollDuration
+		// Then notify every m.pollDuration, backing off if configured.
+		ps := newPollSchedule(m.pollDuration, m.cfg.PollBackoffMax)
 		for {
 			select {
 			case <-ctx.Done():
 				return
-			case <-time.After(m.pollDuration):
+			case <-time.After(ps.interval()):
 			case <-watcherUpdates:
 			}
 
@@ -140,6 +451,9 @@ func (m *Module) Watch(ctx context.Context, proposal cid.Cid) (<-chan deals.Stor
 			if last.StateID != sdi.StateID {
 				last = sdi
 				updates <- last
+				ps.reset()
+			} else {
+				ps.backoff()
 			}
 		}
 	}()
@@ -179,6 +493,9 @@ func fromLotusDealInfo(ctx context.Context, client *api.FullNodeStruct, dinfo *a
 		Duration:      dinfo.Duration,
 		DealID:        uint64(dinfo.DealID),
 		Message:       dinfo.Message,
+		Verified:      dinfo.Verified,
+
+		SealingProgress: sealingProgress(dinfo.State),
 	}
 	if dinfo.State == storagemarket.StorageDealActive {
 		ocd, err := client.StateMarketStorageDeal(ctx, dinfo.DealID, types.EmptyTSK)
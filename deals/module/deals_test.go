@@ -3,21 +3,32 @@ package module
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/filecoin-project/go-address"
+	datatransfer "github.com/filecoin-project/go-data-transfer"
 	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/exitcode"
 	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/actors/builtin/miner"
 	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/chain/types/mock"
 	"github.com/ipfs/go-cid"
 	logging "github.com/ipfs/go-log/v2"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/stretchr/testify/require"
 	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/deals/module/dealwatcher"
 	"github.com/textileio/powergate/v2/tests"
 	"github.com/textileio/powergate/v2/util"
 )
@@ -136,6 +147,165 @@ func TestRetrieve(t *testing.T) {
 	}
 }
 
+func TestCheckLiveAsk(t *testing.T) {
+	t.Parallel()
+	maddr, err := address.NewFromString("t01000")
+	require.NoError(t, err)
+
+	// The miner was selected with price 100, but the ask changed
+	// to 200 before the deal was proposed.
+	price := uint64(200)
+	var client api.FullNodeStruct
+	client.Internal.StateMinerInfo = func(context.Context, address.Address, types.TipSetKey) (miner.MinerInfo, error) {
+		pid := peer.ID("peer")
+		return miner.MinerInfo{PeerId: &pid}, nil
+	}
+	client.Internal.ClientQueryAsk = func(context.Context, peer.ID, address.Address) (*storagemarket.StorageAsk, error) {
+		return &storagemarket.StorageAsk{
+			Price:         abi.NewTokenAmount(int64(price)),
+			VerifiedPrice: abi.NewTokenAmount(0),
+		}, nil
+	}
+
+	cfg := deals.StorageDealConfig{Miner: "t01000", EpochPrice: 100, MaxPrice: 150}
+	err = checkLiveAsk(context.Background(), &client, maddr, cfg)
+	require.True(t, errors.Is(err, ErrAskAboveMaxPrice))
+
+	// Verified deals are checked against the verified price.
+	cfg.VerifiedDeal = true
+	require.NoError(t, checkLiveAsk(context.Background(), &client, maddr, cfg))
+
+	cfg.VerifiedDeal = false
+	price = 150
+	require.NoError(t, checkLiveAsk(context.Background(), &client, maddr, cfg))
+}
+
+func TestMarketFundsShortfall(t *testing.T) {
+	t.Parallel()
+	addr, err := address.NewFromString("t3qfoulel6fy6gn3hjmbhpdpf6fs5aqjb5fkurhtwvgssizq4jey5nw4ptq5up6h7jk7frdvvobv52qzmgjinq")
+	require.NoError(t, err)
+
+	var added []abi.TokenAmount
+	var client api.FullNodeStruct
+	client.Internal.StateMarketBalance = func(context.Context, address.Address, types.TipSetKey) (api.MarketBalance, error) {
+		return api.MarketBalance{Escrow: abi.NewTokenAmount(100), Locked: abi.NewTokenAmount(40)}, nil
+	}
+	client.Internal.MarketGetReserved = func(context.Context, address.Address) (types.BigInt, error) {
+		return abi.NewTokenAmount(10), nil
+	}
+	msg, err := cid.Decode("bafy2bzacea3wsdh6y3a36tb3skempjoxqpuyompjbmfeyf34fi3uy6uue42v4")
+	require.NoError(t, err)
+	var waited []cid.Cid
+	var exitCode exitcode.ExitCode
+	client.Internal.MarketAddBalance = func(_ context.Context, _, _ address.Address, amt types.BigInt) (cid.Cid, error) {
+		added = append(added, amt)
+		return msg, nil
+	}
+	client.Internal.StateWaitMsg = func(_ context.Context, c cid.Cid, _ uint64, _ abi.ChainEpoch, _ bool) (*api.MsgLookup, error) {
+		waited = append(waited, c)
+		return &api.MsgLookup{Message: c, Receipt: types.MessageReceipt{ExitCode: exitCode}}, nil
+	}
+	ctx := context.Background()
+
+	// 50 are available, so the second deal isn't covered.
+	mf := &marketFunds{addr: addr}
+	require.NoError(t, mf.reserve(ctx, &client, abi.NewTokenAmount(30)))
+	err = mf.reserve(ctx, &client, abi.NewTokenAmount(30))
+	require.True(t, errors.Is(err, ErrCollateralShortfall))
+	require.NoError(t, mf.reserve(ctx, &client, abi.NewTokenAmount(20)))
+	require.Empty(t, added)
+
+	// With top-up enabled, only the shortfall is added.
+	mf = &marketFunds{addr: addr, topUp: true}
+	require.NoError(t, mf.reserve(ctx, &client, abi.NewTokenAmount(30)))
+	require.NoError(t, mf.reserve(ctx, &client, abi.NewTokenAmount(30)))
+	require.NoError(t, mf.reserve(ctx, &client, abi.NewTokenAmount(5)))
+	require.Len(t, added, 2)
+	require.Equal(t, "10", added[0].String())
+	require.Equal(t, "5", added[1].String())
+	// Each top-up waits for its message to be on-chain.
+	require.Equal(t, []cid.Cid{msg, msg}, waited)
+
+	// A failed top-up doesn't cover the deal.
+	exitCode = exitcode.ErrInsufficientFunds
+	mf = &marketFunds{addr: addr, topUp: true}
+	require.NoError(t, mf.reserve(ctx, &client, abi.NewTokenAmount(50)))
+	require.Error(t, mf.reserve(ctx, &client, abi.NewTokenAmount(1)))
+}
+
+func TestProviderCollateral(t *testing.T) {
+	t.Parallel()
+	maddr, err := address.NewFromString("f01000")
+	require.NoError(t, err)
+
+	var client api.FullNodeStruct
+	client.Internal.StateDealProviderCollateralBounds = func(_ context.Context, size abi.PaddedPieceSize, verified bool, _ types.TipSetKey) (api.DealCollateralBounds, error) {
+		min := int64(size) / 1024
+		if verified {
+			min *= 10
+		}
+		return api.DealCollateralBounds{Min: abi.NewTokenAmount(min), Max: abi.NewTokenAmount(min * 2)}, nil
+	}
+	client.Internal.StateMarketBalance = func(context.Context, address.Address, types.TipSetKey) (api.MarketBalance, error) {
+		return api.MarketBalance{Escrow: abi.NewTokenAmount(100), Locked: abi.NewTokenAmount(40)}, nil
+	}
+	ctx := context.Background()
+
+	// The miner has 60 available.
+	require.NoError(t, checkProviderCollateral(ctx, &client, maddr, 60*1024, false))
+	err = checkProviderCollateral(ctx, &client, maddr, 61*1024, false)
+	require.True(t, errors.Is(err, ErrCollateralShortfall))
+	err = checkProviderCollateral(ctx, &client, maddr, 10*1024, true)
+	require.True(t, errors.Is(err, ErrCollateralShortfall))
+}
+
+func TestDealStartEpoch(t *testing.T) {
+	t.Parallel()
+	height := abi.ChainEpoch(1000)
+
+	se, err := dealStartEpoch(height, 0, util.MinDealStartOffset)
+	require.NoError(t, err)
+	require.Equal(t, height+defaultDealStartOffset, se)
+
+	se, err = dealStartEpoch(height, util.MinDealStartOffset+10, util.MinDealStartOffset)
+	require.NoError(t, err)
+	require.Equal(t, height+abi.ChainEpoch(util.MinDealStartOffset+10), se)
+
+	_, err = dealStartEpoch(height, util.MinDealStartOffset-1, util.MinDealStartOffset)
+	require.True(t, errors.Is(err, ErrDealStartOffsetTooShort))
+
+	// A configured minimum above the default offset is used instead.
+	minOffset := int64(defaultDealStartOffset + 100)
+	se, err = dealStartEpoch(height, 0, minOffset)
+	require.NoError(t, err)
+	require.Equal(t, height+abi.ChainEpoch(minOffset), se)
+
+	// Miners with a short sealing lead time can take sooner deals.
+	se, err = dealStartEpoch(height, 10, 10)
+	require.NoError(t, err)
+	require.Equal(t, height+10, se)
+}
+
+func TestSealingProgress(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		state    uint64
+		progress int
+	}{
+		{storagemarket.StorageDealTransferring, 0},
+		{storagemarket.StorageDealProposalAccepted, 0},
+		{storagemarket.StorageDealStaged, 10},
+		{storagemarket.StorageDealAwaitingPreCommit, 25},
+		{storagemarket.StorageDealSealing, 50},
+		{storagemarket.StorageDealFinalizing, 75},
+		{storagemarket.StorageDealActive, 100},
+		{storagemarket.StorageDealError, 0},
+	}
+	for _, tt := range cases {
+		require.Equal(t, tt.progress, sealingProgress(tt.state), storagemarket.DealStates[tt.state])
+	}
+}
+
 func storeMultiMiner(m *Module, client *api.FullNodeStruct, numMiners int, data []byte) (cid.Cid, []cid.Cid, error) {
 	ctx := context.Background()
 	miners, err := client.StateListMiners(ctx, types.EmptyTSK)
@@ -238,3 +408,264 @@ func randomBytesFromSource(size int, r *rand.Rand) []byte {
 	_, _ = r.Read(buf)
 	return buf
 }
+
+func TestPreviewProposal(t *testing.T) {
+	t.Parallel()
+	waddr := "t3qfoulel6fy6gn3hjmbhpdpf6fs5aqjb5fkurhtwvgssizq4jey5nw4ptq5up6h7jk7frdvvobv52qzmgjinq"
+	c, err := cid.Decode("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	require.NoError(t, err)
+
+	var sent *api.StartDealParams
+	var client api.FullNodeStruct
+	client.Internal.ChainHead = func(context.Context) (*types.TipSet, error) {
+		return mock.TipSet(mock.MkBlock(nil, 1, 1)), nil
+	}
+	client.Internal.StateMarketBalance = func(context.Context, address.Address, types.TipSetKey) (api.MarketBalance, error) {
+		return api.MarketBalance{Escrow: abi.NewTokenAmount(1 << 50), Locked: abi.NewTokenAmount(0)}, nil
+	}
+	client.Internal.MarketGetReserved = func(context.Context, address.Address) (types.BigInt, error) {
+		return abi.NewTokenAmount(0), nil
+	}
+	client.Internal.StateDealProviderCollateralBounds = func(context.Context, abi.PaddedPieceSize, bool, types.TipSetKey) (api.DealCollateralBounds, error) {
+		return api.DealCollateralBounds{Min: abi.NewTokenAmount(1), Max: abi.NewTokenAmount(2)}, nil
+	}
+	client.Internal.ClientStartDeal = func(_ context.Context, params *api.StartDealParams) (*cid.Cid, error) {
+		sent = params
+		return nil, errors.New("not sending")
+	}
+	cb := func(context.Context) (*api.FullNodeStruct, func(), error) {
+		return &client, func() {}, nil
+	}
+	m := &Module{clientBuilder: cb, cfg: &deals.Config{MinDealStartOffset: util.MinDealStartOffset}}
+
+	ctx := context.Background()
+	dcfg := deals.StorageDealConfig{Miner: "t01000", EpochPrice: 500000000, FastRetrieval: true}
+	pieceSize := abi.PaddedPieceSize(1 << 20)
+	preview, err := m.PreviewProposal(ctx, waddr, c, pieceSize, c, dcfg, util.MinDealDuration)
+	require.NoError(t, err)
+	require.Nil(t, sent)
+	require.Equal(t, "t01000", preview.Params.Miner.String())
+	require.Equal(t, waddr, preview.Params.Wallet.String())
+
+	// The preview matches the proposal started by Store.
+	res, err := m.Store(ctx, waddr, c, 1000, pieceSize, c, []deals.StorageDealConfig{dcfg}, util.MinDealDuration)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.False(t, res[0].Success)
+	require.NotNil(t, sent)
+	msg, err := json.Marshal(sent)
+	require.NoError(t, err)
+	require.Equal(t, string(msg), string(preview.Message))
+
+	_, err = m.PreviewProposal(ctx, waddr, c, pieceSize, c, deals.StorageDealConfig{Miner: "t01000", DealStartOffset: 1}, util.MinDealDuration)
+	require.True(t, errors.Is(err, ErrDealStartOffsetTooShort))
+}
+
+func TestStartDealRetry(t *testing.T) {
+	t.Parallel()
+	c, err := cid.Decode("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	require.NoError(t, err)
+
+	// Rejections are detected from the deal state and message.
+	rejected := deals.StorageDealInfo{
+		StateID: storagemarket.StorageDealFailing,
+		Message: "deal rejected: deal start epoch is too soon or deal already expired",
+	}
+	require.True(t, IsStartEpochRejection(rejected))
+	rejected.StateID = storagemarket.StorageDealProposalRejected
+	require.True(t, IsStartEpochRejection(rejected))
+	require.False(t, IsStartEpochRejection(deals.StorageDealInfo{StateID: storagemarket.StorageDealFailing, Message: "miner offline"}))
+	require.False(t, IsStartEpochRejection(deals.StorageDealInfo{StateID: storagemarket.StorageDealTransferring, Message: rejected.Message}))
+
+	// The retried start epoch keeps the original offset, plus a margin.
+	origStart := abi.ChainEpoch(100 + defaultDealStartOffset)
+	start := retryStartEpoch(150, 100, origStart)
+	require.Greater(t, int64(start), int64(origStart))
+	require.Equal(t, abi.ChainEpoch(150+defaultDealStartOffset+dealStartRetryMargin), start)
+
+	// A proposal can be retried only once.
+	sp := newStartedProposals()
+	sp.put(c, startedProposal{height: 100})
+	p, ok := sp.take(c)
+	require.True(t, ok)
+	require.Equal(t, abi.ChainEpoch(100), p.height)
+	_, ok = sp.take(c)
+	require.False(t, ok)
+}
+
+func TestWatchPollInterval(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		base       time.Duration
+		backoffMax time.Duration
+		gaps       []time.Duration
+	}{
+		{name: "Fixed", base: time.Millisecond * 50, gaps: []time.Duration{50, 50, 50, 50}},
+		{name: "Backoff", base: time.Millisecond * 20, backoffMax: time.Millisecond * 80, gaps: []time.Duration{20, 40, 80, 80}},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prop, err := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+			require.NoError(t, err)
+			polls := make(chan time.Time, len(tt.gaps)+1)
+			cb := func(context.Context) (*api.FullNodeStruct, func(), error) {
+				c := &api.FullNodeStruct{}
+				c.Internal.ClientGetDealUpdates = func(context.Context) (<-chan api.DealInfo, error) {
+					return make(chan api.DealInfo), nil
+				}
+				c.Internal.ClientGetDealInfo = func(_ context.Context, p cid.Cid) (*api.DealInfo, error) {
+					select {
+					case polls <- time.Now():
+					default:
+					}
+					return &api.DealInfo{ProposalCid: p, State: storagemarket.StorageDealSealing}, nil
+				}
+				return c, func() {}, nil
+			}
+			dw, err := dealwatcher.New(cb)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, dw.Close()) }()
+			m := &Module{
+				clientBuilder: cb,
+				cfg:           &deals.Config{PollBackoffMax: tt.backoffMax},
+				dealWatcher:   dw,
+				pollDuration:  tt.base,
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			updates, err := m.Watch(ctx, prop)
+			require.NoError(t, err)
+			<-updates
+
+			last := <-polls
+			for _, gap := range tt.gaps {
+				select {
+				case p := <-polls:
+					require.GreaterOrEqual(t, int64(p.Sub(last)), int64(gap*time.Millisecond))
+					last = p
+				case <-time.After(time.Second * 5):
+					t.Fatal("deal state wasn't polled")
+				}
+			}
+		})
+	}
+}
+
+func TestPollSchedule(t *testing.T) {
+	t.Parallel()
+
+	ps := newPollSchedule(time.Second, time.Second*5)
+	var got []time.Duration
+	for i := 0; i < 5; i++ {
+		got = append(got, ps.interval())
+		ps.backoff()
+	}
+	require.Equal(t, []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 5, time.Second * 5}, got)
+	ps.reset()
+	require.Equal(t, time.Second, ps.interval())
+
+	// Without a greater max, the interval is fixed.
+	ps = newPollSchedule(time.Second, 0)
+	ps.backoff()
+	require.Equal(t, time.Second, ps.interval())
+}
+
+func TestTrackDataTransferResume(t *testing.T) {
+	t.Parallel()
+
+	proposal, err := cid.Decode("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	require.NoError(t, err)
+	voucher, err := json.Marshal(dealTransferVoucher{Proposal: proposal})
+	require.NoError(t, err)
+	transfer := func(s datatransfer.Status, transferred uint64) api.DataTransferChannel {
+		return api.DataTransferChannel{TransferID: 7, Status: s, Voucher: string(voucher), IsInitiator: true, Transferred: transferred}
+	}
+	const stallTimeout = time.Millisecond * 200
+
+	track := func(maxRestarts int) (chan<- api.DataTransferChannel, <-chan dataTransferUpdate, <-chan error, *[]datatransfer.TransferID) {
+		ch := make(chan api.DataTransferChannel)
+		updates := make(chan dataTransferUpdate, 2)
+		var lock sync.Mutex
+		var restarted []datatransfer.TransferID
+		restart := func(_ context.Context, id datatransfer.TransferID, _ peer.ID, _ bool) error {
+			lock.Lock()
+			defer lock.Unlock()
+			restarted = append(restarted, id)
+			return nil
+		}
+		done := make(chan error, 1)
+		go func() {
+			done <- trackDataTransfer(context.Background(), ch, proposal, updates, maxRestarts, stallTimeout, restart)
+		}()
+		return ch, updates, done, &restarted
+	}
+
+	t.Run("Stalled", func(t *testing.T) {
+		t.Parallel()
+		ch, updates, done, restarted := track(1)
+
+		// The transfer stops making progress while ongoing, so it's
+		// restarted and resumes with the original start time.
+		ch <- transfer(datatransfer.Ongoing, 512)
+		ch <- transfer(datatransfer.Ongoing, 512)
+		time.Sleep(stallTimeout * 2)
+		ch <- transfer(datatransfer.Ongoing, 768)
+		ch <- transfer(datatransfer.Completed, 1024)
+		require.NoError(t, <-done)
+		require.Equal(t, []datatransfer.TransferID{7}, *restarted)
+
+		start := <-updates
+		require.False(t, start.start.IsZero())
+		end := <-updates
+		require.Equal(t, start.start, end.start)
+		require.False(t, end.end.IsZero())
+	})
+
+	t.Run("Progressing", func(t *testing.T) {
+		t.Parallel()
+		ch, _, done, restarted := track(1)
+		for i := uint64(1); i <= 4; i++ {
+			ch <- transfer(datatransfer.Ongoing, i*256)
+			time.Sleep(stallTimeout / 2)
+		}
+		ch <- transfer(datatransfer.Completed, 1024)
+		require.NoError(t, <-done)
+		require.Empty(t, *restarted)
+	})
+
+	t.Run("Paused", func(t *testing.T) {
+		t.Parallel()
+		ch, _, done, restarted := track(1)
+		ch <- transfer(datatransfer.Ongoing, 512)
+		ch <- transfer(datatransfer.ResponderPaused, 512)
+		time.Sleep(stallTimeout * 2)
+		ch <- transfer(datatransfer.Completed, 1024)
+		require.NoError(t, <-done)
+		require.Empty(t, *restarted)
+	})
+
+	t.Run("FailedIsFinal", func(t *testing.T) {
+		t.Parallel()
+		ch, _, done, restarted := track(1)
+		ch <- transfer(datatransfer.Ongoing, 512)
+		ch <- transfer(datatransfer.Failed, 512)
+		require.NoError(t, <-done)
+		require.Empty(t, *restarted)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		t.Parallel()
+		ch, _, done, restarted := track(0)
+		ch <- transfer(datatransfer.Ongoing, 512)
+		time.Sleep(stallTimeout * 2)
+		ch <- transfer(datatransfer.Completed, 1024)
+		require.NoError(t, <-done)
+		require.Empty(t, *restarted)
+	})
+}
@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
 	"github.com/filecoin-project/lotus/api"
 	"github.com/ipfs/go-cid"
 	logger "github.com/ipfs/go-log/v2"
 	"github.com/textileio/powergate/v2/lotus"
+	"github.com/textileio/powergate/v2/random"
 	"go.opentelemetry.io/otel/metric"
 )
 
@@ -21,55 +24,300 @@ var (
 	ErrNotFound = errors.New("subscription not found")
 	// ErrActiveSubscription is returned when an already registered channel is registered again.
 	ErrActiveSubscription = errors.New("active subscription")
+	// ErrNilChannel is returned when a nil channel is registered.
+	ErrNilChannel = errors.New("nil subscription channel")
+	// ErrTooManySubscribers is returned when a proposal already has the
+	// maximum number of subscribers.
+	ErrTooManySubscribers = errors.New("too many subscribers")
+	// ErrStale is returned by the health check when deal updates are
+	// expected but none were received recently.
+	ErrStale = errors.New("deal updates are stale")
 )
 
+// Option configures a DealWatcher.
+type Option func(*DealWatcher)
+
+// WithExpireTerminal makes the DealWatcher remove subscriptions of deals
+// reaching a terminal state, after notifying them of the final update.
+// It's disabled by default, so subscribers are kept until unsubscribed.
+func WithExpireTerminal(enabled bool) Option {
+	return func(dw *DealWatcher) {
+		dw.expireTerminal = enabled
+	}
+}
+
+// WithMaxSubscribers limits the number of channels subscribed to a single
+// proposal. Zero, the default, means no limit.
+func WithMaxSubscribers(max int) Option {
+	return func(dw *DealWatcher) {
+		dw.maxSubscribers = max
+	}
+}
+
+// WithLivenessCheck makes the DealWatcher poll the state of subscribed
+// deals which didn't receive updates in the last interval, in case the
+// Lotus updates stream stopped delivering them. Changed states are
+// notified as regular updates. Zero, the default, disables it.
+func WithLivenessCheck(interval time.Duration) Option {
+	return func(dw *DealWatcher) {
+		dw.livenessInterval = interval
+	}
+}
+
+// WithReconnectBackoff configures the delay between attempts to create
+// the Lotus deal updates channel when it closes unexpectedly. The delay
+// starts at min, doubles after every failed attempt up to max, and has
+// random jitter. The default is 30 seconds between attempts.
+func WithReconnectBackoff(min, max time.Duration) Option {
+	return func(dw *DealWatcher) {
+		dw.reconnectMin = min
+		dw.reconnectMax = max
+	}
+}
+
+// WithFailoverThreshold sets the number of consecutive failures of a
+// Lotus endpoint, either creating the updates channel or the channel
+// closing unexpectedly, after which the watcher rotates to the next
+// endpoint provided to NewWithFailover. The default is 3.
+func WithFailoverThreshold(n int) Option {
+	return func(dw *DealWatcher) {
+		dw.failoverThreshold = n
+	}
+}
+
+// WithClientAddrs restricts notifications to deals proposed by the provided
+// client wallet addresses. Since Lotus deal updates don't include the
+// client, the client of each proposal is registered with SetDealClient;
+// updates of proposals of other clients are dropped early. Updates of
+// proposals with an unknown client are processed as usual.
+func WithClientAddrs(addrs ...string) Option {
+	return func(dw *DealWatcher) {
+		dw.clientAddrs = make(map[string]struct{}, len(addrs))
+		for _, a := range addrs {
+			dw.clientAddrs[a] = struct{}{}
+		}
+	}
+}
+
+// WithRandSource sets the source of randomness used for the jitter of
+// reconnection delays. The default is random.Default.
+func WithRandSource(src random.Source) Option {
+	return func(dw *DealWatcher) {
+		dw.rand = src
+	}
+}
+
 // DealWatcher provides a centralize way to watch for deal updates.
 type DealWatcher struct {
-	cb lotus.ClientBuilder
+	// lastUpdate is the unix nano timestamp of the last received update.
+	// It and the following counters are accessed atomically, so they're
+	// kept first for 64-bit alignment.
+	lastUpdate     int64
+	reconnects     int64
+	droppedUpdates int64
+
+	expireTerminal    bool
+	maxSubscribers    int
+	livenessInterval  time.Duration
+	reconnectMin      time.Duration
+	reconnectMax      time.Duration
+	failoverThreshold int
+	clientAddrs       map[string]struct{}
+	rand              random.Source
+
+	endpointsLock sync.Mutex
+	endpoints     []lotus.ClientBuilder
+	endpoint      int
+	// endpointFailures is the number of consecutive failures of the
+	// current endpoint.
+	endpointFailures int
 
-	lock sync.Mutex
-	subs map[cid.Cid][]chan<- struct{}
+	clientsLock sync.RWMutex
+	clients     map[cid.Cid]string
+
+	lock   sync.Mutex
+	subs   map[cid.Cid][]subscriber
+	states StateCache
+	// lastSeen is the last time each subscribed deal was updated.
+	lastSeen map[cid.Cid]time.Time
 
 	closeLock     sync.Mutex
 	closeCtx      context.Context
 	closeCancel   context.CancelFunc
 	closeFinished chan struct{}
+	closeWg       sync.WaitGroup
 	closed        bool
 
 	// Metrics
 	metricDealUpdates            metric.Int64Counter
 	metricDealUpdatesChanFailure metric.Int64Counter
+	metricReconnects             metric.Int64Counter
+	metricDroppedUpdates         metric.Int64Counter
 }
 
 // New returns a new DealWatcher.
-func New(cb lotus.ClientBuilder) (*DealWatcher, error) {
+func New(cb lotus.ClientBuilder, opts ...Option) (*DealWatcher, error) {
+	return NewWithFailover([]lotus.ClientBuilder{cb}, opts...)
+}
+
+// NewWithFailover returns a new DealWatcher which uses the first of
+// the provided Lotus endpoints, and rotates through them round-robin
+// when the current one keeps failing.
+func NewWithFailover(cbs []lotus.ClientBuilder, opts ...Option) (*DealWatcher, error) {
+	if len(cbs) == 0 {
+		return nil, fmt.Errorf("at least one lotus endpoint is required")
+	}
 	ctx, cls := context.WithCancel(context.Background())
 	dw := &DealWatcher{
-		cb:            cb,
-		subs:          make(map[cid.Cid][]chan<- struct{}),
-		closeCtx:      ctx,
-		closeCancel:   cls,
-		closeFinished: make(chan struct{}),
+		endpoints:         append([]lotus.ClientBuilder(nil), cbs...),
+		subs:              make(map[cid.Cid][]subscriber),
+		clients:           make(map[cid.Cid]string),
+		states:            NewMemoryStateCache(),
+		lastSeen:          make(map[cid.Cid]time.Time),
+		lastUpdate:        time.Now().UnixNano(),
+		reconnectMin:      time.Second * 30,
+		reconnectMax:      time.Second * 30,
+		failoverThreshold: 3,
+		rand:              random.Default,
+		closeCtx:          ctx,
+		closeCancel:       cls,
+		closeFinished:     make(chan struct{}),
+	}
+	for _, o := range opts {
+		o(dw)
+	}
+	if dw.reconnectMin <= 0 || dw.reconnectMax < dw.reconnectMin {
+		cls()
+		return nil, fmt.Errorf("invalid reconnect backoff bounds (%s, %s)", dw.reconnectMin, dw.reconnectMax)
+	}
+	if dw.failoverThreshold <= 0 {
+		cls()
+		return nil, fmt.Errorf("failover threshold should be positive")
 	}
 
-	dw.startDaemon()
 	dw.initMetrics()
+	dw.startDaemon()
+	if dw.livenessInterval > 0 {
+		dw.startLivenessCheck()
+	}
 
 	return dw, nil
 }
 
+// subscriber is a channel registered to receive deal updates. Only
+// one of its channels is set.
+type subscriber struct {
+	signal chan<- struct{}
+	info   chan<- api.DealInfo
+	// done is closed when the subscription is removed, which happens
+	// when the deal reaches a terminal state.
+	done chan struct{}
+}
+
+// notify sends the update to the subscriber without blocking. It
+// returns false if the subscriber wasn't ready to receive it.
+func (s subscriber) notify(di api.DealInfo) bool {
+	if s.done != nil {
+		// It's closed when the subscription is removed.
+		return true
+	}
+	if s.info != nil {
+		select {
+		case s.info <- di:
+			return true
+		default:
+			return false
+		}
+	}
+	select {
+	case s.signal <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
 // Subscribe registers a channel that will receive updates for a proposalCid.
 func (dw *DealWatcher) Subscribe(ch chan<- struct{}, proposalCid cid.Cid) error {
+	if ch == nil {
+		return ErrNilChannel
+	}
+	return dw.subscribe(subscriber{signal: ch}, proposalCid)
+}
+
+// SubscribeCtx is like Subscribe, but the subscription is removed when
+// ctx is canceled, so callers don't need to Unsubscribe.
+func (dw *DealWatcher) SubscribeCtx(ctx context.Context, ch chan<- struct{}, proposalCid cid.Cid) error {
+	if err := dw.Subscribe(ch, proposalCid); err != nil {
+		return err
+	}
+	dw.closeWg.Add(1)
+	go func() {
+		defer dw.closeWg.Done()
+		select {
+		case <-ctx.Done():
+			// The subscription might already be removed if it was
+			// expired or unsubscribed explicitly.
+			_ = dw.Unsubscribe(ch, proposalCid)
+		case <-dw.closeCtx.Done():
+		}
+	}()
+	return nil
+}
+
+// SubscribeInfo registers a channel that will receive the DealInfo of
+// updates for a proposalCid, so subscribers don't need to query the deal
+// state again.
+func (dw *DealWatcher) SubscribeInfo(ch chan<- api.DealInfo, proposalCid cid.Cid) error {
+	if ch == nil {
+		return ErrNilChannel
+	}
+	return dw.subscribe(subscriber{info: ch}, proposalCid)
+}
+
+// SubscribeUntilDone returns a channel which is closed when the deal of
+// proposalCid reaches a terminal state, so callers waiting for the deal
+// to finish don't need to check the state of every update. If the last
+// received state of the deal is already terminal, the channel is closed
+// right away. The channel is also closed if the subscriptions of the deal
+// are removed, e.g. with UnsubscribeAll, so callers should check the deal
+// state when it's closed. It isn't closed if the watcher is closed first.
+func (dw *DealWatcher) SubscribeUntilDone(proposalCid cid.Cid) (<-chan struct{}, error) {
+	done := make(chan struct{})
+	if err := dw.subscribe(subscriber{done: done}, proposalCid); err != nil {
+		return nil, err
+	}
+	return done, nil
+}
+
+func (dw *DealWatcher) subscribe(sub subscriber, proposalCid cid.Cid) error {
 	dw.lock.Lock()
 	defer dw.lock.Unlock()
 
-	for _, ich := range dw.subs[proposalCid] {
-		if ch == ich {
+	for _, isub := range dw.subs[proposalCid] {
+		if sub == isub {
 			return ErrActiveSubscription
 		}
 	}
+	if dw.maxSubscribers > 0 && len(dw.subs[proposalCid]) >= dw.maxSubscribers {
+		return fmt.Errorf("%w: %s has %d subscribers", ErrTooManySubscribers, proposalCid, dw.maxSubscribers)
+	}
+	if sub.done != nil {
+		// The terminal update might be received before subscribing.
+		state, ok, err := dw.states.Get(proposalCid)
+		if err != nil {
+			return fmt.Errorf("getting cached deal state: %s", err)
+		}
+		if ok && isTerminal(state) {
+			close(sub.done)
+			return nil
+		}
+	}
 
-	dw.subs[proposalCid] = append(dw.subs[proposalCid], ch)
+	if _, ok := dw.subs[proposalCid]; !ok {
+		dw.lastSeen[proposalCid] = time.Now()
+	}
+	dw.subs[proposalCid] = append(dw.subs[proposalCid], sub)
 
 	log.Infof("subscriber registered")
 	return nil
@@ -77,6 +325,33 @@ func (dw *DealWatcher) Subscribe(ch chan<- struct{}, proposalCid cid.Cid) error
 
 // Unsubscribe removes a previously registered channel to stop receiving updates.
 func (dw *DealWatcher) Unsubscribe(ch chan<- struct{}, proposalCid cid.Cid) error {
+	return dw.unsubscribe(subscriber{signal: ch}, proposalCid)
+}
+
+// UnsubscribeInfo removes a channel registered with SubscribeInfo.
+func (dw *DealWatcher) UnsubscribeInfo(ch chan<- api.DealInfo, proposalCid cid.Cid) error {
+	return dw.unsubscribe(subscriber{info: ch}, proposalCid)
+}
+
+// UnsubscribeUntilDone removes a channel returned by SubscribeUntilDone
+// before the deal reaches a terminal state.
+func (dw *DealWatcher) UnsubscribeUntilDone(ch <-chan struct{}, proposalCid cid.Cid) error {
+	dw.lock.Lock()
+	var sub subscriber
+	for _, s := range dw.subs[proposalCid] {
+		if s.done != nil && s.done == ch {
+			sub = s
+			break
+		}
+	}
+	dw.lock.Unlock()
+	if sub.done == nil {
+		return ErrNotFound
+	}
+	return dw.unsubscribe(sub, proposalCid)
+}
+
+func (dw *DealWatcher) unsubscribe(sub subscriber, proposalCid cid.Cid) error {
 	dw.lock.Lock()
 	defer dw.lock.Unlock()
 
@@ -86,7 +361,7 @@ func (dw *DealWatcher) Unsubscribe(ch chan<- struct{}, proposalCid cid.Cid) erro
 	}
 	idx := -1
 	for i := range subs {
-		if subs[i] == ch {
+		if subs[i] == sub {
 			idx = i
 			break
 		}
@@ -95,7 +370,10 @@ func (dw *DealWatcher) Unsubscribe(ch chan<- struct{}, proposalCid cid.Cid) erro
 		return ErrNotFound
 	}
 	if len(subs) == 1 {
+		// The removed subscriber is explicitly unsubscribed, so its
+		// channel isn't closed.
 		delete(dw.subs, proposalCid)
+		dw.forget(proposalCid)
 		return nil
 	}
 	subs[idx] = subs[len(subs)-1]
@@ -105,6 +383,93 @@ func (dw *DealWatcher) Unsubscribe(ch chan<- struct{}, proposalCid cid.Cid) erro
 	return nil
 }
 
+// UnsubscribeAll removes every channel registered for a proposalCid. It
+// returns ErrNotFound if the proposal has no subscriptions.
+func (dw *DealWatcher) UnsubscribeAll(proposalCid cid.Cid) error {
+	dw.lock.Lock()
+	defer dw.lock.Unlock()
+
+	if _, ok := dw.subs[proposalCid]; !ok {
+		return ErrNotFound
+	}
+	dw.forget(proposalCid)
+	return nil
+}
+
+// LastState returns the last state received for a deal with active
+// subscriptions. It returns ErrNotFound if no update was received since
+// the first subscription, or the deal has no subscriptions.
+func (dw *DealWatcher) LastState(proposalCid cid.Cid) (storagemarket.StorageDealStatus, error) {
+	s, ok, err := dw.states.Get(proposalCid)
+	if err != nil {
+		return 0, fmt.Errorf("getting cached deal state: %s", err)
+	}
+	if !ok {
+		return 0, ErrNotFound
+	}
+	return s, nil
+}
+
+// SetDealClient registers the client wallet address of a proposal. It's
+// only needed if the watcher was created with WithClientAddrs, and is a
+// no-op otherwise.
+func (dw *DealWatcher) SetDealClient(proposalCid cid.Cid, clientAddr string) {
+	if dw.clientAddrs == nil {
+		return
+	}
+	dw.clientsLock.Lock()
+	defer dw.clientsLock.Unlock()
+	dw.clients[proposalCid] = clientAddr
+}
+
+// LastUpdate returns the time the last deal update was received, or the
+// creation time of the watcher if none was received yet.
+func (dw *DealWatcher) LastUpdate() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&dw.lastUpdate))
+}
+
+// Health returns ErrStale if there're active subscriptions but no deal
+// update was received in the last maxAge. Without subscriptions the
+// watcher is considered idle, so it's always healthy.
+func (dw *DealWatcher) Health(maxAge time.Duration) error {
+	dw.lock.Lock()
+	defer dw.lock.Unlock()
+	if len(dw.subs) == 0 {
+		return nil
+	}
+	if age := time.Since(dw.LastUpdate()); age > maxAge {
+		return fmt.Errorf("%w: last update was %s ago", ErrStale, age.Round(time.Second))
+	}
+	return nil
+}
+
+// Metrics is a snapshot of the DealWatcher activity.
+type Metrics struct {
+	// ActiveSubscriptions is the number of proposals with subscribers.
+	ActiveSubscriptions int
+	// Reconnects is the number of times the updates channel was
+	// recreated after closing unexpectedly.
+	Reconnects int64
+	// DroppedUpdates is the number of updates skipped for slow
+	// receivers.
+	DroppedUpdates int64
+}
+
+// Metrics returns a snapshot of the DealWatcher activity.
+func (dw *DealWatcher) Metrics() Metrics {
+	return Metrics{
+		ActiveSubscriptions: dw.activeSubscriptions(),
+		Reconnects:          atomic.LoadInt64(&dw.reconnects),
+		DroppedUpdates:      atomic.LoadInt64(&dw.droppedUpdates),
+	}
+}
+
+func (dw *DealWatcher) activeSubscriptions() int {
+	dw.lock.Lock()
+	defer dw.lock.Unlock()
+	return len(dw.subs)
+}
+
 // Close gracefully shutdowns the deal watcher.
 func (dw *DealWatcher) Close() error {
 	dw.closeLock.Lock()
@@ -117,13 +482,14 @@ func (dw *DealWatcher) Close() error {
 
 	dw.closeCancel()
 	<-dw.closeFinished
+	dw.closeWg.Wait()
 
 	return nil
 }
 
 func (dw *DealWatcher) startDaemon() {
 	createUpdateChan := func() (<-chan api.DealInfo, func(), error) {
-		c, cls, err := dw.cb(dw.closeCtx)
+		c, cls, err := dw.clientBuilder()(dw.closeCtx)
 		if err != nil {
 			return nil, nil, fmt.Errorf("creating lotus client: %s", err)
 		}
@@ -136,13 +502,12 @@ func (dw *DealWatcher) startDaemon() {
 	}
 
 	go func() {
-		updates, cls, err := createUpdateChan()
+		defer close(dw.closeFinished)
+		updates, cls, err := dw.connect(createUpdateChan)
 		if err != nil {
-			log.Errorf("creating initial updates chan: %s", err)
 			return
 		}
 		log.Infof("deal watcher created")
-		defer close(dw.closeFinished)
 		defer func() { cls() }()
 
 		for {
@@ -157,38 +522,268 @@ func (dw *DealWatcher) startDaemon() {
 
 					dw.metricDealUpdatesChanFailure.Add(dw.closeCtx, 1)
 					log.Warnf("updates channel closed unexpectedly")
+					dw.endpointFailed()
 
 					cls() // Formally closed broken chan.
-					for {
-						updates, cls, err = createUpdateChan()
-						if err != nil {
-							log.Warnf("reconstructing updates channel: %s", err)
-							time.Sleep(time.Second * 30)
-							continue
-						}
-						break
+					cls = func() {}
+					newUpdates, newCls, err := dw.connect(createUpdateChan)
+					if err != nil {
+						return
 					}
+					updates, cls = newUpdates, newCls
+					atomic.AddInt64(&dw.reconnects, 1)
+					dw.metricReconnects.Add(dw.closeCtx, 1)
 				}
 
-				dw.lock.Lock()
+				if ok {
+					atomic.StoreInt64(&dw.lastUpdate, time.Now().UnixNano())
+					dw.endpointHealthy()
+				}
+				dw.handleUpdate(di)
+			}
+		}
+	}()
+}
 
-				subs, ok := dw.subs[di.ProposalCid]
-				if !ok {
-					dw.lock.Unlock()
+// connect creates the updates channel, retrying failed attempts with
+// the configured backoff. It only fails if the watcher is closed.
+func (dw *DealWatcher) connect(create func() (<-chan api.DealInfo, func(), error)) (<-chan api.DealInfo, func(), error) {
+	b := backoff{min: dw.reconnectMin, max: dw.reconnectMax}
+	for {
+		updates, cls, err := create()
+		if err == nil {
+			return updates, cls, nil
+		}
+		dw.endpointFailed()
+		delay := jitter(dw.rand, b.next())
+		log.Warnf("creating updates channel: %s, retrying in %s", err, delay)
+		select {
+		case <-dw.closeCtx.Done():
+			return nil, nil, dw.closeCtx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
 
-					dw.metricDealUpdates.Add(dw.closeCtx, 1, attrDealUntracked)
-					continue
-				}
-				dw.metricDealUpdates.Add(dw.closeCtx, 1, attrDealTracked)
-				for _, s := range subs {
-					select {
-					case s <- struct{}{}:
-					default:
-						log.Warn("skipping slow receiver")
-					}
-				}
-				dw.lock.Unlock()
+// clientBuilder returns the builder of the current Lotus endpoint.
+func (dw *DealWatcher) clientBuilder() lotus.ClientBuilder {
+	dw.endpointsLock.Lock()
+	defer dw.endpointsLock.Unlock()
+	return dw.endpoints[dw.endpoint]
+}
+
+// endpointFailed registers a failure of the current Lotus endpoint, and
+// rotates to the next one if it reached the failover threshold.
+func (dw *DealWatcher) endpointFailed() {
+	dw.endpointsLock.Lock()
+	defer dw.endpointsLock.Unlock()
+	dw.endpointFailures++
+	if len(dw.endpoints) == 1 || dw.endpointFailures < dw.failoverThreshold {
+		return
+	}
+	dw.endpoint = (dw.endpoint + 1) % len(dw.endpoints)
+	dw.endpointFailures = 0
+	log.Warnf("failing over to lotus endpoint %d", dw.endpoint)
+}
+
+// endpointHealthy resets the failures of the current Lotus endpoint.
+func (dw *DealWatcher) endpointHealthy() {
+	dw.endpointsLock.Lock()
+	defer dw.endpointsLock.Unlock()
+	dw.endpointFailures = 0
+}
+
+// backoff calculates exponentially growing delays between attempts.
+type backoff struct {
+	min, max time.Duration
+	cur      time.Duration
+}
+
+// next returns the delay before the next attempt.
+func (b *backoff) next() time.Duration {
+	if b.cur == 0 {
+		b.cur = b.min
+	} else {
+		b.cur *= 2
+	}
+	if b.cur > b.max {
+		b.cur = b.max
+	}
+	return b.cur
+}
+
+// jitter returns a random delay between half and the full provided delay.
+func jitter(src random.Source, d time.Duration) time.Duration {
+	half := d / 2
+	return half + time.Duration(src.Int63n(int64(d-half)+1))
+}
+
+func (dw *DealWatcher) startLivenessCheck() {
+	dw.closeWg.Add(1)
+	go func() {
+		defer dw.closeWg.Done()
+		for {
+			select {
+			case <-dw.closeCtx.Done():
+				return
+			case <-time.After(dw.livenessInterval / 2):
+				dw.checkLiveness()
 			}
 		}
 	}()
 }
+
+// checkLiveness polls the state of subscribed deals which weren't updated
+// in the liveness interval, and handles the changed ones as updates.
+func (dw *DealWatcher) checkLiveness() {
+	var stale []cid.Cid
+	dw.lock.Lock()
+	for c, t := range dw.lastSeen {
+		if time.Since(t) > dw.livenessInterval {
+			stale = append(stale, c)
+		}
+	}
+	dw.lock.Unlock()
+	if len(stale) == 0 {
+		return
+	}
+
+	c, cls, err := dw.clientBuilder()(dw.closeCtx)
+	if err != nil {
+		log.Errorf("creating lotus client: %s", err)
+		return
+	}
+	defer cls()
+	for _, proposalCid := range stale {
+		di, err := c.ClientGetDealInfo(dw.closeCtx, proposalCid)
+		if err != nil || di == nil {
+			log.Warnf("polling stale deal %s: %v", proposalCid, err)
+			continue
+		}
+		last, ok, err := dw.states.Get(proposalCid)
+		if err != nil {
+			log.Errorf("getting cached deal state: %s", err)
+			continue
+		}
+		if ok && last == di.State {
+			dw.lock.Lock()
+			if _, ok := dw.lastSeen[proposalCid]; ok {
+				dw.lastSeen[proposalCid] = time.Now()
+			}
+			dw.lock.Unlock()
+			continue
+		}
+		log.Infof("polled missed update of deal %s", proposalCid)
+		dw.handleUpdate(*di)
+	}
+}
+
+// handleUpdate notifies the subscribers of the updated deal.
+func (dw *DealWatcher) handleUpdate(di api.DealInfo) {
+	if !dw.fromClients(di) {
+		dw.metricDealUpdates.Add(dw.closeCtx, 1, attrDealUntracked)
+		return
+	}
+
+	dw.lock.Lock()
+
+	subs, ok := dw.subs[di.ProposalCid]
+	if !ok {
+		dw.lock.Unlock()
+
+		dw.metricDealUpdates.Add(dw.closeCtx, 1, attrDealUntracked)
+		return
+	}
+	dw.metricDealUpdates.Add(dw.closeCtx, 1, attrDealTracked)
+	dw.lastSeen[di.ProposalCid] = time.Now()
+	if err := dw.states.Put(di.ProposalCid, di.State); err != nil {
+		log.Errorf("caching deal state: %s", err)
+	}
+	for _, s := range subs {
+		if !s.notify(di) {
+			atomic.AddInt64(&dw.droppedUpdates, 1)
+			dw.metricDroppedUpdates.Add(dw.closeCtx, 1)
+			log.Warn("skipping slow receiver")
+		}
+	}
+	if dw.expireTerminal && isTerminal(di.State) {
+		dw.forget(di.ProposalCid)
+		log.Infof("expired subscriptions of terminal deal %s", di.ProposalCid)
+	} else if isTerminal(di.State) {
+		dw.removeDone(di.ProposalCid)
+	}
+	dw.lock.Unlock()
+
+	if dw.clientAddrs != nil && isTerminal(di.State) {
+		dw.clientsLock.Lock()
+		delete(dw.clients, di.ProposalCid)
+		dw.clientsLock.Unlock()
+	}
+}
+
+// forget removes the subscriptions and cached state of a deal, closing
+// the channels of SubscribeUntilDone subscriptions. It should be called
+// with dw.lock held.
+func (dw *DealWatcher) forget(proposalCid cid.Cid) {
+	for _, s := range dw.subs[proposalCid] {
+		if s.done != nil {
+			close(s.done)
+		}
+	}
+	delete(dw.subs, proposalCid)
+	delete(dw.lastSeen, proposalCid)
+	if err := dw.states.Delete(proposalCid); err != nil {
+		log.Errorf("deleting cached deal state: %s", err)
+	}
+}
+
+// removeDone removes the SubscribeUntilDone subscriptions of a deal,
+// closing their channels. It should be called with dw.lock held.
+func (dw *DealWatcher) removeDone(proposalCid cid.Cid) {
+	subs := dw.subs[proposalCid][:0]
+	for _, s := range dw.subs[proposalCid] {
+		if s.done == nil {
+			subs = append(subs, s)
+			continue
+		}
+		close(s.done)
+	}
+	if len(subs) == 0 {
+		delete(dw.subs, proposalCid)
+		dw.forget(proposalCid)
+		return
+	}
+	dw.subs[proposalCid] = subs
+}
+
+// fromClients returns true if the update should be processed considering
+// the configured client addresses. Updates of proposals without a
+// registered client are processed, since they may be ours.
+func (dw *DealWatcher) fromClients(di api.DealInfo) bool {
+	if dw.clientAddrs == nil {
+		return true
+	}
+	dw.clientsLock.RLock()
+	client, ok := dw.clients[di.ProposalCid]
+	dw.clientsLock.RUnlock()
+	if !ok {
+		return true
+	}
+	_, ok = dw.clientAddrs[client]
+	return ok
+}
+
+// isTerminal returns true if a deal in the provided state won't
+// receive further updates.
+func isTerminal(state storagemarket.StorageDealStatus) bool {
+	switch state {
+	case storagemarket.StorageDealActive,
+		storagemarket.StorageDealExpired,
+		storagemarket.StorageDealSlashed,
+		storagemarket.StorageDealProposalRejected,
+		storagemarket.StorageDealError:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,664 @@
+package dealwatcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/lotus"
+	"github.com/textileio/powergate/v2/random"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestExpireTerminal(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	for _, expire := range []bool{false, true} {
+		expire := expire
+		updates := make(chan api.DealInfo)
+		dw, err := New(updatesClientBuilder(updates), WithExpireTerminal(expire))
+		require.NoError(t, err)
+
+		ch := make(chan struct{}, 1)
+		require.NoError(t, dw.Subscribe(ch, prop))
+
+		// Non-terminal states keep the subscription.
+		updates <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealSealing}
+		requireNotified(t, ch)
+		require.True(t, dw.subscribed(prop))
+
+		// The terminal state is notified before expiring.
+		updates <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealActive}
+		requireNotified(t, ch)
+		require.Equal(t, !expire, dw.subscribed(prop))
+		if expire {
+			require.Equal(t, ErrNotFound, dw.Unsubscribe(ch, prop))
+		} else {
+			require.NoError(t, dw.Unsubscribe(ch, prop))
+		}
+
+		require.NoError(t, dw.Close())
+	}
+}
+
+func requireNotified(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-ch:
+	case <-time.After(time.Second * 5):
+		t.Fatal("subscriber wasn't notified")
+	}
+}
+
+func (dw *DealWatcher) subscribed(proposalCid cid.Cid) bool {
+	dw.lock.Lock()
+	defer dw.lock.Unlock()
+	_, ok := dw.subs[proposalCid]
+	return ok
+}
+
+func updatesClientBuilder(updates <-chan api.DealInfo) func(context.Context) (*api.FullNodeStruct, func(), error) {
+	return func(ctx context.Context) (*api.FullNodeStruct, func(), error) {
+		c := &api.FullNodeStruct{}
+		c.Internal.ClientGetDealUpdates = func(context.Context) (<-chan api.DealInfo, error) {
+			return updates, nil
+		}
+		return c, func() {}, nil
+	}
+}
+
+func TestHealth(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	updates := make(chan api.DealInfo)
+	dw, err := New(updatesClientBuilder(updates))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+
+	const maxAge = time.Millisecond * 100
+	created := dw.LastUpdate()
+	time.Sleep(maxAge * 2)
+
+	// Without subscriptions, no updates is expected.
+	require.NoError(t, dw.Health(maxAge))
+
+	ch := make(chan struct{}, 1)
+	require.NoError(t, dw.Subscribe(ch, prop))
+	require.ErrorIs(t, dw.Health(maxAge), ErrStale)
+
+	// Any update advances the timestamp, even of untracked deals.
+	other, _ := util.CidFromString("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	updates <- api.DealInfo{ProposalCid: other, State: storagemarket.StorageDealSealing}
+	require.Eventually(t, func() bool { return dw.LastUpdate().After(created) }, time.Second*5, time.Millisecond*10)
+	require.NoError(t, dw.Health(maxAge))
+
+	last := dw.LastUpdate()
+	updates <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealSealing}
+	requireNotified(t, ch)
+	require.True(t, dw.LastUpdate().After(last))
+
+	time.Sleep(maxAge * 2)
+	require.ErrorIs(t, dw.Health(maxAge), ErrStale)
+}
+
+func TestClientAddrsFilter(t *testing.T) {
+	t.Parallel()
+
+	mine, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	foreign, _ := util.CidFromString("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	unknown, _ := util.CidFromString("QmPewMLNUWQ3mfBBd8nJ5xGmrt9MF2K5HaWHPNU8oFjUcE")
+	updates := make(chan api.DealInfo)
+	dw, err := New(updatesClientBuilder(updates), WithClientAddrs("f1mine"))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+
+	chMine := make(chan struct{}, 1)
+	chForeign := make(chan struct{}, 1)
+	chUnknown := make(chan struct{}, 1)
+	require.NoError(t, dw.Subscribe(chMine, mine))
+	require.NoError(t, dw.Subscribe(chForeign, foreign))
+	require.NoError(t, dw.Subscribe(chUnknown, unknown))
+	dw.SetDealClient(mine, "f1mine")
+	dw.SetDealClient(foreign, "f1other")
+
+	for i := 0; i < 3; i++ {
+		updates <- api.DealInfo{ProposalCid: foreign, State: storagemarket.StorageDealSealing}
+		updates <- api.DealInfo{ProposalCid: mine, State: storagemarket.StorageDealSealing}
+		requireNotified(t, chMine)
+	}
+	// Foreign updates were received before the last one of mine, so
+	// they were already dropped.
+	select {
+	case <-chForeign:
+		t.Fatal("foreign deal update was notified")
+	default:
+	}
+
+	// Deals without a registered client may be ours, so they notify.
+	updates <- api.DealInfo{ProposalCid: unknown, State: storagemarket.StorageDealSealing}
+	requireNotified(t, chUnknown)
+
+	// Terminal deals are forgotten by the filter.
+	updates <- api.DealInfo{ProposalCid: mine, State: storagemarket.StorageDealActive}
+	requireNotified(t, chMine)
+	require.Eventually(t, func() bool {
+		dw.clientsLock.RLock()
+		defer dw.clientsLock.RUnlock()
+		_, ok := dw.clients[mine]
+		return !ok
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func TestMaxSubscribers(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	other, _ := util.CidFromString("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	dw, err := New(updatesClientBuilder(make(chan api.DealInfo)), WithMaxSubscribers(2))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+
+	chs := make([]chan struct{}, 3)
+	for i := range chs {
+		chs[i] = make(chan struct{}, 1)
+	}
+	require.NoError(t, dw.Subscribe(chs[0], prop))
+	require.NoError(t, dw.Subscribe(chs[1], prop))
+	require.ErrorIs(t, dw.Subscribe(chs[2], prop), ErrTooManySubscribers)
+
+	// The limit is per proposal.
+	require.NoError(t, dw.Subscribe(chs[2], other))
+
+	// Unsubscribing frees a slot.
+	require.NoError(t, dw.Unsubscribe(chs[0], prop))
+	require.NoError(t, dw.Subscribe(chs[2], prop))
+}
+
+func TestSubscribeNilChannel(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	dw, err := New(updatesClientBuilder(make(chan api.DealInfo)))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+
+	require.Equal(t, ErrNilChannel, dw.Subscribe(nil, prop))
+	require.False(t, dw.subscribed(prop))
+}
+
+func TestLivenessCheck(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	var lock sync.Mutex
+	state := storagemarket.StorageDealSealing
+	polls := 0
+	cb := func(ctx context.Context) (*api.FullNodeStruct, func(), error) {
+		c := &api.FullNodeStruct{}
+		// The stream never delivers updates.
+		c.Internal.ClientGetDealUpdates = func(context.Context) (<-chan api.DealInfo, error) {
+			return make(chan api.DealInfo), nil
+		}
+		c.Internal.ClientGetDealInfo = func(_ context.Context, p cid.Cid) (*api.DealInfo, error) {
+			lock.Lock()
+			defer lock.Unlock()
+			polls++
+			return &api.DealInfo{ProposalCid: p, State: state}, nil
+		}
+		return c, func() {}, nil
+	}
+	dw, err := New(cb, WithLivenessCheck(time.Millisecond*50))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+
+	ch := make(chan struct{}, 1)
+	require.NoError(t, dw.Subscribe(ch, prop))
+	done, err := dw.SubscribeUntilDone(prop)
+	require.NoError(t, err)
+
+	// The poll fills the missed update.
+	requireNotified(t, ch)
+	s, err := dw.LastState(prop)
+	require.NoError(t, err)
+	require.Equal(t, storagemarket.StorageDealSealing, s)
+
+	// Unchanged polled states aren't notified.
+	require.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return polls >= 3
+	}, time.Second*5, time.Millisecond*10)
+	select {
+	case <-ch:
+		t.Fatal("unchanged deal state was notified")
+	default:
+	}
+
+	lock.Lock()
+	state = storagemarket.StorageDealActive
+	lock.Unlock()
+	requireNotified(t, ch)
+	// Polled terminal states close SubscribeUntilDone channels.
+	requireNotified(t, done)
+	require.Eventually(t, func() bool {
+		s, err := dw.LastState(prop)
+		return err == nil && s == storagemarket.StorageDealActive
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func TestSubscribeInfo(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	updates := make(chan api.DealInfo)
+	dw, err := New(updatesClientBuilder(updates))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+
+	chInfo := make(chan api.DealInfo, 1)
+	ch := make(chan struct{}, 1)
+	require.NoError(t, dw.SubscribeInfo(chInfo, prop))
+	require.Equal(t, ErrActiveSubscription, dw.SubscribeInfo(chInfo, prop))
+	require.NoError(t, dw.Subscribe(ch, prop))
+	require.Equal(t, ErrNilChannel, dw.SubscribeInfo(nil, prop))
+
+	sent := []api.DealInfo{
+		{ProposalCid: prop, State: storagemarket.StorageDealTransferring, Message: "transferring"},
+		{ProposalCid: prop, State: storagemarket.StorageDealSealing, Message: "sealing", DealID: 10, Size: 1024},
+	}
+	for _, di := range sent {
+		updates <- di
+		requireInfo(t, chInfo, di)
+		requireNotified(t, ch)
+	}
+
+	// Updates aren't delivered after unsubscribing.
+	require.NoError(t, dw.UnsubscribeInfo(chInfo, prop))
+	require.Equal(t, ErrNotFound, dw.UnsubscribeInfo(chInfo, prop))
+	updates <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealActive}
+	requireNotified(t, ch)
+	select {
+	case <-chInfo:
+		t.Fatal("unsubscribed channel received an update")
+	default:
+	}
+}
+
+func TestSubscribeInfoSlowReceiver(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	updates := make(chan api.DealInfo)
+	dw, err := New(updatesClientBuilder(updates))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+
+	slow := make(chan api.DealInfo)
+	fast := make(chan api.DealInfo, 1)
+	require.NoError(t, dw.SubscribeInfo(slow, prop))
+	require.NoError(t, dw.SubscribeInfo(fast, prop))
+
+	// The slow receiver doesn't block notifying the others.
+	di := api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealSealing, DealID: 10}
+	updates <- di
+	requireInfo(t, fast, di)
+	select {
+	case <-slow:
+		t.Fatal("slow receiver got a skipped update")
+	default:
+	}
+}
+
+func requireInfo(t *testing.T, ch <-chan api.DealInfo, expected api.DealInfo) {
+	t.Helper()
+	select {
+	case di := <-ch:
+		require.Equal(t, expected, di)
+	case <-time.After(time.Second * 5):
+		t.Fatal("subscriber wasn't notified")
+	}
+}
+
+func TestReconnectBackoff(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	const failures = 4
+	var (
+		lock     sync.Mutex
+		attempts []time.Time
+	)
+	first := make(chan api.DealInfo)
+	second := make(chan api.DealInfo)
+	cb := func(ctx context.Context) (*api.FullNodeStruct, func(), error) {
+		lock.Lock()
+		defer lock.Unlock()
+		attempts = append(attempts, time.Now())
+		c := &api.FullNodeStruct{}
+		switch n := len(attempts); {
+		case n == 1:
+			c.Internal.ClientGetDealUpdates = func(context.Context) (<-chan api.DealInfo, error) {
+				return first, nil
+			}
+		case n <= 1+failures:
+			return nil, nil, fmt.Errorf("lotus is unavailable")
+		default:
+			c.Internal.ClientGetDealUpdates = func(context.Context) (<-chan api.DealInfo, error) {
+				return second, nil
+			}
+		}
+		return c, func() {}, nil
+	}
+	dw, err := New(cb, WithReconnectBackoff(time.Millisecond*20, time.Second))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+
+	ch := make(chan struct{}, 1)
+	require.NoError(t, dw.Subscribe(ch, prop))
+
+	// The updates channel closes unexpectedly, and Lotus fails the
+	// next attempts to recreate it.
+	close(first)
+
+	// It eventually connects, and updates are notified again.
+	second <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealSealing}
+	requireNotified(t, ch)
+
+	lock.Lock()
+	defer lock.Unlock()
+	require.Len(t, attempts, 2+failures)
+	// Delays between failed attempts are 10-20ms, 20-40ms, 40-80ms and
+	// 80-160ms, so the last one is longer than the first.
+	firstDelay, lastDelay := attempts[2].Sub(attempts[1]), attempts[5].Sub(attempts[4])
+	require.GreaterOrEqual(t, int64(lastDelay), int64(time.Millisecond*80))
+	require.Greater(t, lastDelay, firstDelay)
+}
+
+func TestFailover(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	closed := make(chan api.DealInfo)
+	close(closed)
+	primaries := map[string]func(context.Context) (*api.FullNodeStruct, func(), error){
+		"Unavailable": func(context.Context) (*api.FullNodeStruct, func(), error) {
+			return nil, nil, fmt.Errorf("lotus is unavailable")
+		},
+		// The updates channel of the primary dies right after creation.
+		"ClosingUpdates": updatesClientBuilder(closed),
+	}
+	for name, primary := range primaries {
+		primary := primary
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			updates := make(chan api.DealInfo)
+			cbs := []lotus.ClientBuilder{primary, updatesClientBuilder(updates)}
+			dw, err := NewWithFailover(cbs, WithFailoverThreshold(2), WithReconnectBackoff(time.Millisecond, time.Millisecond*5))
+			require.NoError(t, err)
+			defer func() { require.NoError(t, dw.Close()) }()
+
+			ch := make(chan struct{}, 1)
+			require.NoError(t, dw.Subscribe(ch, prop))
+
+			// The watcher ends up receiving updates from the secondary.
+			updates <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealSealing}
+			requireNotified(t, ch)
+			dw.endpointsLock.Lock()
+			defer dw.endpointsLock.Unlock()
+			require.Equal(t, 1, dw.endpoint)
+		})
+	}
+
+	_, err := NewWithFailover(nil)
+	require.Error(t, err)
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	b := backoff{min: time.Second, max: time.Second * 5}
+	var delays []time.Duration
+	for i := 0; i < 5; i++ {
+		delays = append(delays, b.next())
+	}
+	require.Equal(t, []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 5, time.Second * 5}, delays)
+
+	src := random.New(1)
+	var jitters []time.Duration
+	for i := 0; i < 100; i++ {
+		d := jitter(src, time.Second)
+		require.GreaterOrEqual(t, int64(d), int64(time.Second/2))
+		require.LessOrEqual(t, int64(d), int64(time.Second))
+		jitters = append(jitters, d)
+	}
+	// The same seed produces the same jitter.
+	src = random.New(1)
+	for _, d := range jitters {
+		require.Equal(t, d, jitter(src, time.Second))
+	}
+
+	_, err := New(updatesClientBuilder(make(chan api.DealInfo)), WithReconnectBackoff(time.Second, time.Millisecond))
+	require.Error(t, err)
+}
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	other, _ := util.CidFromString("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	var (
+		lock  sync.Mutex
+		chans = []chan api.DealInfo{make(chan api.DealInfo), make(chan api.DealInfo)}
+	)
+	cb := func(ctx context.Context) (*api.FullNodeStruct, func(), error) {
+		lock.Lock()
+		defer lock.Unlock()
+		updates := chans[0]
+		if len(chans) > 1 {
+			chans = chans[1:]
+		}
+		c := &api.FullNodeStruct{}
+		c.Internal.ClientGetDealUpdates = func(context.Context) (<-chan api.DealInfo, error) {
+			return updates, nil
+		}
+		return c, func() {}, nil
+	}
+	lock.Lock()
+	first, second := chans[0], chans[1]
+	lock.Unlock()
+	dw, err := New(cb, WithReconnectBackoff(time.Millisecond*10, time.Millisecond*10))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+	require.Equal(t, Metrics{}, dw.Metrics())
+
+	// Active subscriptions count proposals, not channels.
+	fast := make(chan struct{}, 1)
+	slow := make(chan struct{})
+	require.NoError(t, dw.Subscribe(fast, prop))
+	require.NoError(t, dw.Subscribe(slow, prop))
+	chOther := make(chan struct{}, 1)
+	require.NoError(t, dw.Subscribe(chOther, other))
+	require.Equal(t, 2, dw.Metrics().ActiveSubscriptions)
+	require.NoError(t, dw.Unsubscribe(chOther, other))
+	require.Equal(t, 1, dw.Metrics().ActiveSubscriptions)
+
+	// The slow receiver drops the update.
+	first <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealSealing}
+	requireNotified(t, fast)
+	requireDroppedUpdates(t, dw, 1)
+
+	// Recreating the closed updates channel is a reconnect.
+	close(first)
+	second <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealSealing}
+	requireNotified(t, fast)
+	require.Equal(t, int64(1), dw.Metrics().Reconnects)
+	requireDroppedUpdates(t, dw, 2)
+}
+
+func requireDroppedUpdates(t *testing.T, dw *DealWatcher, n int64) {
+	t.Helper()
+	require.Eventually(t, func() bool { return dw.Metrics().DroppedUpdates == n }, time.Second*5, time.Millisecond*10)
+}
+
+func TestSubscribeCtx(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	updates := make(chan api.DealInfo)
+	dw, err := New(updatesClientBuilder(updates))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := make(chan struct{}, 1)
+	require.NoError(t, dw.SubscribeCtx(ctx, ch, prop))
+	require.Equal(t, ErrActiveSubscription, dw.SubscribeCtx(ctx, ch, prop))
+
+	updates <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealSealing}
+	requireNotified(t, ch)
+
+	// Canceling the context removes the subscription.
+	cancel()
+	require.Eventually(t, func() bool { return !dw.subscribed(prop) }, time.Second*5, time.Millisecond*10)
+	require.Equal(t, ErrNotFound, dw.Unsubscribe(ch, prop))
+}
+
+func TestUnsubscribeAll(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	other, _ := util.CidFromString("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	updates := make(chan api.DealInfo)
+	dw, err := New(updatesClientBuilder(updates))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+
+	chs := make([]chan struct{}, 3)
+	for i := range chs {
+		chs[i] = make(chan struct{}, 1)
+		require.NoError(t, dw.Subscribe(chs[i], prop))
+	}
+	chInfo := make(chan api.DealInfo, 1)
+	require.NoError(t, dw.SubscribeInfo(chInfo, prop))
+	chOther := make(chan struct{}, 1)
+	require.NoError(t, dw.Subscribe(chOther, other))
+	done, err := dw.SubscribeUntilDone(prop)
+	require.NoError(t, err)
+	doneOther, err := dw.SubscribeUntilDone(other)
+	require.NoError(t, err)
+
+	// Unsubscribing while updates are delivered is safe.
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		for i := 0; i < 10; i++ {
+			updates <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealSealing}
+		}
+	}()
+	require.NoError(t, dw.UnsubscribeAll(prop))
+	<-sent
+	// Removed SubscribeUntilDone channels are closed.
+	requireNotified(t, done)
+
+	require.False(t, dw.subscribed(prop))
+	require.Equal(t, ErrNotFound, dw.UnsubscribeAll(prop))
+	for _, ch := range chs {
+		require.Equal(t, ErrNotFound, dw.Unsubscribe(ch, prop))
+	}
+
+	// Other proposals keep their subscriptions.
+	require.True(t, dw.subscribed(other))
+	updates <- api.DealInfo{ProposalCid: other, State: storagemarket.StorageDealSealing}
+	requireNotified(t, chOther)
+	select {
+	case <-doneOther:
+		t.Fatal("channel of other proposal was closed")
+	default:
+	}
+}
+
+func TestSubscribeUntilDone(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	updates := make(chan api.DealInfo)
+	dw, err := New(updatesClientBuilder(updates))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+
+	done, err := dw.SubscribeUntilDone(prop)
+	require.NoError(t, err)
+	unsubscribed, err := dw.SubscribeUntilDone(prop)
+	require.NoError(t, err)
+	require.NoError(t, dw.UnsubscribeUntilDone(unsubscribed, prop))
+	require.Equal(t, ErrNotFound, dw.UnsubscribeUntilDone(unsubscribed, prop))
+	ch := make(chan struct{}, 1)
+	require.NoError(t, dw.Subscribe(ch, prop))
+
+	// Non-terminal states don't close the channel.
+	for _, s := range []storagemarket.StorageDealStatus{
+		storagemarket.StorageDealTransferring,
+		storagemarket.StorageDealCheckForAcceptance,
+		storagemarket.StorageDealSealing,
+	} {
+		updates <- api.DealInfo{ProposalCid: prop, State: s}
+		requireNotified(t, ch)
+		select {
+		case <-done:
+			t.Fatalf("channel closed on state %s", storagemarket.DealStates[s])
+		default:
+		}
+	}
+
+	updates <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealActive}
+	requireNotified(t, done)
+	requireNotified(t, ch)
+	select {
+	case <-unsubscribed:
+		t.Fatal("unsubscribed channel was closed")
+	default:
+	}
+
+	// Closed channels are removed, while other subscribers are kept.
+	require.Equal(t, ErrNotFound, dw.UnsubscribeUntilDone(done, prop))
+
+	// Subscribing after the terminal state was received closes the
+	// channel right away.
+	late, err := dw.SubscribeUntilDone(prop)
+	require.NoError(t, err)
+	requireNotified(t, late)
+	require.Equal(t, ErrNotFound, dw.UnsubscribeUntilDone(late, prop))
+
+	require.NoError(t, dw.Unsubscribe(ch, prop))
+	require.False(t, dw.subscribed(prop))
+}
+
+func TestSubscribeUntilDoneExpireTerminal(t *testing.T) {
+	t.Parallel()
+
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	updates := make(chan api.DealInfo)
+	dw, err := New(updatesClientBuilder(updates), WithExpireTerminal(true))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dw.Close()) }()
+
+	ch := make(chan struct{}, 1)
+	require.NoError(t, dw.Subscribe(ch, prop))
+	done, err := dw.SubscribeUntilDone(prop)
+	require.NoError(t, err)
+
+	// Expired subscriptions close their channels once.
+	updates <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealError}
+	requireNotified(t, ch)
+	requireNotified(t, done)
+	require.False(t, dw.subscribed(prop))
+}
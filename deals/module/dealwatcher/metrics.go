@@ -1,6 +1,8 @@
 package dealwatcher
 
 import (
+	"context"
+
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/global"
@@ -16,4 +18,11 @@ func (dw *DealWatcher) initMetrics() {
 
 	dw.metricDealUpdates = metric.Must(meter).NewInt64Counter("powergate.dealwatcher.updates.total")
 	dw.metricDealUpdatesChanFailure = metric.Must(meter).NewInt64Counter("powergate.dealwatcher.updates.chan.failure.total")
+	dw.metricReconnects = metric.Must(meter).NewInt64Counter("powergate.dealwatcher.reconnects.total")
+	dw.metricDroppedUpdates = metric.Must(meter).NewInt64Counter("powergate.dealwatcher.updates.dropped.total")
+
+	_ = metric.Must(meter).NewInt64ValueObserver("powergate.dealwatcher.subscriptions.active",
+		func(ctx context.Context, result metric.Int64ObserverResult) {
+			result.Observe(int64(dw.activeSubscriptions()))
+		}, metric.WithDescription("Proposals with active subscriptions"))
 }
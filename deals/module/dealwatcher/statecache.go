@@ -0,0 +1,109 @@
+package dealwatcher
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+)
+
+// StateCache stores the last known state of watched deals.
+type StateCache interface {
+	// Get returns the last known state of a deal. The bool result is
+	// false if the state isn't known.
+	Get(proposalCid cid.Cid) (storagemarket.StorageDealStatus, bool, error)
+	// Put sets the last known state of a deal.
+	Put(proposalCid cid.Cid, state storagemarket.StorageDealStatus) error
+	// Delete removes the state of a deal.
+	Delete(proposalCid cid.Cid) error
+}
+
+// WithStateCache sets the backend of the deal state cache. By default, it's
+// kept in memory.
+func WithStateCache(sc StateCache) Option {
+	return func(dw *DealWatcher) {
+		dw.states = sc
+	}
+}
+
+// MemoryStateCache is a StateCache kept in memory.
+type MemoryStateCache struct {
+	lock   sync.RWMutex
+	states map[cid.Cid]storagemarket.StorageDealStatus
+}
+
+var _ StateCache = (*MemoryStateCache)(nil)
+
+// NewMemoryStateCache returns a new MemoryStateCache.
+func NewMemoryStateCache() *MemoryStateCache {
+	return &MemoryStateCache{states: make(map[cid.Cid]storagemarket.StorageDealStatus)}
+}
+
+// Get implements StateCache.
+func (mc *MemoryStateCache) Get(proposalCid cid.Cid) (storagemarket.StorageDealStatus, bool, error) {
+	mc.lock.RLock()
+	defer mc.lock.RUnlock()
+	s, ok := mc.states[proposalCid]
+	return s, ok, nil
+}
+
+// Put implements StateCache.
+func (mc *MemoryStateCache) Put(proposalCid cid.Cid, state storagemarket.StorageDealStatus) error {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	mc.states[proposalCid] = state
+	return nil
+}
+
+// Delete implements StateCache.
+func (mc *MemoryStateCache) Delete(proposalCid cid.Cid) error {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	delete(mc.states, proposalCid)
+	return nil
+}
+
+// DatastoreStateCache is a StateCache persisted in a datastore.
+type DatastoreStateCache struct {
+	ds datastore.Datastore
+}
+
+var _ StateCache = (*DatastoreStateCache)(nil)
+
+// NewDatastoreStateCache returns a new DatastoreStateCache which stores
+// deal states in ds.
+func NewDatastoreStateCache(ds datastore.Datastore) *DatastoreStateCache {
+	return &DatastoreStateCache{ds: ds}
+}
+
+// Get implements StateCache.
+func (dc *DatastoreStateCache) Get(proposalCid cid.Cid) (storagemarket.StorageDealStatus, bool, error) {
+	b, err := dc.ds.Get(stateKey(proposalCid))
+	if err == datastore.ErrNotFound {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	s, err := strconv.ParseUint(string(b), 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return storagemarket.StorageDealStatus(s), true, nil
+}
+
+// Put implements StateCache.
+func (dc *DatastoreStateCache) Put(proposalCid cid.Cid, state storagemarket.StorageDealStatus) error {
+	return dc.ds.Put(stateKey(proposalCid), []byte(strconv.FormatUint(uint64(state), 10)))
+}
+
+// Delete implements StateCache.
+func (dc *DatastoreStateCache) Delete(proposalCid cid.Cid) error {
+	return dc.ds.Delete(stateKey(proposalCid))
+}
+
+func stateKey(proposalCid cid.Cid) datastore.Key {
+	return datastore.NewKey(proposalCid.String())
+}
@@ -0,0 +1,71 @@
+package dealwatcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/tests"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestStateCacheBackends(t *testing.T) {
+	t.Parallel()
+
+	backends := map[string]func() StateCache{
+		"Memory":    func() StateCache { return NewMemoryStateCache() },
+		"Datastore": func() StateCache { return NewDatastoreStateCache(tests.NewTxMapDatastore()) },
+	}
+	for name, newCache := range backends {
+		newCache := newCache
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+			other, _ := util.CidFromString("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+			sc := newCache()
+			updates := make(chan api.DealInfo)
+			dw, err := New(updatesClientBuilder(updates), WithStateCache(sc), WithExpireTerminal(true))
+			require.NoError(t, err)
+			defer func() { require.NoError(t, dw.Close()) }()
+
+			ch := make(chan struct{}, 1)
+			require.NoError(t, dw.Subscribe(ch, prop))
+			_, err = dw.LastState(prop)
+			require.Equal(t, ErrNotFound, err)
+
+			// Only states of subscribed deals are cached.
+			updates <- api.DealInfo{ProposalCid: other, State: storagemarket.StorageDealSealing}
+			updates <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealSealing}
+			requireNotified(t, ch)
+			s, err := dw.LastState(prop)
+			require.NoError(t, err)
+			require.Equal(t, storagemarket.StorageDealSealing, s)
+			_, ok, err := sc.Get(other)
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			// Expired subscriptions forget the cached state.
+			updates <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealActive}
+			requireNotified(t, ch)
+			require.Eventually(t, func() bool {
+				_, err := dw.LastState(prop)
+				return err == ErrNotFound
+			}, time.Second*5, time.Millisecond*10)
+
+			// Unsubscribing the last subscriber forgets the cached state.
+			require.NoError(t, dw.Subscribe(ch, prop))
+			updates <- api.DealInfo{ProposalCid: prop, State: storagemarket.StorageDealSealing}
+			requireNotified(t, ch)
+			_, ok, err = sc.Get(prop)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.NoError(t, dw.Unsubscribe(ch, prop))
+			_, ok, err = sc.Get(prop)
+			require.NoError(t, err)
+			require.False(t, ok)
+		})
+	}
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/textileio/powergate/v2/deals/module/dealwatcher"
 	"github.com/textileio/powergate/v2/deals/module/store"
 	"github.com/textileio/powergate/v2/lotus"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+	"github.com/textileio/powergate/v2/util"
 	"go.opentelemetry.io/otel/metric"
 )
 
@@ -32,6 +34,8 @@ type Module struct {
 	cfg                 *deals.Config
 	store               *store.Store
 	dealWatcher         *dealwatcher.DealWatcher
+	transfers           *transferLimiter
+	proposals           *startedProposals
 	pollDuration        time.Duration
 	dealFinalityTimeout time.Duration
 
@@ -41,7 +45,7 @@ type Module struct {
 
 // New creates a new Module.
 func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, pollDuration time.Duration, dealFinalityTimeout time.Duration, opts ...deals.Option) (*Module, error) {
-	var cfg deals.Config
+	cfg := deals.Config{CollateralTopUp: true, MinDealStartOffset: util.MinDealStartOffset}
 	for _, o := range opts {
 		if err := o(&cfg); err != nil {
 			return nil, err
@@ -49,7 +53,14 @@ func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, pollDurat
 	}
 
 	log.Infof("creating deal watcher")
-	dw, err := dealwatcher.New(clientBuilder)
+	var dwOpts []dealwatcher.Option
+	if cfg.PersistDealStates {
+		dwOpts = append(dwOpts, dealwatcher.WithStateCache(dealwatcher.NewDatastoreStateCache(txndstr.Wrap(ds, "dealwatcher/states"))))
+	}
+	if len(cfg.WatchedClients) > 0 {
+		dwOpts = append(dwOpts, dealwatcher.WithClientAddrs(cfg.WatchedClients...))
+	}
+	dw, err := dealwatcher.New(clientBuilder, dwOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("creating deal watcher: %s", err)
 	}
@@ -60,6 +71,8 @@ func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, pollDurat
 		pollDuration:        pollDuration,
 		dealFinalityTimeout: dealFinalityTimeout,
 		dealWatcher:         dw,
+		transfers:           newTransferLimiter(cfg.MaxConcurrentTransfers),
+		proposals:           newStartedProposals(),
 	}
 	m.initMetrics()
 
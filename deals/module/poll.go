@@ -0,0 +1,37 @@
+package module
+
+import "time"
+
+// pollSchedule is the interval between deal state checks. It starts
+// at base, and backoff doubles it up to max. A max lower or equal
+// than base disables the backoff.
+type pollSchedule struct {
+	base    time.Duration
+	max     time.Duration
+	current time.Duration
+}
+
+func newPollSchedule(base, max time.Duration) *pollSchedule {
+	return &pollSchedule{base: base, max: max, current: base}
+}
+
+// interval returns the time to wait before the next check.
+func (ps *pollSchedule) interval() time.Duration {
+	return ps.current
+}
+
+// backoff increases the interval after a check without news.
+func (ps *pollSchedule) backoff() {
+	if ps.max <= ps.base {
+		return
+	}
+	ps.current *= 2
+	if ps.current > ps.max {
+		ps.current = ps.max
+	}
+}
+
+// reset restores the base interval after a state change.
+func (ps *pollSchedule) reset() {
+	ps.current = ps.base
+}
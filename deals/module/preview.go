@@ -0,0 +1,63 @@
+package module
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/util"
+)
+
+// ProposalPreview is a deal proposal which would be started by Store.
+type ProposalPreview struct {
+	// Params are the parameters sent to the Lotus node to start the deal.
+	Params api.StartDealParams
+	// Message is the serialized Params, exactly as sent to the Lotus node.
+	// The proposal cid isn't known, since the Lotus node builds and signs
+	// the proposal when the deal is started.
+	Message []byte
+}
+
+// PreviewProposal returns the deal proposal which Store would start with
+// the miner of dcfg at the current chain height, without sending it. It
+// doesn't check the miner ask nor the client market funds.
+func (m *Module) PreviewProposal(ctx context.Context, waddr string, dataCid cid.Cid, pieceSize abi.PaddedPieceSize, pieceCid cid.Cid, dcfg deals.StorageDealConfig, minDuration uint64) (ProposalPreview, error) {
+	if minDuration < util.MinDealDuration {
+		return ProposalPreview{}, fmt.Errorf("duration %d should be greater or equal to %d", minDuration, util.MinDealDuration)
+	}
+	addr, err := address.NewFromString(waddr)
+	if err != nil {
+		return ProposalPreview{}, fmt.Errorf("parsing wallet address: %s", err)
+	}
+	maddr, err := address.NewFromString(dcfg.Miner)
+	if err != nil {
+		return ProposalPreview{}, fmt.Errorf("parsing miner address: %s", err)
+	}
+	lapi, cls, err := m.clientBuilder(ctx)
+	if err != nil {
+		return ProposalPreview{}, fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+	ts, err := lapi.ChainHead(ctx)
+	if err != nil {
+		return ProposalPreview{}, fmt.Errorf("getting chain head: %s", err)
+	}
+
+	params, err := startDealParams(addr, maddr, ts.Height(), dataCid, pieceSize, pieceCid, dcfg, minDuration, m.cfg.MinDealStartOffset)
+	if err != nil {
+		return ProposalPreview{}, err
+	}
+	msg, err := json.Marshal(params)
+	if err != nil {
+		return ProposalPreview{}, fmt.Errorf("serializing proposal: %s", err)
+	}
+	return ProposalPreview{
+		Params:  *params,
+		Message: msg,
+	}, nil
+}
@@ -11,6 +11,7 @@ import (
 	sm "github.com/filecoin-project/go-fil-markets/storagemarket"
 	"github.com/filecoin-project/lotus/api"
 	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/textileio/powergate/v2/deals"
 	"github.com/textileio/powergate/v2/util"
 )
@@ -89,6 +90,22 @@ func (m *Module) ListStorageDealRecords(opts ...deals.DealRecordsOption) ([]deal
 	return filtered, nil
 }
 
+// ImportStorageDealRecord saves a final storage deal record of a deal
+// which wasn't made by the module, e.g. found while reconciling with
+// other deal sources.
+func (m *Module) ImportStorageDealRecord(dr deals.StorageDealRecord) error {
+	if dr.Pending {
+		return fmt.Errorf("imported records can't be pending")
+	}
+	if !dr.DealInfo.ProposalCid.Defined() {
+		return fmt.Errorf("proposal cid is undefined")
+	}
+	if err := m.store.PutStorageDeal(dr); err != nil {
+		return fmt.Errorf("saving deal record: %s", err)
+	}
+	return nil
+}
+
 // ListRetrievalDealRecords returns a list of retrieval deals according to the provided options.
 func (m *Module) ListRetrievalDealRecords(opts ...deals.DealRecordsOption) ([]deals.RetrievalDealRecord, error) {
 	c := deals.DealRecordsConfig{}
@@ -169,19 +186,29 @@ func (m *Module) resumeWatchingPendingRecords() error {
 
 	log.Infof("resuming %d pending records", len(pendingStorageRecords))
 	for _, dr := range pendingStorageRecords {
+		m.dealWatcher.SetDealClient(dr.DealInfo.ProposalCid, dr.Addr)
 		remaining := time.Until(time.Unix(dr.Time, 0).Add(m.dealFinalityTimeout))
 		if remaining <= 0 {
 			go m.finalizePendingDeal(dr)
-		} else {
-			go m.eventuallyFinalizeDeal(dr, remaining)
+			continue
+		}
+		// Deals which weren't sealing yet can still be transferring
+		// data, so they're counted as running transfers.
+		releaseTransfer := func() {}
+		if dr.DataTransferEnd == 0 && dr.SealingStart == 0 {
+			releaseTransfer = m.transfers.track()
 		}
+		go m.eventuallyFinalizeDeal(dr, remaining, releaseTransfer)
 	}
 	log.Infof("resumed watching %d pending storage deal records", len(pendingStorageRecords))
 
 	return nil
 }
 
-func (m *Module) recordDeal(params *api.StartDealParams, proposalCid cid.Cid, dataSize int64) {
+// recordDeal saves a pending record of a started deal, and watches it
+// until final. releaseTransfer is called when the data transfer of the
+// deal finishes.
+func (m *Module) recordDeal(params *api.StartDealParams, proposalCid cid.Cid, dataSize int64, releaseTransfer func()) {
 	di := deals.StorageDealInfo{
 		Duration:      params.MinBlocksDuration,
 		PricePerEpoch: params.EpochPrice.Uint64(),
@@ -198,10 +225,11 @@ func (m *Module) recordDeal(params *api.StartDealParams, proposalCid cid.Cid, da
 	}
 	log.Infof("storing pending deal record for proposal cid: %s", util.CidToString(proposalCid))
 	if err := m.store.PutStorageDeal(record); err != nil {
+		releaseTransfer()
 		log.Errorf("storing pending deal: %v", err)
 		return
 	}
-	go m.eventuallyFinalizeDeal(record, m.dealFinalityTimeout)
+	go m.eventuallyFinalizeDeal(record, m.dealFinalityTimeout, releaseTransfer)
 }
 
 func (m *Module) finalizePendingDeal(dr deals.StorageDealRecord) {
@@ -257,7 +285,11 @@ func (m *Module) finalizePendingDeal(dr deals.StorageDealRecord) {
 	}
 }
 
-func (m *Module) eventuallyFinalizeDeal(dr deals.StorageDealRecord, timeout time.Duration) {
+func (m *Module) eventuallyFinalizeDeal(dr deals.StorageDealRecord, timeout time.Duration, releaseTransfer func()) {
+	defer releaseTransfer()
+	// Once the deal is final, its watchers have a while to retry it.
+	proposal := dr.DealInfo.ProposalCid
+	defer time.AfterFunc(proposalRetryWindow, func() { m.proposals.take(proposal) })
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 	m.metricDealTracking.Add(ctx, 1)
@@ -324,6 +356,7 @@ func (m *Module) eventuallyFinalizeDeal(dr deals.StorageDealRecord, timeout time
 			// If we're in this status, data transfer should already finished, so just check if we got into
 			// that situation and account for it. Shouldn't happen if Lotus doesn't misreports events.
 			case sm.StorageDealCheckForAcceptance, sm.StorageDealProposalAccepted, sm.StorageDealAwaitingPreCommit:
+				releaseTransfer()
 				if dr.DataTransferStart > 0 && dr.DataTransferEnd == 0 {
 					dr.DataTransferEnd = time.Now().Unix()
 					if err := m.store.PutStorageDeal(dr); err != nil {
@@ -331,6 +364,7 @@ func (m *Module) eventuallyFinalizeDeal(dr deals.StorageDealRecord, timeout time
 					}
 				}
 			case sm.StorageDealSealing:
+				releaseTransfer()
 				if dr.SealingStart == 0 {
 					dr.SealingStart = time.Now().Unix()
 					if err := m.store.PutStorageDeal(dr); err != nil {
@@ -345,6 +379,9 @@ func (m *Module) eventuallyFinalizeDeal(dr deals.StorageDealRecord, timeout time
 					log.Errorf("saving data transfer start time: %s", err)
 				}
 			}
+			if !u.end.IsZero() {
+				releaseTransfer()
+			}
 			if dr.DataTransferEnd == 0 && !u.end.IsZero() {
 				dr.DataTransferEnd = u.end.Unix()
 				if err := m.store.PutStorageDeal(dr); err != nil {
@@ -375,14 +412,43 @@ func (m *Module) dataTransferUpdates(ctx context.Context, proposalCid cid.Cid, u
 		return fmt.Errorf("measure data transfer getting update channel from lotus: %s", err)
 	}
 
+	return trackDataTransfer(ctx, ch, proposalCid, updates, m.cfg.TransferRestarts, m.cfg.TransferStallTimeout, lapi.ClientRestartDataTransfer)
+}
+
+// restartTransferFunc restarts an existing data transfer channel, which
+// resumes sending the data not yet received by the other peer.
+type restartTransferFunc func(ctx context.Context, transferID datatransfer.TransferID, otherPeer peer.ID, isInitiator bool) error
+
+// trackDataTransfer reports the start and end of the data transfer of a
+// storage deal from Lotus data transfer updates. Ongoing transfers which
+// don't make progress for stallTimeout are restarted up to maxRestarts
+// times. Failed and cancelled transfers are final, so they aren't
+// restarted.
+func trackDataTransfer(ctx context.Context, ch <-chan api.DataTransferChannel, proposalCid cid.Cid, updates chan<- dataTransferUpdate, maxRestarts int, stallTimeout time.Duration, restart restartTransferFunc) error {
+	var restarts int
 	var dtStart time.Time
+	var last api.DataTransferChannel
+	// A nil channel never fires, which disables the stall detection
+	// while the transfer isn't ongoing or restarts are exhausted.
+	var stalled <-chan time.Time
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
+		case <-stalled:
+			stalled = nil
+			if restarts >= maxRestarts {
+				continue
+			}
+			restarts++
+			log.Infof("restarting stalled data transfer %d of proposal %s (%d/%d)", last.TransferID, util.CidToString(proposalCid), restarts, maxRestarts)
+			if err := restart(ctx, last.TransferID, last.OtherPeer, last.IsInitiator); err != nil {
+				return fmt.Errorf("restarting data transfer %d: %s", last.TransferID, err)
+			}
+			stalled = time.After(stallTimeout)
 		case u, ok := <-ch:
 			if !ok {
-				return fmt.Errorf("data transfer updates channel unexpectedly closed: %s", err)
+				return fmt.Errorf("data transfer updates channel unexpectedly closed")
 			}
 			var dtVoucher dealTransferVoucher
 			if err := json.Unmarshal([]byte(u.Voucher), &dtVoucher); err != nil {
@@ -397,12 +463,19 @@ func (m *Module) dataTransferUpdates(ctx context.Context, proposalCid cid.Cid, u
 					dtStart = time.Now()
 					updates <- dataTransferUpdate{start: dtStart, end: time.Time{}}
 				}
+				progressed := last.Status != datatransfer.Ongoing || u.Transferred != last.Transferred
+				if progressed && restarts < maxRestarts {
+					stalled = time.After(stallTimeout)
+				}
 			case datatransfer.Completed:
 				updates <- dataTransferUpdate{start: dtStart, end: time.Now()}
 				return nil
 			case datatransfer.Failed, datatransfer.Cancelled:
 				return nil
+			default:
+				stalled = nil
 			}
+			last = u
 		}
 	}
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/filecoin-project/lotus/api"
 	marketevents "github.com/filecoin-project/lotus/markets/loggers"
 	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/deals"
 )
 
 var (
@@ -33,7 +34,31 @@ func (m *Module) Fetch(ctx context.Context, waddr string, payloadCid cid.Cid, pi
 		return "", nil, fmt.Errorf("creating lotus client: %s", err)
 	}
 
-	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, nil)
+	offers := getRetrievalOffers(ctx, lapi, payloadCid, pieceCid, miners)
+	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, offers, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	return miner, events, nil
+}
+
+// FetchPrefetched is like Fetch, but uses the offers of a previous
+// QueryRetrievalAvailability call instead of querying the miners again.
+// Offers are tried in the provided order, and miners without an offer
+// are skipped.
+func (m *Module) FetchPrefetched(ctx context.Context, waddr string, payloadCid cid.Cid, avs []deals.RetrievalAvailability) (string, <-chan marketevents.RetrievalEvent, error) {
+	var offers []api.QueryOffer
+	for _, av := range avs {
+		if av.Offer != nil {
+			offers = append(offers, *av.Offer)
+		}
+	}
+	lapi, cls, err := m.clientBuilder(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("creating lotus client: %s", err)
+	}
+
+	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, offers, nil)
 	if err != nil {
 		return "", nil, err
 	}
@@ -56,7 +81,8 @@ func (m *Module) Retrieve(ctx context.Context, waddr string, payloadCid cid.Cid,
 	if err != nil {
 		return "", nil, fmt.Errorf("creating lotus client: %s", err)
 	}
-	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, &ref)
+	offers := getRetrievalOffers(ctx, lapi, payloadCid, pieceCid, miners)
+	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, offers, &ref)
 	if err != nil {
 		return "", nil, fmt.Errorf("retrieving from lotus: %s", err)
 	}
@@ -73,17 +99,24 @@ func (m *Module) Retrieve(ctx context.Context, waddr string, payloadCid cid.Cid,
 	return miner, &autodeleteFile{File: f}, nil
 }
 
-func (m *Module) retrieve(ctx context.Context, lapi *api.FullNodeStruct, lapiCls func(), waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string, ref *api.FileRef) (string, <-chan marketevents.RetrievalEvent, error) {
+// retrieve makes the retrieval trying the offers in the provided order,
+// until a miner accepts it.
+func (m *Module) retrieve(ctx context.Context, lapi *api.FullNodeStruct, lapiCls func(), waddr string, payloadCid cid.Cid, sortedOffers []api.QueryOffer, ref *api.FileRef) (string, <-chan marketevents.RetrievalEvent, error) {
 	addr, err := address.NewFromString(waddr)
 	if err != nil {
 		return "", nil, fmt.Errorf("parsing wallet address: %s", err)
 	}
 
-	sortedOffers := getRetrievalOffers(ctx, lapi, payloadCid, pieceCid, miners)
 	if len(sortedOffers) == 0 {
 		return "", nil, ErrRetrievalNoAvailableProviders
 	}
 
+	// The slot is released when the retrieval finishes.
+	release, err := m.transfers.acquire(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("waiting for a data transfer slot: %s", err)
+	}
+
 	var events <-chan marketevents.RetrievalEvent
 
 	// Try to make the retrieval in the specified offers order, until we
@@ -97,10 +130,16 @@ func (m *Module) retrieve(ctx context.Context, lapi *api.FullNodeStruct, lapiCls
 		}
 		break
 	}
+	if err != nil {
+		release()
+		lapiCls()
+		return "", nil, fmt.Errorf("no miner accepted the retrieval: %s", err)
+	}
 
 	out := make(chan marketevents.RetrievalEvent, 1)
 	go func() {
 		defer lapiCls()
+		defer release()
 		defer close(out)
 		m.metricRetrievalTracking.Add(ctx, 1)
 		defer m.metricRetrievalTracking.Add(ctx, -1)
@@ -167,6 +206,45 @@ func (m *Module) retrieve(ctx context.Context, lapi *api.FullNodeStruct, lapiCls
 	return o.MinerPeer.Address.String(), out, nil
 }
 
+// QueryRetrievalAvailability asks miners if they can serve a retrieval,
+// and if they have an unsealed copy of the data. Querying a miner
+// connects to it, so it also warms up connections for later retrievals.
+func (m *Module) QueryRetrievalAvailability(ctx context.Context, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string) ([]deals.RetrievalAvailability, error) {
+	lapi, cls, err := m.clientBuilder(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+
+	ret := make([]deals.RetrievalAvailability, 0, len(miners))
+	for _, mi := range miners {
+		ra := deals.RetrievalAvailability{Miner: mi}
+		a, err := address.NewFromString(mi)
+		if err != nil {
+			ra.Err = fmt.Sprintf("parsing miner address: %s", err)
+			ret = append(ret, ra)
+			continue
+		}
+		qo, err := lapi.ClientMinerQueryOffer(ctx, a, payloadCid, pieceCid)
+		if err != nil {
+			ra.Err = fmt.Sprintf("query-offer failed: %s", err)
+			ret = append(ret, ra)
+			continue
+		}
+		if qo.Err != "" {
+			ra.Err = qo.Err
+			ret = append(ret, ra)
+			continue
+		}
+		ra.MinPrice = qo.MinPrice.Uint64()
+		ra.UnsealPrice = qo.UnsealPrice.Uint64()
+		ra.Unsealed = qo.UnsealPrice.IsZero()
+		ra.Offer = &qo
+		ret = append(ret, ra)
+	}
+	return ret, nil
+}
+
 func getRetrievalOffers(ctx context.Context, lapi *api.FullNodeStruct, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string) []api.QueryOffer {
 	// Ask each miner about costs and information about retrieving this data.
 	var offers []api.QueryOffer
@@ -0,0 +1,41 @@
+package module
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/ipfs/go-cid"
+)
+
+// SealingProgress returns an estimation of how far along the sealing of the
+// sector containing a deal is, as a percentage between 0 and 100. Lotus
+// clients don't have access to the miner sealing pipeline, so the progress
+// is inferred from the deal state.
+func (m *Module) SealingProgress(ctx context.Context, proposal cid.Cid) (int, error) {
+	di, err := m.getStorageDealInfo(ctx, proposal)
+	if err != nil {
+		return 0, fmt.Errorf("getting deal info: %s", err)
+	}
+	return di.SealingProgress, nil
+}
+
+// sealingProgress maps a deal state to a sealing progress percentage.
+// States before the deal is handed to the miner sealing pipeline, and
+// failed states, have no progress.
+func sealingProgress(state uint64) int {
+	switch state {
+	case storagemarket.StorageDealStaged:
+		return 10
+	case storagemarket.StorageDealAwaitingPreCommit:
+		return 25
+	case storagemarket.StorageDealSealing:
+		return 50
+	case storagemarket.StorageDealFinalizing:
+		return 75
+	case storagemarket.StorageDealActive:
+		return 100
+	default:
+		return 0
+	}
+}
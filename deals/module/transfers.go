@@ -0,0 +1,76 @@
+package module
+
+import (
+	"context"
+	"sync"
+)
+
+// transferLimiter caps the number of concurrent data transfers of
+// storage and retrieval deals. Transfers exceeding the cap wait until
+// a running transfer finishes.
+type transferLimiter struct {
+	lock    sync.Mutex
+	max     int
+	current int
+	// freed is closed and replaced when a transfer finishes.
+	freed chan struct{}
+}
+
+// newTransferLimiter returns a limiter allowing max concurrent
+// transfers. Zero or negative values disable the cap.
+func newTransferLimiter(max int) *transferLimiter {
+	return &transferLimiter{max: max, freed: make(chan struct{})}
+}
+
+// acquire waits for a free transfer slot. The returned func releases
+// the slot, and only its first call has effect.
+func (tl *transferLimiter) acquire(ctx context.Context) (func(), error) {
+	for {
+		tl.lock.Lock()
+		if tl.max <= 0 || tl.current < tl.max {
+			tl.current++
+			tl.lock.Unlock()
+			return tl.releaseFunc(), nil
+		}
+		freed := tl.freed
+		tl.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-freed:
+		}
+	}
+}
+
+// track counts a transfer which is already running, e.g. of a deal
+// resumed after a restart, without waiting for a free slot. It can
+// exceed the cap, in which case new transfers wait until enough
+// running ones finish. The returned func releases the slot, and only
+// its first call has effect.
+func (tl *transferLimiter) track() func() {
+	tl.lock.Lock()
+	defer tl.lock.Unlock()
+	tl.current++
+	return tl.releaseFunc()
+}
+
+func (tl *transferLimiter) releaseFunc() func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			tl.lock.Lock()
+			defer tl.lock.Unlock()
+			tl.current--
+			close(tl.freed)
+			tl.freed = make(chan struct{})
+		})
+	}
+}
+
+// running returns the number of running transfers.
+func (tl *transferLimiter) running() int {
+	tl.lock.Lock()
+	defer tl.lock.Unlock()
+	return tl.current
+}
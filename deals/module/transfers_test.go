@@ -0,0 +1,117 @@
+package module
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferLimiter(t *testing.T) {
+	t.Parallel()
+
+	const max = 3
+	tl := newTransferLimiter(max)
+
+	var (
+		lock          sync.Mutex
+		running       int
+		maxConcurrent int
+		wg            sync.WaitGroup
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := tl.acquire(context.Background())
+			if err != nil {
+				return
+			}
+			defer release()
+
+			lock.Lock()
+			running++
+			if running > maxConcurrent {
+				maxConcurrent = running
+			}
+			lock.Unlock()
+
+			time.Sleep(time.Millisecond * 10)
+
+			lock.Lock()
+			running--
+			lock.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, maxConcurrent, max)
+	require.Equal(t, 0, tl.running())
+}
+
+func TestTransferLimiterRelease(t *testing.T) {
+	t.Parallel()
+
+	tl := newTransferLimiter(1)
+	release, err := tl.acquire(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, tl.running())
+
+	// Queued transfers wait until the context is canceled.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	_, err = tl.acquire(ctx)
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	// Releasing twice frees a single slot.
+	release()
+	release()
+	require.Equal(t, 0, tl.running())
+	release, err = tl.acquire(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, tl.running())
+	release()
+}
+
+func TestTransferLimiterUnlimited(t *testing.T) {
+	t.Parallel()
+
+	tl := newTransferLimiter(0)
+	for i := 0; i < 10; i++ {
+		_, err := tl.acquire(context.Background())
+		require.NoError(t, err)
+	}
+}
+
+func TestTransferLimiterTrack(t *testing.T) {
+	t.Parallel()
+
+	tl := newTransferLimiter(1)
+
+	// Resumed transfers are counted even over the cap.
+	resumed1 := tl.track()
+	resumed2 := tl.track()
+	require.Equal(t, 2, tl.running())
+
+	acquired := make(chan func())
+	go func() {
+		release, err := tl.acquire(context.Background())
+		require.NoError(t, err)
+		acquired <- release
+	}()
+
+	// New transfers wait until running ones are below the cap.
+	resumed1()
+	select {
+	case <-acquired:
+		t.Fatal("transfer acquired a slot over the cap")
+	case <-time.After(time.Millisecond * 50):
+	}
+	resumed2()
+	release := <-acquired
+	require.Equal(t, 1, tl.running())
+	release()
+	require.Equal(t, 0, tl.running())
+}
@@ -0,0 +1,33 @@
+package module
+
+import (
+	"fmt"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	format "github.com/ipfs/go-ipld-format"
+
+	// Registers the block decoders for supported IPLD codecs.
+	_ "github.com/ipfs/go-merkledag"
+)
+
+// DecodeVerifiedBlock decodes the raw data of the block with Cid c, checking
+// that the data hashes to c. It returns an error if the data was tampered.
+func DecodeVerifiedBlock(c cid.Cid, data []byte) (format.Node, error) {
+	chk, err := c.Prefix().Sum(data)
+	if err != nil {
+		return nil, fmt.Errorf("hashing block data: %s", err)
+	}
+	if !chk.Equals(c) {
+		return nil, fmt.Errorf("block data doesn't match cid, got %s", chk)
+	}
+	blk, err := blocks.NewBlockWithCid(data, c)
+	if err != nil {
+		return nil, fmt.Errorf("creating block: %s", err)
+	}
+	nd, err := format.Decode(blk)
+	if err != nil {
+		return nil, fmt.Errorf("decoding block: %s", err)
+	}
+	return nd, nil
+}
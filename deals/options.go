@@ -1,10 +1,47 @@
 package deals
 
-import "os"
+import (
+	"fmt"
+	"os"
+	"time"
+)
 
 // Config contains configuration for storing deals.
 type Config struct {
 	ImportPath string
+	// CollateralTopUp indicates if market funds shortfalls detected
+	// before proposing deals should be covered by adding balance
+	// from the client wallet, instead of failing the proposal. It's
+	// enabled by default.
+	CollateralTopUp bool
+	// MinDealStartOffset is the minimum number of epochs between a
+	// proposal and its deal start epoch. Proposals starting sooner are
+	// rejected, since miners can't seal them in time. The default is
+	// util.MinDealStartOffset.
+	MinDealStartOffset int64
+	// PersistDealStates indicates if the states of watched deals are
+	// cached in the datastore instead of memory.
+	PersistDealStates bool
+	// PollBackoffMax is the maximum interval between deal state checks
+	// while watching a deal. If it's greater than the poll interval,
+	// the interval doubles after every check without a state change,
+	// up to this value, and it's reset when the state changes.
+	PollBackoffMax time.Duration
+	// MaxConcurrentTransfers is the maximum number of storage and
+	// retrieval data transfers running at the same time. Excess
+	// transfers wait for running ones to finish. Zero means no limit.
+	MaxConcurrentTransfers int
+	// TransferRestarts is the maximum number of times a stalled storage
+	// data transfer is restarted. Restarted transfers resume from the
+	// data already received by the miner. Zero disables restarts.
+	TransferRestarts int
+	// TransferStallTimeout is how long an ongoing storage data transfer
+	// can go without progress before it's considered stalled.
+	TransferStallTimeout time.Duration
+	// WatchedClients are the client wallet addresses whose deal updates
+	// are processed by the deal watcher. Updates of deals of other known
+	// clients are dropped. Empty means all clients.
+	WatchedClients []string
 }
 
 // Option sets values on a Config.
@@ -22,6 +59,90 @@ func WithImportPath(path string) Option {
 	}
 }
 
+// WithMinDealStartOffset sets the minimum number of epochs between a
+// proposal and its deal start epoch. It should be at least the sealing
+// lead time of the miners deals are made with.
+func WithMinDealStartOffset(epochs int64) Option {
+	return func(c *Config) error {
+		if epochs < 0 {
+			return fmt.Errorf("min deal start offset can't be negative")
+		}
+		c.MinDealStartOffset = epochs
+		return nil
+	}
+}
+
+// WithCollateralTopUp indicates if missing market funds to cover
+// proposed deals should be automatically added from the client wallet.
+func WithCollateralTopUp(enabled bool) Option {
+	return func(c *Config) error {
+		c.CollateralTopUp = enabled
+		return nil
+	}
+}
+
+// WithPersistDealStates indicates if the states of watched deals
+// should be cached in the datastore instead of memory.
+func WithPersistDealStates(enabled bool) Option {
+	return func(c *Config) error {
+		c.PersistDealStates = enabled
+		return nil
+	}
+}
+
+// WithPollBackoff enables an exponential backoff of deal state checks
+// while watching deals, bounded by max. Zero disables the backoff, so
+// the state is checked at a fixed interval.
+func WithPollBackoff(max time.Duration) Option {
+	return func(c *Config) error {
+		if max < 0 {
+			return fmt.Errorf("poll backoff max can't be negative")
+		}
+		c.PollBackoffMax = max
+		return nil
+	}
+}
+
+// WithMaxConcurrentTransfers caps the number of storage and retrieval
+// data transfers running at the same time. Zero disables the cap.
+func WithMaxConcurrentTransfers(max int) Option {
+	return func(c *Config) error {
+		if max < 0 {
+			return fmt.Errorf("max concurrent transfers can't be negative")
+		}
+		c.MaxConcurrentTransfers = max
+		return nil
+	}
+}
+
+// WithWatchedClients restricts the deal updates processed by the deal
+// watcher to deals of the provided client wallet addresses, which is
+// useful with a Lotus node shared by many clients. Empty means all.
+func WithWatchedClients(addrs ...string) Option {
+	return func(c *Config) error {
+		c.WatchedClients = addrs
+		return nil
+	}
+}
+
+// WithTransferRestarts enables restarting storage data transfers which
+// are ongoing but didn't make progress for stallTimeout, up to max times,
+// resuming from the data already received by the miner. Zero disables
+// restarts. Failed or cancelled transfers aren't restarted.
+func WithTransferRestarts(max int, stallTimeout time.Duration) Option {
+	return func(c *Config) error {
+		if max < 0 {
+			return fmt.Errorf("transfer restarts can't be negative")
+		}
+		if max > 0 && stallTimeout <= 0 {
+			return fmt.Errorf("transfer stall timeout should be positive")
+		}
+		c.TransferRestarts = max
+		c.TransferStallTimeout = stallTimeout
+		return nil
+	}
+}
+
 // DealRecordsConfig specifies the options for DealsManager.List.
 type DealRecordsConfig struct {
 	FromAddrs      []string
@@ -1,6 +1,7 @@
 package deals
 
 import (
+	"github.com/filecoin-project/lotus/api"
 	"github.com/ipfs/go-cid"
 )
 
@@ -11,6 +12,10 @@ type StorageDealConfig struct {
 	FastRetrieval   bool
 	DealStartOffset int64
 	VerifiedDeal    bool
+	// MaxPrice is the maximum epoch price accepted for the deal. If
+	// greater than zero, the miner's live ask is re-validated right
+	// before proposing the deal. Zero means no re-validation.
+	MaxPrice uint64
 }
 
 // StoreResult contains information about Executing deals.
@@ -38,6 +43,11 @@ type StorageDealInfo struct {
 	DealID          uint64
 	ActivationEpoch int64
 	Message         string
+	Verified        bool
+
+	// SealingProgress is the estimated percentage of the sealing
+	// of the sector containing the deal.
+	SealingProgress int
 }
 
 // StorageDealRecord represents a storage deal log record.
@@ -56,6 +66,22 @@ type StorageDealRecord struct {
 	UpdatedAt         int64
 }
 
+// RetrievalAvailability describes if a miner can serve a retrieval.
+type RetrievalAvailability struct {
+	Miner string
+	// Unsealed indicates the miner has an unsealed copy of the data,
+	// so the retrieval doesn't pay for unsealing.
+	Unsealed    bool
+	MinPrice    uint64
+	UnsealPrice uint64
+	// Err is the reason the miner can't serve the retrieval.
+	Err string
+	// Offer is the query offer of the miner, if it can serve the
+	// retrieval. It can be used to start the retrieval without
+	// querying the miner again.
+	Offer *api.QueryOffer
+}
+
 // RetrievalDealInfo contains information about a retrieval deal.
 type RetrievalDealInfo struct {
 	RootCid                 cid.Cid
@@ -0,0 +1,64 @@
+package diagnostics
+
+import (
+	"fmt"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+// PreviewSize is the max number of bytes of a value included in a
+// KeyValueMeta preview.
+const PreviewSize = 64
+
+// KeyValueMeta describes a key stored in the datastore.
+type KeyValueMeta struct {
+	Key     string
+	Size    int
+	Preview []byte
+}
+
+// Diagnostics provides tools to inspect the raw state of the datastore.
+type Diagnostics struct {
+	ds datastore.Read
+}
+
+// New returns a new Diagnostics for the provided datastore.
+func New(ds datastore.Read) *Diagnostics {
+	return &Diagnostics{ds: ds}
+}
+
+// ScanKeys returns at most limit keys with the provided prefix, in key
+// order, with their value size and a preview of the first PreviewSize bytes.
+func (d *Diagnostics) ScanKeys(prefix string, limit int) ([]KeyValueMeta, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit should be positive")
+	}
+	q := query.Query{
+		Prefix: prefix,
+		Orders: []query.Order{query.OrderByKey{}},
+		Limit:  limit,
+	}
+	res, err := d.ds.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+
+	var kvs []KeyValueMeta
+	for v := range res.Next() {
+		if v.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", v.Error)
+		}
+		preview := v.Value
+		if len(preview) > PreviewSize {
+			preview = preview[:PreviewSize]
+		}
+		kvs = append(kvs, KeyValueMeta{
+			Key:     v.Key,
+			Size:    len(v.Value),
+			Preview: append([]byte(nil), preview...),
+		})
+	}
+	return kvs, nil
+}
@@ -0,0 +1,58 @@
+package diagnostics
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestScanKeys(t *testing.T) {
+	t.Parallel()
+
+	ds := tests.NewTxMapDatastore()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, ds.Put(datastore.NewKey(fmt.Sprintf("deals/%02d", i)), []byte("small")))
+		require.NoError(t, ds.Put(datastore.NewKey(fmt.Sprintf("ffs/manager/%02d", i)), bytes.Repeat([]byte{'x'}, 100)))
+	}
+	d := New(ds)
+
+	t.Run("Prefix", func(t *testing.T) {
+		kvs, err := d.ScanKeys("/ffs", 100)
+		require.NoError(t, err)
+		require.Len(t, kvs, 10)
+		for i, kv := range kvs {
+			require.Equal(t, fmt.Sprintf("/ffs/manager/%02d", i), kv.Key)
+			require.Equal(t, 100, kv.Size)
+			require.Len(t, kv.Preview, PreviewSize)
+		}
+
+		kvs, err = d.ScanKeys("/deals", 100)
+		require.NoError(t, err)
+		require.Len(t, kvs, 10)
+		require.Equal(t, 5, kvs[0].Size)
+		require.Equal(t, []byte("small"), kvs[0].Preview)
+
+		kvs, err = d.ScanKeys("/missing", 100)
+		require.NoError(t, err)
+		require.Empty(t, kvs)
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		kvs, err := d.ScanKeys("", 3)
+		require.NoError(t, err)
+		require.Len(t, kvs, 3)
+		require.Equal(t, "/deals/00", kvs[0].Key)
+		require.Equal(t, "/deals/02", kvs[2].Key)
+
+		kvs, err = d.ScanKeys("/ffs", 15)
+		require.NoError(t, err)
+		require.Len(t, kvs, 10)
+
+		_, err = d.ScanKeys("/ffs", 0)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,137 @@
+package diagnostics
+
+import (
+	"fmt"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler"
+	"github.com/textileio/powergate/v2/index/miner"
+)
+
+var log = logging.Logger("diagnostics")
+
+// Violation is a broken invariant found by a consistency check.
+type Violation struct {
+	Check  string
+	Detail string
+}
+
+// String returns a human-readable description of the violation.
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Check, v.Detail)
+}
+
+// Check verifies an invariant of the Powergate state, returning every
+// violation found.
+type Check struct {
+	Name string
+	Run  func() ([]Violation, error)
+}
+
+// InstanceLister lists existing API instances.
+type InstanceLister interface {
+	List() ([]ffs.AuthEntry, error)
+}
+
+// JobLister lists StorageJobs.
+type JobLister interface {
+	ListStorageJobs(config scheduler.ListStorageJobsConfig) ([]ffs.StorageJob, bool, string, error)
+}
+
+// DealLister lists storage deal records.
+type DealLister interface {
+	ListStorageDealRecords(opts ...deals.DealRecordsOption) ([]deals.StorageDealRecord, error)
+}
+
+// MinerIndex provides the known miners.
+type MinerIndex interface {
+	Get() miner.IndexSnapshot
+}
+
+// JobInstancesCheck verifies every StorageJob belongs to an existing
+// API instance.
+func JobInstancesCheck(il InstanceLister, jl JobLister) Check {
+	const name = "job-instances"
+	return Check{
+		Name: name,
+		Run: func() ([]Violation, error) {
+			entries, err := il.List()
+			if err != nil {
+				return nil, fmt.Errorf("listing instances: %s", err)
+			}
+			iids := make(map[ffs.APIID]struct{}, len(entries))
+			for _, e := range entries {
+				iids[e.APIID] = struct{}{}
+			}
+			jobs, _, _, err := jl.ListStorageJobs(scheduler.ListStorageJobsConfig{Select: scheduler.All})
+			if err != nil {
+				return nil, fmt.Errorf("listing storage jobs: %s", err)
+			}
+			var vs []Violation
+			for _, j := range jobs {
+				if _, ok := iids[j.APIID]; !ok {
+					vs = append(vs, Violation{
+						Check:  name,
+						Detail: fmt.Sprintf("job %s references unknown instance %s", j.ID, j.APIID),
+					})
+				}
+			}
+			return vs, nil
+		},
+	}
+}
+
+// DealMinersCheck verifies every active storage deal, either pending or
+// final and not failed, was made with a miner known by the miner index. The check is skipped while the index is empty,
+// since it may not be built yet.
+func DealMinersCheck(dl DealLister, mi MinerIndex) Check {
+	const name = "deal-miners"
+	return Check{
+		Name: name,
+		Run: func() ([]Violation, error) {
+			miners := mi.Get().OnChain.Miners
+			if len(miners) == 0 {
+				log.Infof("miner index is empty, skipping %s check", name)
+				return nil, nil
+			}
+			recs, err := dl.ListStorageDealRecords(deals.WithIncludePending(true), deals.WithIncludeFinal(true))
+			if err != nil {
+				return nil, fmt.Errorf("listing storage deal records: %s", err)
+			}
+			var vs []Violation
+			for _, r := range recs {
+				if r.ErrMsg != "" {
+					continue
+				}
+				if _, ok := miners[r.DealInfo.Miner]; !ok {
+					vs = append(vs, Violation{
+						Check:  name,
+						Detail: fmt.Sprintf("deal %s references unknown miner %s", r.DealInfo.ProposalCid, r.DealInfo.Miner),
+					})
+				}
+			}
+			return vs, nil
+		},
+	}
+}
+
+// SelfTest runs the provided checks and returns all violations found.
+// Every violation is logged as a warning. A check that fails to run is
+// logged and doesn't prevent the others from running.
+func SelfTest(checks ...Check) []Violation {
+	var all []Violation
+	for _, c := range checks {
+		vs, err := c.Run()
+		if err != nil {
+			log.Errorf("running %s check: %s", c.Name, err)
+			continue
+		}
+		for _, v := range vs {
+			log.Warnf("consistency violation: %s", v)
+		}
+		all = append(all, vs...)
+	}
+	return all
+}
@@ -0,0 +1,91 @@
+package diagnostics
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler"
+	"github.com/textileio/powergate/v2/index/miner"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestSelfTest(t *testing.T) {
+	t.Parallel()
+
+	iid := ffs.NewAPIID()
+	orphan := ffs.NewAPIID()
+	il := &instanceListerMock{entries: []ffs.AuthEntry{{APIID: iid}}}
+	jl := &jobListerMock{jobs: []ffs.StorageJob{
+		{ID: ffs.NewJobID(), APIID: iid},
+		{ID: ffs.NewJobID(), APIID: iid},
+	}}
+	prop, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	dl := &dealListerMock{recs: []deals.StorageDealRecord{
+		{DealInfo: deals.StorageDealInfo{ProposalCid: prop, Miner: "f01000"}, Pending: true},
+		{DealInfo: deals.StorageDealInfo{ProposalCid: prop, Miner: "f01001"}},
+		{DealInfo: deals.StorageDealInfo{ProposalCid: prop, Miner: "f09999"}, ErrMsg: "failed"},
+	}}
+	mi := minerIndexMock{"f01000", "f01001"}
+
+	checks := []Check{JobInstancesCheck(il, jl), DealMinersCheck(dl, mi)}
+	require.Empty(t, SelfTest(checks...))
+
+	// Inject a job of a missing instance and a deal with an unknown miner.
+	badJob := ffs.StorageJob{ID: ffs.NewJobID(), APIID: orphan}
+	jl.jobs = append(jl.jobs, badJob)
+	dl.recs = append(dl.recs, deals.StorageDealRecord{DealInfo: deals.StorageDealInfo{ProposalCid: prop, Miner: "f07777"}})
+
+	vs := SelfTest(checks...)
+	require.Len(t, vs, 2)
+	require.Equal(t, "job-instances", vs[0].Check)
+	require.Contains(t, vs[0].Detail, badJob.ID.String())
+	require.Contains(t, vs[0].Detail, orphan.String())
+	require.Equal(t, "deal-miners", vs[1].Check)
+	require.Contains(t, vs[1].Detail, "f07777")
+
+	// A failing check doesn't prevent the others from running.
+	broken := Check{Name: "broken", Run: func() ([]Violation, error) { return nil, fmt.Errorf("boom") }}
+	require.Len(t, SelfTest(broken, JobInstancesCheck(il, jl)), 1)
+
+	// Deal miners aren't checked until the miner index is built.
+	require.Empty(t, SelfTest(DealMinersCheck(dl, minerIndexMock{})))
+}
+
+type instanceListerMock struct {
+	entries []ffs.AuthEntry
+}
+
+func (il *instanceListerMock) List() ([]ffs.AuthEntry, error) {
+	return il.entries, nil
+}
+
+type jobListerMock struct {
+	jobs []ffs.StorageJob
+}
+
+func (jl *jobListerMock) ListStorageJobs(config scheduler.ListStorageJobsConfig) ([]ffs.StorageJob, bool, string, error) {
+	return jl.jobs, false, "", nil
+}
+
+type dealListerMock struct {
+	recs []deals.StorageDealRecord
+}
+
+func (dl *dealListerMock) ListStorageDealRecords(opts ...deals.DealRecordsOption) ([]deals.StorageDealRecord, error) {
+	return dl.recs, nil
+}
+
+type minerIndexMock []string
+
+func (mi minerIndexMock) Get() miner.IndexSnapshot {
+	idx := miner.IndexSnapshot{
+		OnChain: miner.ChainIndex{Miners: make(map[string]miner.OnChainMinerData, len(mi))},
+	}
+	for _, addr := range mi {
+		idx.OnChain.Miners[addr] = miner.OnChainMinerData{}
+	}
+	return idx
+}
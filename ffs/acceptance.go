@@ -0,0 +1,186 @@
+package ffs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AcceptancePolicy constrains the terms of new deals. Miners whose
+// terms don't satisfy the policy aren't selected. The zero value
+// accepts any terms.
+type AcceptancePolicy struct {
+	// MaxPrice is the maximum epoch price of the deal in attoFil
+	// per GiB. Zero means no limit.
+	MaxPrice uint64
+	// RequireVerified only accepts verified deals. Storage configs
+	// requiring it should enable VerifiedDeal.
+	RequireVerified bool
+	// RequireFastRetrieval only accepts deals with fast retrieval.
+	// Storage configs requiring it should enable FastRetrieval.
+	RequireFastRetrieval bool
+	// MinDuration is the minimum duration of the deal in epochs.
+	// Zero means no limit.
+	MinDuration int64
+	// MaxMinPieceSize rejects miners whose minimum accepted piece size
+	// is bigger than this value. Zero means no limit.
+	MaxMinPieceSize uint64
+}
+
+// DealTerms are the terms of a deal proposed to a miner.
+type DealTerms struct {
+	// Price is the epoch price asked by the miner for the deal.
+	Price uint64
+	// MinPieceSize and MaxPieceSize are the piece size bounds of the
+	// miner ask. A zero MaxPieceSize means the bounds are unknown.
+	MinPieceSize uint64
+	MaxPieceSize uint64
+	// PieceSize is the piece size of the data.
+	PieceSize uint64
+	// Verified indicates the deal is verified.
+	Verified bool
+	// FastRetrieval indicates the deal has fast retrieval.
+	FastRetrieval bool
+	// Duration is the deal duration in epochs.
+	Duration int64
+}
+
+// Evaluate returns a non-nil error describing why the terms don't
+// satisfy the policy.
+func (ap AcceptancePolicy) Evaluate(t DealTerms) error {
+	if ap.MaxPrice > 0 && t.Price > ap.MaxPrice {
+		return fmt.Errorf("price %d is above the maximum %d", t.Price, ap.MaxPrice)
+	}
+	if ap.RequireVerified && !t.Verified {
+		return fmt.Errorf("deal isn't verified")
+	}
+	if ap.RequireFastRetrieval && !t.FastRetrieval {
+		return fmt.Errorf("deal doesn't have fast retrieval")
+	}
+	if ap.MinDuration > 0 && t.Duration < ap.MinDuration {
+		return fmt.Errorf("duration %d is below the minimum %d", t.Duration, ap.MinDuration)
+	}
+	if ap.MaxMinPieceSize > 0 && t.MinPieceSize > ap.MaxMinPieceSize {
+		return fmt.Errorf("miner minimum piece size %d is above the maximum %d", t.MinPieceSize, ap.MaxMinPieceSize)
+	}
+	return t.FitsPieceSize()
+}
+
+// FitsPieceSize returns a non-nil error if the piece size doesn't fit
+// the bounds of the miner ask. Unknown bounds fit any size.
+func (t DealTerms) FitsPieceSize() error {
+	if t.MaxPieceSize > 0 && (t.PieceSize < t.MinPieceSize || t.PieceSize > t.MaxPieceSize) {
+		return fmt.Errorf("piece size %d doesn't fit bounds (%d, %d)", t.PieceSize, t.MinPieceSize, t.MaxPieceSize)
+	}
+	return nil
+}
+
+// Accepts evaluates the acceptance policy of the filter against the
+// terms of a deal with a miner asking the provided price and piece
+// size bounds. Zero bounds mean they're unknown.
+func (f MinerSelectorFilter) Accepts(price, minPieceSize, maxPieceSize uint64) error {
+	return f.Policy.Evaluate(DealTerms{
+		Price:         price,
+		MinPieceSize:  minPieceSize,
+		MaxPieceSize:  maxPieceSize,
+		PieceSize:     f.PieceSize,
+		Verified:      f.VerifiedDeal,
+		FastRetrieval: f.FastRetrieval,
+		Duration:      f.DealDuration,
+	})
+}
+
+// DealSettings are the deal acceptance settings of a miner.
+type DealSettings struct {
+	// Accepting indicates the miner has a current ask, so it's
+	// accepting new deals.
+	Accepting bool
+	// Price and VerifiedPrice are the asked epoch prices in attoFil
+	// per GiB for regular and verified deals.
+	Price         uint64
+	VerifiedPrice uint64
+	// MinPieceSize and MaxPieceSize are the accepted piece size bounds.
+	MinPieceSize uint64
+	MaxPieceSize uint64
+	// MinDuration and MaxDuration are the accepted deal duration
+	// bounds in epochs. Zero values mean they're unknown.
+	MinDuration int64
+	MaxDuration int64
+}
+
+// EpochPrice returns the epoch price asked for regular or verified
+// deals.
+func (s DealSettings) EpochPrice(verified bool) uint64 {
+	if verified {
+		return s.VerifiedPrice
+	}
+	return s.Price
+}
+
+// AcceptsSettings evaluates the filter against the deal acceptance
+// settings of a miner, including its acceptance policy.
+func (f MinerSelectorFilter) AcceptsSettings(s DealSettings) error {
+	if !s.Accepting {
+		return fmt.Errorf("miner isn't accepting deals")
+	}
+	if f.DealDuration > 0 {
+		if s.MinDuration > 0 && f.DealDuration < s.MinDuration {
+			return fmt.Errorf("duration %d is below the miner minimum %d", f.DealDuration, s.MinDuration)
+		}
+		if s.MaxDuration > 0 && f.DealDuration > s.MaxDuration {
+			return fmt.Errorf("duration %d is above the miner maximum %d", f.DealDuration, s.MaxDuration)
+		}
+	}
+	price := s.EpochPrice(f.VerifiedDeal)
+	if f.MaxPrice > 0 && price > f.MaxPrice {
+		return fmt.Errorf("price %d is above the max-price %d", price, f.MaxPrice)
+	}
+	return f.Accepts(price, s.MinPieceSize, s.MaxPieceSize)
+}
+
+// AskUnavailablePolicy is the behavior of miner selectors for miners
+// whose ask isn't available, neither in the ask index nor querying
+// the miner.
+type AskUnavailablePolicy string
+
+const (
+	// AskUnavailableSkip doesn't select the miner. It's the default.
+	AskUnavailableSkip AskUnavailablePolicy = "skip"
+	// AskUnavailableFree selects the miner with a zero epoch price. It's
+	// dangerous, since the miner may ask for a higher price than the
+	// accepted by the storage config.
+	AskUnavailableFree AskUnavailablePolicy = "free"
+	// AskUnavailableFail fails the whole miner selection.
+	AskUnavailableFail AskUnavailablePolicy = "fail"
+)
+
+// ErrAskUnavailable is returned by miner selectors failing a selection
+// due to the AskUnavailableFail policy.
+var ErrAskUnavailable = errors.New("miner ask is unavailable")
+
+// ParseAskUnavailablePolicy returns the AskUnavailablePolicy named by
+// s. An empty string is interpreted as AskUnavailableSkip.
+func ParseAskUnavailablePolicy(s string) (AskUnavailablePolicy, error) {
+	switch AskUnavailablePolicy(s) {
+	case "", AskUnavailableSkip:
+		return AskUnavailableSkip, nil
+	case AskUnavailableFree, AskUnavailableFail:
+		return AskUnavailablePolicy(s), nil
+	default:
+		return "", fmt.Errorf("unknown ask unavailable policy %q", s)
+	}
+}
+
+// Unavailable applies the policy to a miner whose ask isn't available
+// due to err. It returns the settings to consider for the miner, or an
+// error if the miner shouldn't be selected. The error wraps
+// ErrAskUnavailable if the whole selection should fail.
+func (p AskUnavailablePolicy) Unavailable(miner string, err error) (DealSettings, error) {
+	switch p {
+	case AskUnavailableFree:
+		return DealSettings{Accepting: true}, nil
+	case AskUnavailableFail:
+		return DealSettings{}, fmt.Errorf("%w: %s: %s", ErrAskUnavailable, miner, err)
+	default:
+		return DealSettings{}, fmt.Errorf("skipping miner %s with unavailable ask: %s", miner, err)
+	}
+}
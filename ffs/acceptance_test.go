@@ -0,0 +1,75 @@
+package ffs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptancePolicyEvaluate(t *testing.T) {
+	t.Parallel()
+
+	policy := AcceptancePolicy{
+		MaxPrice:             100,
+		RequireVerified:      true,
+		RequireFastRetrieval: true,
+		MinDuration:          1000,
+		MaxMinPieceSize:      1024,
+	}
+	valid := DealTerms{
+		Price:         100,
+		MinPieceSize:  256,
+		MaxPieceSize:  4096,
+		PieceSize:     2048,
+		Verified:      true,
+		FastRetrieval: true,
+		Duration:      1000,
+	}
+	require.NoError(t, policy.Evaluate(valid))
+	require.NoError(t, AcceptancePolicy{}.Evaluate(DealTerms{}))
+
+	tests := []struct {
+		name   string
+		mutate func(t *DealTerms)
+	}{
+		{"expensive", func(t *DealTerms) { t.Price = 101 }},
+		{"unverified", func(t *DealTerms) { t.Verified = false }},
+		{"no fast retrieval", func(t *DealTerms) { t.FastRetrieval = false }},
+		{"short duration", func(t *DealTerms) { t.Duration = 999 }},
+		{"big minimum piece size", func(t *DealTerms) { t.MinPieceSize = 2048 }},
+		{"piece too big", func(t *DealTerms) { t.PieceSize = 8192 }},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			terms := valid
+			tt.mutate(&terms)
+			require.Error(t, policy.Evaluate(terms))
+		})
+	}
+}
+
+func TestAskUnavailablePolicy(t *testing.T) {
+	t.Parallel()
+
+	p, err := ParseAskUnavailablePolicy("")
+	require.NoError(t, err)
+	require.Equal(t, AskUnavailableSkip, p)
+	_, err = ParseAskUnavailablePolicy("ignore")
+	require.Error(t, err)
+
+	askErr := errors.New("query ask timed out")
+	_, err = AskUnavailableSkip.Unavailable("f01", askErr)
+	require.Error(t, err)
+	require.False(t, errors.Is(err, ErrAskUnavailable))
+
+	s, err := AskUnavailableFree.Unavailable("f01", askErr)
+	require.NoError(t, err)
+	require.True(t, s.Accepting)
+	require.Zero(t, s.EpochPrice(false))
+
+	_, err = AskUnavailableFail.Unavailable("f01", askErr)
+	require.True(t, errors.Is(err, ErrAskUnavailable))
+}
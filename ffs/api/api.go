@@ -56,7 +56,7 @@ func New(ds datastore.Datastore, iid ffs.APIID, sch *scheduler.Scheduler, wm ffs
 	dc.Cold.Filecoin.Addr = addrInfo.Addr
 
 	if err := dc.Validate(); err != nil {
-		return nil, fmt.Errorf("default storage config is invalid: %s", err)
+		return nil, fmt.Errorf("default storage config is invalid: %w", err)
 	}
 
 	config := InstanceConfig{
@@ -112,10 +112,29 @@ func (i *API) SetDefaultStorageConfig(c ffs.StorageConfig) error {
 	i.lock.Lock()
 	defer i.lock.Unlock()
 	if err := c.Validate(); err != nil {
-		return fmt.Errorf("default cid config is invalid: %s", err)
+		return fmt.Errorf("default cid config is invalid: %w", err)
 	}
+	before := i.cfg.DefaultStorageConfig
 	i.cfg.DefaultStorageConfig = c
-	return i.is.putInstanceConfig(i.cfg)
+	if err := i.is.putInstanceConfig(i.cfg); err != nil {
+		return err
+	}
+	if err := i.is.putConfigAuditEntry(cid.Undef, &before, &c); err != nil {
+		return fmt.Errorf("saving config audit entry: %s", err)
+	}
+	return nil
+}
+
+// ConfigAuditLog returns the history of StorageConfig changes in the
+// instance in chronological order. If cids are provided, only changes of
+// those Cids are returned; cid.Undef selects changes of the default
+// StorageConfig.
+func (i *API) ConfigAuditLog(cids ...cid.Cid) ([]ConfigAuditEntry, error) {
+	es, err := i.is.getConfigAuditEntries(cids...)
+	if err != nil {
+		return nil, fmt.Errorf("getting config audit entries: %s", err)
+	}
+	return es, nil
 }
 
 // GetStorageConfigs returns the current StorageConfigs for a FFS instance, filtered by cids, if provided.
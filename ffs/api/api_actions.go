@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler"
 )
 
 // PushStorageConfig push a new configuration for the Cid in the hot and
@@ -21,6 +23,18 @@ func (i *API) PushStorageConfig(c cid.Cid, opts ...PushStorageConfigOption) (ffs
 			return ffs.EmptyJobID, fmt.Errorf("config option: %s", err)
 		}
 	}
+	if cfg.costCenter != "" {
+		cfg.config.Cold.Filecoin.CostCenter = cfg.costCenter
+	}
+	if cfg.priorCid.Defined() && cfg.priorCid.Equals(c) {
+		jid, err := i.latestStorageJob(c)
+		if err != nil {
+			return ffs.EmptyJobID, fmt.Errorf("getting latest job for unchanged cid: %s", err)
+		}
+		if jid != ffs.EmptyJobID {
+			return jid, nil
+		}
+	}
 	if !cfg.overrideConfig {
 		_, err := i.is.getStorageConfigs(c)
 		if err == nil {
@@ -30,10 +44,7 @@ func (i *API) PushStorageConfig(c cid.Cid, opts ...PushStorageConfigOption) (ffs
 			return ffs.EmptyJobID, fmt.Errorf("getting cid config: %s", err)
 		}
 	}
-	if err := cfg.config.Validate(); err != nil {
-		return ffs.EmptyJobID, err
-	}
-	if err := i.ensureValidColdCfg(cfg.config.Cold); err != nil {
+	if err := i.validatePushedConfig(c, &cfg); err != nil {
 		return ffs.EmptyJobID, err
 	}
 
@@ -46,7 +57,7 @@ func (i *API) PushStorageConfig(c cid.Cid, opts ...PushStorageConfigOption) (ffs
 	var jid ffs.JobID
 	var err error
 	if !cfg.noExec {
-		jid, err = i.sched.PushConfig(i.cfg.ID, c, cfg.config)
+		jid, err = i.sched.PushConfigWithPriority(i.cfg.ID, c, cfg.config, cfg.priority)
 		if err != nil {
 			return ffs.EmptyJobID, fmt.Errorf("scheduling cid %s: %s", c, err)
 		}
@@ -118,6 +129,29 @@ func (i *API) Replace(c1 cid.Cid, c2 cid.Cid) (ffs.JobID, error) {
 	return jid, nil
 }
 
+// Rehydrate re-establishes the hot copy of a Cid whose Hot Storage was
+// disabled. The data is fetched from the IPFS network, falling back to
+// a Filecoin retrieval if it isn't available. The StorageConfig of the
+// Cid is updated to keep Hot Storage enabled.
+func (i *API) Rehydrate(c cid.Cid) (ffs.JobID, error) {
+	i.lock.Lock()
+	cfgs, err := i.is.getStorageConfigs(c)
+	i.lock.Unlock()
+	if err == ErrNotFound {
+		return ffs.EmptyJobID, err
+	}
+	if err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("getting cid config: %s", err)
+	}
+
+	cfg := cfgs[c].WithHotEnabled(true).WithHotAllowUnfreeze(true)
+	jid, err := i.PushStorageConfig(c, WithStorageConfig(cfg), WithOverride(true))
+	if err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("pushing rehydrated config: %s", err)
+	}
+	return jid, nil
+}
+
 // Get returns an io.Reader for reading a stored Cid from hot storage.
 func (i *API) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
 	if !c.Defined() {
@@ -137,6 +171,124 @@ func (i *API) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
 	return r, nil
 }
 
+// PushStorageConfigAt schedules a new configuration for the Cid to be pushed
+// at time at. The configuration is validated now, but it's only saved for the
+// Cid when at is reached and the Job executing it is created. The same options
+// as PushStorageConfig apply, except WithNoExec and WithDealImport.
+func (i *API) PushStorageConfigAt(c cid.Cid, at time.Time, opts ...PushStorageConfigOption) (ffs.ScheduledPushID, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	cfg := pushStorageConfigConfig{config: i.cfg.DefaultStorageConfig}
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return "", fmt.Errorf("config option: %s", err)
+		}
+	}
+	if cfg.noExec || len(cfg.dealIDs) > 0 {
+		return "", fmt.Errorf("no-exec and deal import options aren't supported on scheduled pushes")
+	}
+	if cfg.costCenter != "" {
+		cfg.config.Cold.Filecoin.CostCenter = cfg.costCenter
+	}
+	if !cfg.overrideConfig {
+		_, err := i.is.getStorageConfigs(c)
+		if err == nil {
+			return "", ErrMustOverrideConfig
+		}
+		if err != ErrNotFound {
+			return "", fmt.Errorf("getting cid config: %s", err)
+		}
+	}
+	if err := i.validatePushedConfig(c, &cfg); err != nil {
+		return "", err
+	}
+
+	sp := ffs.ScheduledPush{
+		APIID:     i.cfg.ID,
+		Cid:       c,
+		Config:    cfg.config,
+		Override:  cfg.overrideConfig,
+		Priority:  cfg.priority,
+		ExecuteAt: at,
+	}
+	spid, err := i.sched.SchedulePush(sp)
+	if err != nil {
+		return "", fmt.Errorf("scheduling push for cid %s: %s", c, err)
+	}
+	return spid, nil
+}
+
+// ExecuteScheduledPush saves the configuration of a due scheduled push for its
+// Cid, and pushes it as a new Job. If the configuration can't be saved, the Job
+// is canceled.
+func (i *API) ExecuteScheduledPush(sp ffs.ScheduledPush) (ffs.JobID, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if sp.APIID != i.cfg.ID {
+		return ffs.EmptyJobID, fmt.Errorf("scheduled push belongs to instance %s", sp.APIID)
+	}
+	if !sp.Override {
+		_, err := i.is.getStorageConfigs(sp.Cid)
+		if err == nil {
+			return ffs.EmptyJobID, ErrMustOverrideConfig
+		}
+		if err != ErrNotFound {
+			return ffs.EmptyJobID, fmt.Errorf("getting cid config: %s", err)
+		}
+	}
+
+	jid, err := i.sched.PushConfigWithPriority(i.cfg.ID, sp.Cid, sp.Config, sp.Priority)
+	if err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("scheduling cid %s: %s", sp.Cid, err)
+	}
+	if err := i.is.putStorageConfig(sp.Cid, sp.Config); err != nil {
+		if err := i.sched.Cancel(jid); err != nil {
+			log.Errorf("canceling job %s of unsaved config: %s", jid, err)
+		}
+		return ffs.EmptyJobID, fmt.Errorf("saving new config for cid %s: %s", sp.Cid, err)
+	}
+	return jid, nil
+}
+
+// ListScheduledPushes returns the pending scheduled pushes of the instance
+// sorted by execution time.
+func (i *API) ListScheduledPushes() ([]ffs.ScheduledPush, error) {
+	return i.sched.ListScheduledPushes(i.cfg.ID)
+}
+
+// CancelScheduledPush cancels a pending scheduled push. If it doesn't exist
+// or was already executed, it returns ErrNotFound.
+func (i *API) CancelScheduledPush(id ffs.ScheduledPushID) error {
+	err := i.sched.CancelScheduledPush(i.cfg.ID, id)
+	if err == scheduler.ErrNotFound {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("canceling scheduled push %s: %s", id, err)
+	}
+	return nil
+}
+
+// PauseRenewals pauses or resumes automatic deal renewals of the
+// instance. It doesn't affect repairs.
+func (i *API) PauseRenewals(paused bool) error {
+	return i.sched.PauseRenewals(i.cfg.ID, paused)
+}
+
+// PauseRepairs pauses or resumes automatic repairs of the instance.
+// It doesn't affect renewals.
+func (i *API) PauseRepairs(paused bool) error {
+	return i.sched.PauseRepairs(i.cfg.ID, paused)
+}
+
+// MaintenancePause returns which background maintenance tasks are
+// paused for the instance.
+func (i *API) MaintenancePause() (ffs.MaintenancePause, error) {
+	return i.sched.MaintenancePause(i.cfg.ID)
+}
+
 // CancelJob cancels an executing Job. If no Job is executing
 // with that JobID, it won't fail.
 func (i *API) CancelJob(jid ffs.JobID) error {
@@ -146,6 +298,60 @@ func (i *API) CancelJob(jid ffs.JobID) error {
 	return nil
 }
 
+// latestStorageJob returns the most recent Job for c in the instance, or
+// EmptyJobID if there's none.
+func (i *API) latestStorageJob(c cid.Cid) (ffs.JobID, error) {
+	jobs, _, _, err := i.sched.ListStorageJobs(scheduler.ListStorageJobsConfig{
+		APIIDFilter: i.cfg.ID,
+		CidFilter:   c,
+		Limit:       1,
+	})
+	if err != nil {
+		return ffs.EmptyJobID, err
+	}
+	if len(jobs) == 0 {
+		return ffs.EmptyJobID, nil
+	}
+	return jobs[0].ID, nil
+}
+
+// validatePushedConfig validates the config pushed for a Cid. Invalid
+// configs fail with ffs.FieldErrors, unless pushed in lenient mode where
+// invalid fields are reverted to their current value.
+func (i *API) validatePushedConfig(c cid.Cid, cfg *pushStorageConfigConfig) error {
+	if !cfg.lenient {
+		if err := cfg.config.Validate(); err != nil {
+			return err
+		}
+		return i.ensureValidColdCfg(cfg.config.Cold)
+	}
+
+	base := i.cfg.DefaultStorageConfig
+	cfgs, err := i.is.getStorageConfigs(c)
+	if err == nil {
+		base = cfgs[c]
+	} else if err != ErrNotFound {
+		return fmt.Errorf("getting cid config: %s", err)
+	}
+	var rejected ffs.FieldErrors
+	if err := i.ensureValidColdCfg(cfg.config.Cold); err != nil {
+		rejected = append(rejected, ffs.FieldError{Field: "Cold.Filecoin.Addr", Reason: err.Error()})
+		cfg.config.Cold.Filecoin.Addr = base.Cold.Filecoin.Addr
+	}
+	sc, errs, err := cfg.config.ApplyValid(base)
+	if err != nil {
+		return err
+	}
+	if err := i.ensureValidColdCfg(sc.Cold); err != nil {
+		return err
+	}
+	cfg.config = sc
+	if cfg.rejected != nil {
+		*cfg.rejected = append(rejected, errs...)
+	}
+	return nil
+}
+
 func (i *API) ensureValidColdCfg(cfg ffs.ColdConfig) error {
 	if cfg.Enabled && !i.isManagedAddress(cfg.Filecoin.Addr) {
 		return fmt.Errorf("%v is not managed by ffs instance", cfg.Filecoin.Addr)
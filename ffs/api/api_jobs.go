@@ -121,3 +121,14 @@ func (i *API) StorageConfigForJob(jid ffs.JobID) (ffs.StorageConfig, error) {
 	}
 	return sc, nil
 }
+
+// EstimateStorageTime returns a rough estimation of the time until data
+// of the provided size, pushed now with cfg, is stored in Filecoin. It
+// considers the queued jobs of every instance.
+func (i *API) EstimateStorageTime(cfg ffs.StorageConfig, size uint64) (scheduler.Estimate, error) {
+	e, err := i.sched.EstimateStorageTime(cfg, size)
+	if err != nil {
+		return scheduler.Estimate{}, fmt.Errorf("estimating storage time: %s", err)
+	}
+	return e, nil
+}
@@ -52,3 +52,48 @@ func (i *API) WatchLogs(ctx context.Context, ch chan<- ffs.LogEntry, c cid.Cid,
 
 	return nil
 }
+
+// StreamLogs sends the history logs of a Cid to ch in pages of at most
+// pageSize entries, in chronological order. Logs are read from storage
+// page by page, so the full history is never held in memory. The method
+// blocks until all pages are sent or the context is canceled.
+func (i *API) StreamLogs(ctx context.Context, ch chan<- []ffs.LogEntry, c cid.Cid, pageSize int, opts ...GetLogsOption) error {
+	if pageSize <= 0 {
+		return fmt.Errorf("page size should be greater than zero")
+	}
+	_, err := i.is.getStorageConfigs(c)
+	if err == ErrNotFound {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("validating cid: %s", err)
+	}
+
+	config := &GetLogsConfig{}
+	for _, o := range opts {
+		o(config)
+	}
+
+	for offset := 0; ; offset += pageSize {
+		lgs, err := i.sched.GetLogsByCidPage(ctx, i.cfg.ID, c, offset, pageSize)
+		if err != nil {
+			return fmt.Errorf("getting history logs page of %s: %s", c, err)
+		}
+		page := make([]ffs.LogEntry, 0, len(lgs))
+		for _, l := range lgs {
+			if config.jid == ffs.EmptyJobID || config.jid == l.Jid {
+				page = append(page, l)
+			}
+		}
+		if len(page) > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case ch <- page:
+			}
+		}
+		if len(lgs) < pageSize {
+			return nil
+		}
+	}
+}
@@ -25,3 +25,9 @@ func (i *API) StorageInfo(cid cid.Cid) (ffs.StorageInfo, error) {
 func (i *API) ListStorageInfo(cids ...cid.Cid) ([]ffs.StorageInfo, error) {
 	return i.sched.ListStorageInfo([]ffs.APIID{i.cfg.ID}, cids)
 }
+
+// BillingReport returns the spend of the active deals of the instance
+// aggregated by cost center.
+func (i *API) BillingReport() ([]ffs.CostCenterSpend, error) {
+	return i.sched.BillingReport([]ffs.APIID{i.cfg.ID})
+}
@@ -17,6 +17,7 @@ var (
 	dsInstanceConfig       = datastore.NewKey("instanceconfig")
 	dsBaseCidStorageConfig = datastore.NewKey("cidstorageconfig")
 	dsBaseRetrievalRequest = datastore.NewKey("retrievalrequest")
+	dsBaseConfigAudit      = datastore.NewKey("configaudit")
 )
 
 type instanceStore struct {
@@ -64,6 +65,10 @@ func (s *instanceStore) putStorageConfig(c cid.Cid, sc ffs.StorageConfig) error
 	if !c.Defined() {
 		return fmt.Errorf("cid can't be undefined")
 	}
+	before, err := s.getStorageConfig(c)
+	if err != nil {
+		return fmt.Errorf("getting current cid config: %s", err)
+	}
 	buf, err := json.Marshal(sc)
 	if err != nil {
 		return fmt.Errorf("marshaling cid config to datastore: %s", err)
@@ -71,6 +76,9 @@ func (s *instanceStore) putStorageConfig(c cid.Cid, sc ffs.StorageConfig) error
 	if err := s.ds.Put(makeStorageConfigKey(c), buf); err != nil {
 		return fmt.Errorf("saving cid config to datastore: %s", err)
 	}
+	if err := s.putConfigAuditEntry(c, before, &sc); err != nil {
+		return fmt.Errorf("saving config audit entry: %s", err)
+	}
 	return nil
 }
 
@@ -78,12 +86,97 @@ func (s *instanceStore) removeStorageConfig(c cid.Cid) error {
 	if !c.Defined() {
 		return fmt.Errorf("cid can't be undefined")
 	}
+	before, err := s.getStorageConfig(c)
+	if err != nil {
+		return fmt.Errorf("getting current cid config: %s", err)
+	}
 	if err := s.ds.Delete(makeStorageConfigKey(c)); err != nil {
 		return fmt.Errorf("removing from datastore: %s", err)
 	}
+	if err := s.putConfigAuditEntry(c, before, nil); err != nil {
+		return fmt.Errorf("saving config audit entry: %s", err)
+	}
 	return nil
 }
 
+// getStorageConfig returns the StorageConfig of a Cid, or nil if
+// it doesn't exist.
+func (s *instanceStore) getStorageConfig(c cid.Cid) (*ffs.StorageConfig, error) {
+	buf, err := s.ds.Get(makeStorageConfigKey(c))
+	if err == datastore.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var sc ffs.StorageConfig
+	if err := json.Unmarshal(buf, &sc); err != nil {
+		return nil, fmt.Errorf("unmarshaling cid config from datastore: %s", err)
+	}
+	return &sc, nil
+}
+
+// putConfigAuditEntry records a StorageConfig change of a Cid. An undefined
+// Cid indicates a change in the default StorageConfig.
+func (s *instanceStore) putConfigAuditEntry(c cid.Cid, before, after *ffs.StorageConfig) error {
+	e := ConfigAuditEntry{
+		Cid:       c,
+		Before:    before,
+		After:     after,
+		Timestamp: time.Now(),
+	}
+	buf, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshaling config audit entry: %s", err)
+	}
+	// Keys are prefixed with a fixed-width timestamp so they're
+	// sorted chronologically.
+	k := dsBaseConfigAudit.ChildString(fmt.Sprintf("%020d-%s", e.Timestamp.UnixNano(), util.CidToString(c)))
+	if err := s.ds.Put(k, buf); err != nil {
+		return fmt.Errorf("saving config audit entry to datastore: %s", err)
+	}
+	return nil
+}
+
+// getConfigAuditEntries returns config audit entries in chronological
+// order. If cids are provided, only entries of those Cids are returned.
+func (s *instanceStore) getConfigAuditEntries(cids ...cid.Cid) ([]ConfigAuditEntry, error) {
+	filter := make(map[cid.Cid]struct{}, len(cids))
+	for _, c := range cids {
+		filter[c] = struct{}{}
+	}
+	q := query.Query{
+		Prefix: dsBaseConfigAudit.String(),
+		Orders: []query.Order{query.OrderByKey{}},
+	}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("querying config audit entries: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	var ret []ConfigAuditEntry
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		var e ConfigAuditEntry
+		if err := json.Unmarshal(r.Value, &e); err != nil {
+			return nil, fmt.Errorf("unmarshaling config audit entry: %s", err)
+		}
+		if len(filter) > 0 {
+			if _, ok := filter[e.Cid]; !ok {
+				continue
+			}
+		}
+		ret = append(ret, e)
+	}
+	return ret, nil
+}
+
 func (s *instanceStore) getStorageConfigs(cids ...cid.Cid) (map[cid.Cid]ffs.StorageConfig, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+func TestConfigAuditTrail(t *testing.T) {
+	t.Parallel()
+
+	is := newInstanceStore(datastore.NewMapDatastore())
+	c1, err := cid.Decode("QmSqL792vF4fGjStbYHRgazsEahAQKZmx68jrnvFi9hXMp")
+	require.NoError(t, err)
+	c2, err := cid.Decode("QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG")
+	require.NoError(t, err)
+
+	sc1 := ffs.StorageConfig{}.WithHotEnabled(true)
+	sc2 := sc1.WithColdEnabled(true)
+
+	require.NoError(t, is.putStorageConfig(c1, sc1))
+	require.NoError(t, is.putStorageConfig(c1, sc2))
+	require.NoError(t, is.putStorageConfig(c2, sc1))
+	require.NoError(t, is.removeStorageConfig(c1))
+	require.NoError(t, is.putConfigAuditEntry(cid.Undef, &sc1, &sc2))
+
+	all, err := is.getConfigAuditEntries()
+	require.NoError(t, err)
+	require.Len(t, all, 5)
+	for i := 1; i < len(all); i++ {
+		require.False(t, all[i].Timestamp.Before(all[i-1].Timestamp))
+	}
+
+	es, err := is.getConfigAuditEntries(c1)
+	require.NoError(t, err)
+	require.Len(t, es, 3)
+	require.Nil(t, es[0].Before)
+	require.Equal(t, sc1, *es[0].After)
+	require.Equal(t, sc1, *es[1].Before)
+	require.Equal(t, sc2, *es[1].After)
+	require.Equal(t, sc2, *es[2].Before)
+	require.Nil(t, es[2].After)
+
+	es, err = is.getConfigAuditEntries(cid.Undef)
+	require.NoError(t, err)
+	require.Len(t, es, 1)
+	require.Equal(t, sc1, *es[0].Before)
+	require.Equal(t, sc2, *es[0].After)
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"fmt"
 
+	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/v2/ffs"
 )
 
@@ -14,6 +15,11 @@ type pushStorageConfigConfig struct {
 	overrideConfig bool
 	dealIDs        []uint64
 	noExec         bool
+	priorCid       cid.Cid
+	priority       int
+	lenient        bool
+	rejected       *ffs.FieldErrors
+	costCenter     string
 }
 
 // WithStorageConfig overrides the Api default Cid configuration.
@@ -50,6 +56,47 @@ func WithNoExec(noExec bool) PushStorageConfigOption {
 	}
 }
 
+// WithPriorCid makes the push conditional on the data having changed. If the
+// pushed Cid is equal to prior, no new Job is created and the latest Job
+// for the Cid is returned.
+func WithPriorCid(prior cid.Cid) PushStorageConfigOption {
+	return func(o *pushStorageConfigConfig) error {
+		o.priorCid = prior
+		return nil
+	}
+}
+
+// WithPriority sets the priority of the created Job. Queued Jobs with
+// higher priority are executed first. The default priority is 0.
+func WithPriority(priority int) PushStorageConfigOption {
+	return func(o *pushStorageConfigConfig) error {
+		o.priority = priority
+		return nil
+	}
+}
+
+// WithLenient applies only the valid fields of the pushed config. Invalid
+// fields keep the current value of the Cid config, or the default config
+// value if the Cid isn't tracked. The errors of the rejected fields are
+// saved in rejected, which can be nil.
+func WithLenient(rejected *ffs.FieldErrors) PushStorageConfigOption {
+	return func(o *pushStorageConfigConfig) error {
+		o.lenient = true
+		o.rejected = rejected
+		return nil
+	}
+}
+
+// WithCostCenter labels the deals made for the pushed config with a
+// cost center, so their spend is attributed to it in billing reports.
+// It takes precedence over the cost center of the pushed config.
+func WithCostCenter(costCenter string) PushStorageConfigOption {
+	return func(o *pushStorageConfigConfig) error {
+		o.costCenter = costCenter
+		return nil
+	}
+}
+
 // Validate validates a PushStorageConfigConfig.
 func (pc pushStorageConfigConfig) Validate() error {
 	if err := pc.config.Validate(); err != nil {
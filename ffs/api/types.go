@@ -2,7 +2,9 @@ package api
 
 import (
 	"errors"
+	"time"
 
+	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/v2/ffs"
 )
 
@@ -37,3 +39,18 @@ type GetLogsConfig struct {
 	jid     ffs.JobID
 	history bool
 }
+
+// ConfigAuditEntry records a change of a StorageConfig in the instance.
+type ConfigAuditEntry struct {
+	// Cid is the data Cid whose StorageConfig changed. It's cid.Undef
+	// if the change was in the default StorageConfig.
+	Cid cid.Cid
+	// Before is the StorageConfig prior to the change, or nil if
+	// there wasn't one.
+	Before *ffs.StorageConfig
+	// After is the StorageConfig after the change, or nil if it
+	// was removed.
+	After *ffs.StorageConfig
+	// Timestamp is when the change happened.
+	Timestamp time.Time
+}
@@ -0,0 +1,55 @@
+package ffs
+
+import (
+	"sort"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+)
+
+// CostCenterSpend is the aggregated spend of the deals of a cost center.
+type CostCenterSpend struct {
+	// CostCenter is the cost center of the deals. It's empty for deals
+	// made without one.
+	CostCenter string
+	// Deals is the number of deals.
+	Deals int
+	// Spend is the total cost of the deals in attoFIL.
+	Spend big.Int
+}
+
+// DealCost returns the total cost in attoFIL of a deal lasting duration
+// epochs, with a price in attoFIL per epoch for the whole deal.
+func DealCost(epochPrice uint64, duration int64) big.Int {
+	return big.Mul(big.NewIntUnsigned(epochPrice), big.NewInt(duration))
+}
+
+// AskDealCost returns the total cost in attoFIL of a deal storing pieceSize
+// bytes for duration epochs, with an ask price in attoFIL per GiB per epoch.
+func AskDealCost(askPrice, pieceSize uint64, duration int64) big.Int {
+	perEpoch := big.Div(big.Mul(big.NewIntUnsigned(askPrice), big.NewIntUnsigned(pieceSize)), abi.NewTokenAmount(1<<30))
+	return big.Mul(perEpoch, big.NewInt(duration))
+}
+
+// BillingReport aggregates the spend of the Filecoin deals of the provided
+// storage infos by cost center, sorted by cost center.
+func BillingReport(infos []StorageInfo) []CostCenterSpend {
+	spends := make(map[string]*CostCenterSpend)
+	for _, si := range infos {
+		for _, p := range si.Cold.Filecoin.Proposals {
+			s, ok := spends[p.CostCenter]
+			if !ok {
+				s = &CostCenterSpend{CostCenter: p.CostCenter, Spend: big.Zero()}
+				spends[p.CostCenter] = s
+			}
+			s.Deals++
+			s.Spend = big.Add(s.Spend, DealCost(p.EpochPrice, p.Duration))
+		}
+	}
+	res := make([]CostCenterSpend, 0, len(spends))
+	for _, s := range spends {
+		res = append(res, *s)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].CostCenter < res[j].CostCenter })
+	return res
+}
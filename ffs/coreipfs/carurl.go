@@ -0,0 +1,227 @@
+package coreipfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-car"
+	"github.com/ipfs/go-cid"
+	format "github.com/ipfs/go-ipld-format"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/powergate/v2/ffs"
+
+	// Registers the block decoders for supported IPLD codecs.
+	_ "github.com/ipfs/go-merkledag"
+)
+
+const (
+	// carURLMaxRetries is the maximum number of times a CAR download is
+	// retried, so a server which keeps failing mid-stream doesn't make it
+	// retry forever.
+	carURLMaxRetries = 10
+	// carURLRetryDelay is the delay between attempts to resume a CAR
+	// download.
+	carURLRetryDelay = time.Second * 2
+	// stageCarBatchSize is the number of nodes added to IPFS at once
+	// while staging a CAR file.
+	stageCarBatchSize = 64
+)
+
+// StageFromCarURL streams the CAR file at url into IPFS, and stage-pins its
+// root. The CAR file should have a single root. If the download fails with
+// a transient error, it's resumed from the last read byte, using range
+// requests if the server supports them.
+func (ci *CoreIpfs) StageFromCarURL(ctx context.Context, iid ffs.APIID, url string) (cid.Cid, error) {
+	r := newResumableReader(ctx, http.DefaultClient, url, carURLMaxRetries, carURLRetryDelay)
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("closing car download: %s", err)
+		}
+	}()
+
+	cr, err := car.NewCarReader(r)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("reading car header: %s", err)
+	}
+	if len(cr.Header.Roots) != 1 {
+		return cid.Undef, fmt.Errorf("car file should have one root, got %d", len(cr.Header.Roots))
+	}
+	root := cr.Header.Roots[0]
+
+	batch := make([]format.Node, 0, stageCarBatchSize)
+	for {
+		b, err := cr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return cid.Undef, fmt.Errorf("reading car block: %s", err)
+		}
+		nd, err := format.Decode(b)
+		if err != nil {
+			return cid.Undef, fmt.Errorf("decoding block %s: %s", b.Cid(), err)
+		}
+		batch = append(batch, nd)
+		if len(batch) == stageCarBatchSize {
+			if err := ci.ipfs.Dag().AddMany(ctx, batch); err != nil {
+				return cid.Undef, fmt.Errorf("adding blocks to ipfs: %s", err)
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := ci.ipfs.Dag().AddMany(ctx, batch); err != nil {
+		return cid.Undef, fmt.Errorf("adding blocks to ipfs: %s", err)
+	}
+
+	if err := ci.ipfs.Pin().Add(ctx, path.IpfsPath(root), options.Pin.Recursive(true)); err != nil {
+		return cid.Undef, fmt.Errorf("pinning car root %s: %s", root, err)
+	}
+	ci.lock.Lock()
+	defer ci.lock.Unlock()
+
+	if err := ci.ps.AddStaged(iid, root); err != nil {
+		return cid.Undef, fmt.Errorf("saving new pin in pinstore: %s", err)
+	}
+
+	return root, nil
+}
+
+// errPermanent wraps errors of a download which shouldn't be retried.
+type errPermanent struct {
+	err error
+}
+
+func (e errPermanent) Error() string {
+	return e.err.Error()
+}
+
+// resumableReader reads the body of an HTTP resource, resuming the
+// download from the last read byte if it fails with a transient error,
+// up to maxRetries times in total.
+type resumableReader struct {
+	ctx        context.Context
+	client     *http.Client
+	url        string
+	maxRetries int
+	retryDelay time.Duration
+
+	body    io.ReadCloser
+	offset  int64
+	retries int
+}
+
+func newResumableReader(ctx context.Context, client *http.Client, url string, maxRetries int, retryDelay time.Duration) *resumableReader {
+	return &resumableReader{
+		ctx:        ctx,
+		client:     client,
+		url:        url,
+		maxRetries: maxRetries,
+		retryDelay: retryDelay,
+	}
+}
+
+// Read implements io.Reader.
+func (rr *resumableReader) Read(p []byte) (int, error) {
+	for {
+		if rr.body == nil {
+			if err := rr.open(); err != nil {
+				if rerr := rr.retry(err); rerr != nil {
+					return 0, rerr
+				}
+				continue
+			}
+		}
+		n, err := rr.body.Read(p)
+		rr.offset += int64(n)
+		if err == nil || err == io.EOF {
+			return n, err
+		}
+		_ = rr.body.Close()
+		rr.body = nil
+		if n > 0 {
+			return n, nil
+		}
+		if rerr := rr.retry(err); rerr != nil {
+			return 0, rerr
+		}
+	}
+}
+
+// Close implements io.Closer.
+func (rr *resumableReader) Close() error {
+	if rr.body == nil {
+		return nil
+	}
+	err := rr.body.Close()
+	rr.body = nil
+	return err
+}
+
+// retry waits before the next attempt, or returns an error if the
+// download shouldn't be retried.
+func (rr *resumableReader) retry(err error) error {
+	var perr errPermanent
+	if errors.As(err, &perr) {
+		return perr.err
+	}
+	if rr.retries >= rr.maxRetries {
+		return fmt.Errorf("giving up after %d retries: %s", rr.retries, err)
+	}
+	rr.retries++
+	log.Warnf("downloading %s failed at offset %d, retrying: %s", rr.url, rr.offset, err)
+	select {
+	case <-rr.ctx.Done():
+		return rr.ctx.Err()
+	case <-time.After(rr.retryDelay):
+		return nil
+	}
+}
+
+func (rr *resumableReader) open() error {
+	req, err := http.NewRequestWithContext(rr.ctx, http.MethodGet, rr.url, nil)
+	if err != nil {
+		return errPermanent{fmt.Errorf("creating request: %s", err)}
+	}
+	if rr.offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", rr.offset))
+	}
+	res, err := rr.client.Do(req)
+	if err != nil {
+		if rr.ctx.Err() != nil {
+			return errPermanent{rr.ctx.Err()}
+		}
+		return fmt.Errorf("sending request: %s", err)
+	}
+
+	switch {
+	case res.StatusCode == http.StatusPartialContent && rr.offset > 0:
+		if !strings.HasPrefix(res.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", rr.offset)) {
+			_ = res.Body.Close()
+			return errPermanent{fmt.Errorf("unexpected content range %q", res.Header.Get("Content-Range"))}
+		}
+	case res.StatusCode == http.StatusOK:
+		// The server doesn't support range requests, so skip the
+		// already read bytes.
+		if rr.offset > 0 {
+			if _, err := io.CopyN(ioutil.Discard, res.Body, rr.offset); err != nil {
+				_ = res.Body.Close()
+				return fmt.Errorf("skipping %d read bytes: %s", rr.offset, err)
+			}
+		}
+	case res.StatusCode >= http.StatusInternalServerError || res.StatusCode == http.StatusTooManyRequests:
+		_ = res.Body.Close()
+		return fmt.Errorf("unexpected status %s", res.Status)
+	default:
+		_ = res.Body.Close()
+		return errPermanent{fmt.Errorf("unexpected status %s", res.Status)}
+	}
+	rr.body = res.Body
+	return nil
+}
@@ -0,0 +1,177 @@
+package coreipfs
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-car"
+	"github.com/ipfs/go-cid"
+	dag "github.com/ipfs/go-merkledag"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	it "github.com/textileio/powergate/v2/ffs/integrationtest"
+)
+
+func TestStageFromCarURL(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	ci, ipfs := newCoreIPFS(t)
+	root, data := newCar(t)
+	srv := newFlakyServer(t, data, true)
+	iid := ffs.NewAPIID()
+
+	c, err := ci.StageFromCarURL(ctx, iid, srv.URL)
+	require.NoError(t, err)
+	require.Equal(t, root, c)
+	require.Equal(t, []string{"", "bytes=" + strconv.Itoa(len(data)/2) + "-"}, srv.ranges())
+	it.RequireIpfsPinnedCid(ctx, t, c, ipfs)
+	requireCidIsGCable(t, ci, c)
+	requireRefCount(t, ci, c, 0, 1)
+}
+
+func TestResumableReader(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		supportRange bool
+		ranges       []string
+	}{
+		{name: "RangeRequests", supportRange: true, ranges: []string{"", "bytes=500-"}},
+		{name: "NoRangeRequests", supportRange: false, ranges: []string{"", "bytes=500-"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := it.RandomBytes(rand.New(rand.NewSource(22)), 1000)
+			srv := newFlakyServer(t, data, tt.supportRange)
+			r := newResumableReader(context.Background(), srv.Client(), srv.URL, 3, time.Millisecond)
+			got, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			require.Equal(t, data, got)
+			require.Equal(t, tt.ranges, srv.ranges())
+		})
+	}
+}
+
+func TestResumableReaderErrors(t *testing.T) {
+	t.Parallel()
+
+	var lock sync.Mutex
+	var requests int
+	status := http.StatusNotFound
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		requests++
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+
+	// Client errors aren't retried.
+	r := newResumableReader(context.Background(), srv.Client(), srv.URL, 3, time.Millisecond)
+	_, err := ioutil.ReadAll(r)
+	require.Error(t, err)
+	require.Equal(t, 1, requests)
+
+	// Server errors are retried up to the maximum retries.
+	lock.Lock()
+	requests = 0
+	status = http.StatusServiceUnavailable
+	lock.Unlock()
+	r = newResumableReader(context.Background(), srv.Client(), srv.URL, 3, time.Millisecond)
+	_, err = ioutil.ReadAll(r)
+	require.Error(t, err)
+	require.Equal(t, 4, requests)
+
+	// Downloads failing mid-stream are retried up to the maximum retries,
+	// even if every attempt makes progress.
+	data := it.RandomBytes(rand.New(rand.NewSource(22)), 1000)
+	var offsets []string
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		offsets = append(offsets, r.Header.Get("Range"))
+		lock.Unlock()
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		_, _ = w.Write(data[:10])
+	}))
+	t.Cleanup(srv.Close)
+	r = newResumableReader(context.Background(), srv.Client(), srv.URL, 3, time.Millisecond)
+	_, err = ioutil.ReadAll(r)
+	require.Error(t, err)
+	require.Len(t, offsets, 4)
+}
+
+// flakyServer serves data, failing the first request midway.
+type flakyServer struct {
+	*httptest.Server
+
+	lock         sync.Mutex
+	data         []byte
+	supportRange bool
+	reqRanges    []string
+}
+
+func newFlakyServer(t *testing.T, data []byte, supportRange bool) *flakyServer {
+	fs := &flakyServer{data: data, supportRange: supportRange}
+	fs.Server = httptest.NewServer(http.HandlerFunc(fs.serve))
+	t.Cleanup(fs.Server.Close)
+	return fs
+}
+
+func (fs *flakyServer) serve(w http.ResponseWriter, r *http.Request) {
+	fs.lock.Lock()
+	fs.reqRanges = append(fs.reqRanges, r.Header.Get("Range"))
+	first := len(fs.reqRanges) == 1
+	fs.lock.Unlock()
+
+	if first {
+		// Declare the full length but write only half of the data, so
+		// the client sees the connection closed mid-stream.
+		w.Header().Set("Content-Length", strconv.Itoa(len(fs.data)))
+		_, _ = w.Write(fs.data[:len(fs.data)/2])
+		return
+	}
+	if fs.supportRange {
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(fs.data))
+		return
+	}
+	_, _ = w.Write(fs.data)
+}
+
+func (fs *flakyServer) ranges() []string {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	return append([]string(nil), fs.reqRanges...)
+}
+
+func newCar(t *testing.T) (cid.Cid, []byte) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+	ds := dstest.Mock()
+
+	root := &dag.ProtoNode{}
+	for i := 0; i < 100; i++ {
+		leaf := dag.NewRawNode(it.RandomBytes(r, 1000))
+		require.NoError(t, ds.Add(ctx, leaf))
+		require.NoError(t, root.AddNodeLink(strconv.Itoa(i), leaf))
+	}
+	require.NoError(t, ds.Add(ctx, root))
+
+	var buf bytes.Buffer
+	require.NoError(t, car.WriteCar(ctx, ds, []cid.Cid{root.Cid()}, &buf))
+	return root.Cid(), buf.Bytes()
+}
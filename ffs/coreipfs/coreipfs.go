@@ -15,6 +15,7 @@ import (
 	iface "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/ipfs/interface-go-ipfs-core/options"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/powergate/v2/dataprep"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/coreipfs/internal/pinstore"
 	txndstr "github.com/textileio/powergate/v2/txndstransform"
@@ -99,6 +100,15 @@ func (ci *CoreIpfs) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
 	return file, nil
 }
 
+// DagStat returns the block and link structure of the DAG of a cid.
+func (ci *CoreIpfs) DagStat(ctx context.Context, c cid.Cid) (dataprep.DagStat, error) {
+	st, err := dataprep.Stat(ctx, ci.ipfs.Dag(), c)
+	if err != nil {
+		return dataprep.DagStat{}, fmt.Errorf("walking dag of %s: %s", c, err)
+	}
+	return st, nil
+}
+
 // Pin a cid for an APIID. If the cid was already pinned by a stage from APIID,
 // the Cid is considered fully-pinned and not a candidate to be unpinned by GCStaged().
 func (ci *CoreIpfs) Pin(ctx context.Context, iid ffs.APIID, c cid.Cid) (int, error) {
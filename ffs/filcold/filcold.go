@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -13,9 +15,11 @@ import (
 	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/lotus/api"
 	marketevents "github.com/filecoin-project/lotus/markets/loggers"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/ipfs/go-cid"
 	logger "github.com/ipfs/go-log/v2"
 	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/powergate/v2/deals"
 	"github.com/textileio/powergate/v2/deals/module"
 	dealsModule "github.com/textileio/powergate/v2/deals/module"
@@ -23,22 +27,31 @@ import (
 	"github.com/textileio/powergate/v2/lotus"
 	"github.com/textileio/powergate/v2/util"
 	"github.com/textileio/powergate/v2/wallet"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	unsyncedThreshold = 10
+	// pieceCacheSize is the number of calculated deal pieces cached, so
+	// repairs and renewals of recently stored data don't calculate
+	// them again.
+	pieceCacheSize = 1024
 )
 
 var (
-	log = logger.Logger("ffs-filcold")
+	log    = logger.Logger("ffs-filcold")
+	tracer = otel.Tracer("powergate/ffs/filcold")
 )
 
 // FilCold is a ColdStorage implementation which saves data in the Filecoin network.
 // It assumes the underlying Filecoin client has access to an IPFS node where data is stored.
 type FilCold struct {
 	ms                   ffs.MinerSelector
-	dm                   *dealsModule.Module
+	dm                   dealManager
 	wm                   wallet.Module
 	ipfs                 iface.CoreAPI
 	chain                FilChain
@@ -47,18 +60,47 @@ type FilCold struct {
 	minPieceSize         uint64
 	retrNextEventTimeout time.Duration
 	semaphDealPrep       chan struct{}
+	pieces               *lru.Cache
+
+	calcLock sync.Mutex
+	calcs    map[cid.Cid]*pieceCalc
 
 	// Metrics
 	metricPreprocessingTotal metric.Int64UpDownCounter
 }
 
-var _ ffs.ColdStorage = (*FilCold)(nil)
+var (
+	_ ffs.ColdStorage         = (*FilCold)(nil)
+	_ ffs.RetrievalPrefetcher = (*FilCold)(nil)
+)
+
+// pieceCalc is an in-flight calculation of a deal piece. done is closed
+// when piece and err are set.
+type pieceCalc struct {
+	done  chan struct{}
+	piece api.DataCIDSize
+	err   error
+}
 
 // FilChain is an abstraction of a Filecoin node to get information of the network.
 type FilChain interface {
 	GetHeight(context.Context) (uint64, error)
 }
 
+// dealManager is the deals module functionality used by FilCold.
+type dealManager interface {
+	CalculateDealPiece(context.Context, cid.Cid) (api.DataCIDSize, error)
+	Store(context.Context, string, cid.Cid, int64, abi.PaddedPieceSize, cid.Cid, []deals.StorageDealConfig, uint64) ([]deals.StoreResult, error)
+	Watch(context.Context, cid.Cid) (<-chan deals.StorageDealInfo, error)
+	RetryProposal(context.Context, cid.Cid) (cid.Cid, error)
+	Fetch(context.Context, string, cid.Cid, *cid.Cid, []string) (string, <-chan marketevents.RetrievalEvent, error)
+	FetchPrefetched(context.Context, string, cid.Cid, []deals.RetrievalAvailability) (string, <-chan marketevents.RetrievalEvent, error)
+	QueryRetrievalAvailability(context.Context, cid.Cid, *cid.Cid, []string) ([]deals.RetrievalAvailability, error)
+	GetDealInfo(context.Context, uint64) (api.MarketDeal, error)
+}
+
+var _ dealManager = (*dealsModule.Module)(nil)
+
 // New returns a new FilCold instance.
 func New(ms ffs.MinerSelector, dm *dealsModule.Module, wm wallet.Module, ipfs iface.CoreAPI, chain FilChain, l ffs.JobLogger, lsm *lotus.SyncMonitor, minPieceSize uint64, maxParallelDealPreparing int, retrievalNextEventTimeout time.Duration) *FilCold {
 	fc := &FilCold{
@@ -72,12 +114,23 @@ func New(ms ffs.MinerSelector, dm *dealsModule.Module, wm wallet.Module, ipfs if
 		minPieceSize:         minPieceSize,
 		retrNextEventTimeout: retrievalNextEventTimeout,
 		semaphDealPrep:       make(chan struct{}, maxParallelDealPreparing),
+		pieces:               newPieceCache(),
 	}
 	fc.initMetrics()
 
 	return fc
 }
 
+// PrefetchRetrieval queries the miners of a retrieval, which warms up
+// connections with them, and returns which of them can serve it.
+func (fc *FilCold) PrefetchRetrieval(ctx context.Context, pyCid cid.Cid, piCid *cid.Cid, miners []string) ([]deals.RetrievalAvailability, error) {
+	avs, err := fc.dm.QueryRetrievalAvailability(ctx, pyCid, piCid, miners)
+	if err != nil {
+		return nil, fmt.Errorf("querying retrieval availability: %s", err)
+	}
+	return avs, nil
+}
+
 // Fetch fetches the stored Cid data.The data will be considered available
 // to the underlying blockstore.
 func (fc *FilCold) Fetch(ctx context.Context, pyCid cid.Cid, piCid *cid.Cid, waddr string, miners []string, maxPrice uint64, selector string) (ffs.FetchInfo, error) {
@@ -85,6 +138,23 @@ func (fc *FilCold) Fetch(ctx context.Context, pyCid cid.Cid, piCid *cid.Cid, wad
 	if err != nil {
 		return ffs.FetchInfo{}, fmt.Errorf("fetching from deal module: %s", err)
 	}
+	return fc.waitFetch(ctx, pyCid, miner, events, selector)
+}
+
+// FetchPrefetched is like Fetch, but retrieves from the miners of a
+// PrefetchRetrieval result in the provided order, without querying
+// them again.
+func (fc *FilCold) FetchPrefetched(ctx context.Context, pyCid cid.Cid, waddr string, avs []deals.RetrievalAvailability, maxPrice uint64, selector string) (ffs.FetchInfo, error) {
+	miner, events, err := fc.dm.FetchPrefetched(ctx, waddr, pyCid, avs)
+	if err != nil {
+		return ffs.FetchInfo{}, fmt.Errorf("fetching from deal module: %s", err)
+	}
+	return fc.waitFetch(ctx, pyCid, miner, events, selector)
+}
+
+// waitFetch follows the events of a retrieval until it finishes, and
+// verifies the retrieved data.
+func (fc *FilCold) waitFetch(ctx context.Context, pyCid cid.Cid, miner string, events <-chan marketevents.RetrievalEvent, selector string) (ffs.FetchInfo, error) {
 	fc.l.Log(ctx, "Fetching from %s...", miner)
 
 	var (
@@ -119,17 +189,150 @@ Loop:
 		return ffs.FetchInfo{}, fmt.Errorf("retrieval failed with status %s and message %s", retrievalmarket.DealStatuses[lastEvent.Status], lastMsg)
 	}
 
+	// Partial retrievals don't contain the full DAG, so
+	// only complete ones can be verified.
+	if selector == "" {
+		fc.l.Log(ctx, "Verifying retrieved data...")
+		if err := verifyDAG(ctx, pyCid, fc.getRawBlock); err != nil {
+			return ffs.FetchInfo{}, fmt.Errorf("verifying retrieved data: %s", err)
+		}
+	}
+
 	return ffs.FetchInfo{RetrievedMiner: miner, FundsSpent: fundsSpent}, nil
 }
 
-func (fc *FilCold) calculateDealPiece(ctx context.Context, c cid.Cid) (int64, abi.PaddedPieceSize, cid.Cid, error) {
+func (fc *FilCold) getRawBlock(ctx context.Context, c cid.Cid) ([]byte, error) {
+	r, err := fc.ipfs.Block().Get(ctx, path.IpfsPath(c))
+	if err != nil {
+		return nil, fmt.Errorf("getting block: %s", err)
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading block: %s", err)
+	}
+	return data, nil
+}
+
+// verifyDAG walks the DAG with the provided root and checks that every
+// block hashes to its Cid, so the DAG reconstructs the root.
+func verifyDAG(ctx context.Context, root cid.Cid, getBlock func(context.Context, cid.Cid) ([]byte, error)) error {
+	visited := make(map[cid.Cid]struct{})
+	queue := []cid.Cid{root}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[c]; ok {
+			continue
+		}
+		visited[c] = struct{}{}
+		data, err := getBlock(ctx, c)
+		if err != nil {
+			return fmt.Errorf("getting block %s: %s", c, err)
+		}
+		nd, err := module.DecodeVerifiedBlock(c, data)
+		if err != nil {
+			return fmt.Errorf("verifying block %s: %s", c, err)
+		}
+		for _, l := range nd.Links() {
+			queue = append(queue, l.Cid)
+		}
+	}
+	return nil
+}
+
+// newPieceCache returns a cache of calculated deal pieces by payload cid.
+func newPieceCache() *lru.Cache {
+	// It only fails with a non-positive size.
+	c, _ := lru.New(pieceCacheSize)
+	return c
+}
+
+// calculateDealPiece returns the payload size, piece size and piece cid
+// of c. The piece is calculated once for the same payload, and reused
+// for further deals, such as repairs of dropped replicas, since it's
+// the same for all of them. The piece recorded in inf, the current
+// storage state of c, is reused too, so it isn't calculated again after
+// a restart. Concurrent calculations of the same payload are executed
+// once.
+func (fc *FilCold) calculateDealPiece(ctx context.Context, c cid.Cid, inf ffs.FilInfo) (int64, abi.PaddedPieceSize, cid.Cid, error) {
+	if piece, ok := knownPiece(inf); ok {
+		fc.pieces.Add(c, piece)
+		fc.l.Log(ctx, "Reusing recorded piece %s", piece.PieceCID)
+		return piece.PayloadSize, piece.PieceSize, piece.PieceCID, nil
+	}
+	if v, ok := fc.pieces.Get(c); ok {
+		piece := v.(api.DataCIDSize)
+		fc.l.Log(ctx, "Reusing calculated piece %s", piece.PieceCID)
+		return piece.PayloadSize, piece.PieceSize, piece.PieceCID, nil
+	}
+
+	fc.calcLock.Lock()
+	pc, ok := fc.calcs[c]
+	if !ok {
+		pc = &pieceCalc{done: make(chan struct{})}
+		if fc.calcs == nil {
+			fc.calcs = make(map[cid.Cid]*pieceCalc)
+		}
+		fc.calcs[c] = pc
+	}
+	fc.calcLock.Unlock()
+
+	if ok {
+		fc.l.Log(ctx, "Waiting for the piece being calculated...")
+		select {
+		case <-pc.done:
+		case <-ctx.Done():
+			return 0, 0, cid.Undef, fmt.Errorf("canceled by context")
+		}
+		if pc.err != nil {
+			return 0, 0, cid.Undef, pc.err
+		}
+		return pc.piece.PayloadSize, pc.piece.PieceSize, pc.piece.PieceCID, nil
+	}
+
+	pc.piece, pc.err = fc.doCalculateDealPiece(ctx, c)
+	if pc.err == nil {
+		fc.pieces.Add(c, pc.piece)
+	}
+	fc.calcLock.Lock()
+	delete(fc.calcs, c)
+	fc.calcLock.Unlock()
+	close(pc.done)
+	if pc.err != nil {
+		return 0, 0, cid.Undef, pc.err
+	}
+	return pc.piece.PayloadSize, pc.piece.PieceSize, pc.piece.PieceCID, nil
+}
+
+// knownPiece returns the piece recorded in inf, if any. Infos recorded
+// before keeping the payload size don't have a known piece.
+func knownPiece(inf ffs.FilInfo) (api.DataCIDSize, bool) {
+	if inf.PayloadSize <= 0 || inf.Size == 0 {
+		return api.DataCIDSize{}, false
+	}
+	for _, p := range inf.Proposals {
+		if p.PieceCid.Defined() {
+			return api.DataCIDSize{
+				PayloadSize: inf.PayloadSize,
+				PieceSize:   abi.PaddedPieceSize(inf.Size),
+				PieceCID:    p.PieceCid,
+			}, true
+		}
+	}
+	return api.DataCIDSize{}, false
+}
+
+func (fc *FilCold) doCalculateDealPiece(ctx context.Context, c cid.Cid) (_ api.DataCIDSize, err error) {
+	ctx, span := tracer.Start(ctx, "commP")
+	defer func() { endSpan(span, err) }()
+
 	fc.l.Log(ctx, "Entering deal preprocessing queue...")
 	fc.metricPreprocessingTotal.Add(ctx, 1, metricTagPreprocessingWaiting)
 	select {
 	case fc.semaphDealPrep <- struct{}{}:
 	case <-ctx.Done():
 		fc.metricPreprocessingTotal.Add(ctx, -1, metricTagPreprocessingWaiting)
-		return 0, 0, cid.Undef, fmt.Errorf("canceled by context")
+		return api.DataCIDSize{}, fmt.Errorf("canceled by context")
 	}
 	fc.metricPreprocessingTotal.Add(ctx, -1, metricTagPreprocessingWaiting)
 	fc.metricPreprocessingTotal.Add(ctx, 1, metricTagPreprocessingInProgress)
@@ -144,48 +347,39 @@ func (fc *FilCold) calculateDealPiece(ctx context.Context, c cid.Cid) (int64, ab
 		log.Warnf("backpressure from unsynced Lotus node")
 		select {
 		case <-ctx.Done():
-			return 0, 0, cid.Undef, fmt.Errorf("canceled by context")
+			return api.DataCIDSize{}, fmt.Errorf("canceled by context")
 		case <-time.After(time.Minute):
 		}
 	}
 	fc.l.Log(ctx, "Calculating piece size...")
 	piece, err := fc.dm.CalculateDealPiece(ctx, c)
 	if err != nil {
-		return 0, 0, cid.Undef, fmt.Errorf("getting cid cummulative size: %s", err)
+		return api.DataCIDSize{}, fmt.Errorf("getting cid cummulative size: %s", err)
 	}
-	return piece.PayloadSize, piece.PieceSize, piece.PieceCID, nil
+	return piece, nil
 }
 
 // Store stores a Cid in Filecoin considering the configuration provided. The Cid is retrieved using
 // the DAGService registered on instance creation. It returns a slice of ProposalCids that were correctly
 // started, and a slice of with Proposal Cids rejected. Returned proposed deals can be tracked
-// with the WaitForDeal API.
-func (fc *FilCold) Store(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) ([]cid.Cid, []ffs.DealError, abi.PaddedPieceSize, error) {
-	payloadSize, pieceSize, pieceCid, err := fc.calculateDealPiece(ctx, c)
+// with the WaitForDeal API. The piece recorded in inf, the current storage state of the Cid, is reused
+// if known, and the returned info records the piece of the new deals.
+func (fc *FilCold) Store(ctx context.Context, c cid.Cid, inf ffs.FilInfo, cfg ffs.FilConfig) ([]cid.Cid, []ffs.DealError, ffs.FilInfo, error) {
+	payloadSize, pieceSize, pieceCid, err := fc.calculateDealPiece(ctx, c, inf)
 	if err != nil {
-		return nil, nil, 0, fmt.Errorf("getting cid cummulative size: %s", err)
+		return nil, nil, ffs.FilInfo{}, fmt.Errorf("getting cid cummulative size: %s", err)
 	}
 	fc.l.Log(ctx, "The payload size is %s, and the calculated piece size is %s", humanize.IBytes(uint64(payloadSize)), humanize.IBytes(uint64(pieceSize)))
 
 	if uint64(pieceSize) < fc.minPieceSize {
-		return nil, nil, 0, fmt.Errorf("Piece size is below allowed minimum %s", humanize.IBytes(fc.minPieceSize))
+		return nil, nil, ffs.FilInfo{}, fmt.Errorf("Piece size is below allowed minimum %s", humanize.IBytes(fc.minPieceSize))
 	}
 
-	if cfg.VerifiedDeal {
-		vci, err := fc.wm.GetVerifiedClientInfo(ctx, cfg.Addr)
-		if err != nil && err != wallet.ErrNoVerifiedClient {
-			return nil, nil, 0, fmt.Errorf("get address verified client info: %s", err)
-		}
-		if err == wallet.ErrNoVerifiedClient {
-			return nil, nil, 0, fmt.Errorf("wallet address isn't a verified client")
-		}
-
-		pieceSizeBig := big.NewInt(int64(pieceSize))
-		if vci.RemainingDatacapBytes.Cmp(big.NewInt(0).Mul(big.NewInt(int64(cfg.RepFactor)), pieceSizeBig)) == -1 {
-			return nil, nil, 0, fmt.Errorf("the remaining data-cap %s is less than piece-size %s * rep-factor %d", humanize.IBytes(vci.RemainingDatacapBytes.Uint64()), humanize.IBytes(uint64(pieceSize)), cfg.RepFactor)
+	verified := verifiedReplicas(cfg)
+	if verified > 0 {
+		if err := fc.checkDataCap(ctx, cfg.Addr, pieceSize, verified); err != nil {
+			return nil, nil, ffs.FilInfo{}, err
 		}
-
-		fc.l.Log(ctx, "Attempting to use %s of data-cap. The current quota is %s", humanize.IBytes(uint64(pieceSize)*uint64(cfg.RepFactor)), humanize.IBytes(vci.RemainingDatacapBytes.Uint64()))
 	}
 	f := ffs.MinerSelectorFilter{
 		ExcludedMiners: cfg.ExcludedMiners,
@@ -194,17 +388,54 @@ func (fc *FilCold) Store(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) ([]c
 		MaxPrice:       cfg.MaxPrice,
 		PieceSize:      uint64(pieceSize),
 		VerifiedDeal:   cfg.VerifiedDeal,
+		FastRetrieval:  cfg.FastRetrieval,
+		DealDuration:   cfg.DealMinDuration,
+		Policy:         cfg.Acceptance,
 	}
-	cfgs, err := makeDealConfigs(fc.ms, cfg.RepFactor, f, cfg.FastRetrieval, cfg.DealStartOffset)
+	cfgs, err := makeMixedDealConfigs(fc.ms, cfg.RepFactor, verified, f, cfg.FastRetrieval, cfg.DealStartOffset)
 	if err != nil {
-		return nil, nil, 0, fmt.Errorf("making deal configs: %s", err)
+		return nil, nil, ffs.FilInfo{}, fmt.Errorf("making deal configs: %s", err)
 	}
 
 	okDeals, failedStartingDeals, err := fc.makeDeals(ctx, c, payloadSize, pieceSize, pieceCid, cfgs, cfg)
 	if err != nil {
-		return nil, nil, 0, fmt.Errorf("starting deals: %s", err)
+		return nil, nil, ffs.FilInfo{}, fmt.Errorf("starting deals: %s", err)
+	}
+	pinf := ffs.FilInfo{
+		DataCid:     c,
+		Size:        uint64(pieceSize),
+		PayloadSize: payloadSize,
+	}
+	return okDeals, failedStartingDeals, pinf, nil
+}
+
+// verifiedReplicas returns how many of the deals made with cfg should be
+// verified.
+func verifiedReplicas(cfg ffs.FilConfig) int {
+	if cfg.VerifiedDeal || cfg.MinVerifiedReplicas > cfg.RepFactor {
+		return cfg.RepFactor
 	}
-	return okDeals, failedStartingDeals, pieceSize, nil
+	return cfg.MinVerifiedReplicas
+}
+
+// checkDataCap returns an error if the remaining DataCap of addr isn't
+// enough for the provided number of verified deals of pieceSize.
+func (fc *FilCold) checkDataCap(ctx context.Context, addr string, pieceSize abi.PaddedPieceSize, verified int) error {
+	vci, err := fc.wm.GetVerifiedClientInfo(ctx, addr)
+	if err != nil && err != wallet.ErrNoVerifiedClient {
+		return fmt.Errorf("get address verified client info: %s", err)
+	}
+	if err == wallet.ErrNoVerifiedClient {
+		return fmt.Errorf("wallet address isn't a verified client")
+	}
+
+	pieceSizeBig := big.NewInt(int64(pieceSize))
+	if vci.RemainingDatacapBytes.Cmp(big.NewInt(0).Mul(big.NewInt(int64(verified)), pieceSizeBig)) == -1 {
+		return fmt.Errorf("the remaining data-cap %s is less than piece-size %s * verified deals %d", humanize.IBytes(vci.RemainingDatacapBytes.Uint64()), humanize.IBytes(uint64(pieceSize)), verified)
+	}
+
+	fc.l.Log(ctx, "Attempting to use %s of data-cap. The current quota is %s", humanize.IBytes(uint64(pieceSize)*uint64(verified)), humanize.IBytes(vci.RemainingDatacapBytes.Uint64()))
+	return nil
 }
 
 // GetDealInfo returns on-chain information for a deal.
@@ -268,9 +499,10 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 	}
 
 	newInf := ffs.FilInfo{
-		DataCid:   inf.DataCid,
-		Size:      inf.Size,
-		Proposals: make([]ffs.FilStorage, len(inf.Proposals)),
+		DataCid:     inf.DataCid,
+		Size:        inf.Size,
+		PayloadSize: inf.PayloadSize,
+		Proposals:   make([]ffs.FilStorage, len(inf.Proposals)),
 	}
 	for i, p := range inf.Proposals {
 		newInf.Proposals[i] = p
@@ -280,7 +512,7 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 	var newDealErrors []ffs.DealError
 	for i, p := range toRenew {
 		var dealError ffs.DealError
-		newProposal, err := fc.renewDeal(ctx, c, p, cfg, dealFinalityTimeout, dealUpdates)
+		newProposal, err := fc.renewDeal(ctx, c, inf, p, cfg, dealFinalityTimeout, dealUpdates)
 		if err != nil {
 			if errors.As(err, &dealError) {
 				newDealErrors = append(newDealErrors, dealError)
@@ -295,19 +527,31 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 	return newInf, newDealErrors, nil
 }
 
-func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, p ffs.FilStorage, fcfg ffs.FilConfig, waitDealTimeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilStorage, error) {
-	payloadSize, pieceSize, pieceCid, err := fc.calculateDealPiece(ctx, c)
+func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, inf ffs.FilInfo, p ffs.FilStorage, fcfg ffs.FilConfig, waitDealTimeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilStorage, error) {
+	payloadSize, pieceSize, pieceCid, err := fc.calculateDealPiece(ctx, c, inf)
 	if err != nil {
 		return ffs.FilStorage{}, fmt.Errorf("getting cid cummulative size: %s", err)
 	}
 
+	// The renewal keeps the replica verified, so the minimum of verified
+	// replicas still holds. Deals tracked before recording if they're
+	// verified are renewed as the config says.
+	verified := p.Verified || fcfg.VerifiedDeal
+	if verified {
+		if err := fc.checkDataCap(ctx, fcfg.Addr, pieceSize, 1); err != nil {
+			return ffs.FilStorage{}, fmt.Errorf("checking data-cap for verified renewal: %s", err)
+		}
+	}
 	f := ffs.MinerSelectorFilter{
 		ExcludedMiners: fcfg.ExcludedMiners,
 		CountryCodes:   fcfg.CountryCodes,
 		TrustedMiners:  []string{p.Miner},
 		MaxPrice:       fcfg.MaxPrice,
 		PieceSize:      uint64(pieceSize),
-		VerifiedDeal:   fcfg.VerifiedDeal,
+		VerifiedDeal:   verified,
+		FastRetrieval:  fcfg.FastRetrieval,
+		DealDuration:   fcfg.DealMinDuration,
+		Policy:         fcfg.Acceptance,
 	}
 	dealConfig, err := makeDealConfigs(fc.ms, 1, f, fcfg.FastRetrieval, fcfg.DealStartOffset)
 	if err != nil {
@@ -327,16 +571,21 @@ func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, p ffs.FilStorage, f
 	}
 
 	var dealError ffs.DealError
-	okDeal, err := fc.WaitForDeal(ctx, c, okDeals[0], waitDealTimeout, dealUpdates)
+	acceptanceTimeout := time.Duration(fcfg.DealAcceptanceTimeout) * time.Second
+	okDeal, err := fc.WaitForDeal(ctx, c, okDeals[0], waitDealTimeout, acceptanceTimeout, dealUpdates)
 	if err != nil && !errors.As(err, &dealError) {
 		return ffs.FilStorage{}, ffs.DealError{ProposalCid: c, Message: fmt.Sprintf("waiting for renew deal: %s", err)}
 	}
+	okDeal.CostCenter = fcfg.CostCenter
 	return okDeal, err
 }
 
 // makeDeals starts deals with the specified miners. It returns a slice with all the ProposalCids
 // that were started successfully, and a slice of DealError with deals that failed to be started.
-func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, payloadSize int64, pieceSize abi.PaddedPieceSize, pieceCid cid.Cid, cfgs []deals.StorageDealConfig, fcfg ffs.FilConfig) ([]cid.Cid, []ffs.DealError, error) {
+func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, payloadSize int64, pieceSize abi.PaddedPieceSize, pieceCid cid.Cid, cfgs []deals.StorageDealConfig, fcfg ffs.FilConfig) (_ []cid.Cid, _ []ffs.DealError, err error) {
+	ctx, span := tracer.Start(ctx, "propose", trace.WithAttributes(attribute.Int("deals", len(cfgs))))
+	defer func() { endSpan(span, err) }()
+
 	for {
 		if fc.lsm.SyncHeightDiff() < unsyncedThreshold {
 			break
@@ -382,14 +631,34 @@ func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, payloadSize int64,
 // If the deal finishes successfully it returns a FilStorage result.
 // If the deal finished with error, it returns a ffs.DealError error
 // result, so it should be considered in error handling.
-func (fc *FilCold) WaitForDeal(ctx context.Context, c cid.Cid, proposal cid.Cid, timeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilStorage, error) {
+// If acceptanceTimeout is greater than zero and the miner doesn't accept
+// the proposal within it, the deal fails as unresponsive.
+func (fc *FilCold) WaitForDeal(ctx context.Context, c cid.Cid, proposal cid.Cid, timeout time.Duration, acceptanceTimeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilStorage, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	chDi, err := fc.dm.Watch(ctx, proposal)
 	if err != nil {
 		return ffs.FilStorage{}, fmt.Errorf("watching proposals in deals module: %s", err)
 	}
+	return fc.waitForDeal(ctx, c, proposal, chDi, timeout, acceptanceTimeout, dealUpdates)
+}
+
+func (fc *FilCold) waitForDeal(ctx context.Context, c cid.Cid, proposal cid.Cid, chDi <-chan deals.StorageDealInfo, timeout time.Duration, acceptanceTimeout time.Duration, dealUpdates chan deals.StorageDealInfo) (_ ffs.FilStorage, err error) {
+	// The deal is traced in two phases: the data transfer until the
+	// miner accepts the deal, and the activation until it's active.
+	ctx, span := tracer.Start(ctx, "deal", trace.WithAttributes(attribute.String("proposal", util.CidToString(proposal))))
+	_, phase := tracer.Start(ctx, "data transfer")
+	defer func() {
+		endSpan(phase, err)
+		endSpan(span, err)
+	}()
 
+	// A nil channel never fires, which disables the acceptance deadline.
+	var acceptanceDeadline <-chan time.Time
+	if acceptanceTimeout > 0 {
+		acceptanceDeadline = time.After(acceptanceTimeout)
+	}
+	var accepted, retried bool
 	var last deals.StorageDealInfo
 Loop:
 	for {
@@ -398,6 +667,10 @@ Loop:
 			msg := fmt.Sprintf("DealID %d with miner %s tracking timed out after waiting for %.0f hours.", last.DealID, last.Miner, timeout.Hours())
 			fc.l.Log(ctx, msg)
 			return ffs.FilStorage{}, ffs.DealError{ProposalCid: proposal, Miner: last.Miner, Message: msg}
+		case <-acceptanceDeadline:
+			msg := fmt.Sprintf("Miner %s didn't accept the deal proposal after waiting for %.0f minutes.", last.Miner, acceptanceTimeout.Minutes())
+			fc.l.Log(ctx, msg)
+			return ffs.FilStorage{}, ffs.DealError{ProposalCid: proposal, Miner: last.Miner, Message: msg, Reason: ffs.DealUnresponsive}
 		case di, ok := <-chDi:
 			if !ok {
 				break Loop
@@ -408,6 +681,12 @@ Loop:
 			default:
 				log.Warnf("slow receiver for deal updates for %s", c)
 			}
+			if !accepted && isAcceptedDealState(di.StateID) {
+				accepted = true
+				acceptanceDeadline = nil
+				phase.End()
+				_, phase = tracer.Start(ctx, "activation")
+			}
 			switch di.StateID {
 			case storagemarket.StorageDealActive:
 				activeProposal := ffs.FilStorage{
@@ -417,15 +696,30 @@ Loop:
 					Miner:      di.Miner,
 					StartEpoch: di.StartEpoch,
 					EpochPrice: di.PricePerEpoch,
+					Verified:   di.Verified,
 				}
 				fc.l.Log(ctx, "Deal %d with miner %s is active on-chain", di.DealID, di.Miner)
 
 				return activeProposal, nil
-			case storagemarket.StorageDealError, storagemarket.StorageDealFailing:
+			case storagemarket.StorageDealProposalRejected, storagemarket.StorageDealError, storagemarket.StorageDealFailing:
+				if !retried && dealsModule.IsStartEpochRejection(di) {
+					retried = true
+					newProposal, newChDi, err := fc.retryProposal(ctx, proposal)
+					if err == nil {
+						fc.l.Log(ctx, "Miner %s rejected the deal start epoch, proposing again with a later one", di.Miner)
+						proposal, chDi = newProposal, newChDi
+						continue
+					}
+					log.Warnf("retrying proposal %s: %s", proposal, err)
+				}
 				log.Errorf("deal %d & proposal %s failed with state %s: %s", di.DealID, proposal, storagemarket.DealStates[di.StateID], di.Message)
 				fc.l.Log(ctx, "DealID %d with miner %s failed and won't be active on-chain: %s", di.DealID, di.Miner, di.Message)
 
-				return ffs.FilStorage{}, ffs.DealError{ProposalCid: di.ProposalCid, Miner: di.Miner, Message: di.Message}
+				reason := ffs.DealFailed
+				if !accepted {
+					reason = ffs.DealRejected
+				}
+				return ffs.FilStorage{}, ffs.DealError{ProposalCid: di.ProposalCid, Miner: di.Miner, Message: di.Message, Reason: reason}
 			default:
 				if di.DealID != 0 {
 					fc.l.Log(ctx, "Deal %d with miner %s changed state to %s", di.DealID, di.Miner, storagemarket.DealStates[di.StateID])
@@ -438,6 +732,38 @@ Loop:
 	return ffs.FilStorage{}, fmt.Errorf("aborted due to cancellation")
 }
 
+// retryProposal proposes again a deal rejected because of its start epoch,
+// and returns the new proposal and its updates.
+func (fc *FilCold) retryProposal(ctx context.Context, proposal cid.Cid) (cid.Cid, <-chan deals.StorageDealInfo, error) {
+	newProposal, err := fc.dm.RetryProposal(ctx, proposal)
+	if err != nil {
+		return cid.Undef, nil, fmt.Errorf("retrying proposal: %s", err)
+	}
+	chDi, err := fc.dm.Watch(ctx, newProposal)
+	if err != nil {
+		return cid.Undef, nil, fmt.Errorf("watching retried proposal %s: %s", newProposal, err)
+	}
+	return newProposal, chDi, nil
+}
+
+// isAcceptedDealState returns true if the deal state implies
+// the miner accepted the deal proposal.
+func isAcceptedDealState(state uint64) bool {
+	switch state {
+	case storagemarket.StorageDealProposalAccepted,
+		storagemarket.StorageDealPublish,
+		storagemarket.StorageDealPublishing,
+		storagemarket.StorageDealStaged,
+		storagemarket.StorageDealAwaitingPreCommit,
+		storagemarket.StorageDealSealing,
+		storagemarket.StorageDealFinalizing,
+		storagemarket.StorageDealActive:
+		return true
+	default:
+		return false
+	}
+}
+
 func makeDealConfigs(ms ffs.MinerSelector, cntMiners int, f ffs.MinerSelectorFilter, fastRetrieval bool, dealStartOffset int64) ([]deals.StorageDealConfig, error) {
 	mps, err := ms.GetMiners(cntMiners, f)
 	if err != nil {
@@ -451,7 +777,55 @@ func makeDealConfigs(ms ffs.MinerSelector, cntMiners int, f ffs.MinerSelectorFil
 			FastRetrieval:   fastRetrieval,
 			DealStartOffset: dealStartOffset,
 			VerifiedDeal:    f.VerifiedDeal,
+			MaxPrice:        f.MaxPrice,
 		}
 	}
 	return res, nil
 }
+
+// makeMixedDealConfigs selects cntMiners miners, of which verified make
+// verified deals. Verified and regular deals are selected separately,
+// since miners may ask different prices for them, and never with the
+// same miner.
+func makeMixedDealConfigs(ms ffs.MinerSelector, cntMiners, verified int, f ffs.MinerSelectorFilter, fastRetrieval bool, dealStartOffset int64) ([]deals.StorageDealConfig, error) {
+	if verified == 0 || verified == cntMiners {
+		f.VerifiedDeal = verified > 0
+		return makeDealConfigs(ms, cntMiners, f, fastRetrieval, dealStartOffset)
+	}
+
+	vf := f
+	vf.VerifiedDeal = true
+	res, err := makeDealConfigs(ms, verified, vf, fastRetrieval, dealStartOffset)
+	if err != nil {
+		return nil, fmt.Errorf("selecting verified deal miners: %s", err)
+	}
+	f.VerifiedDeal = false
+	selected := make(map[string]struct{}, len(res))
+	f.ExcludedMiners = append([]string(nil), f.ExcludedMiners...)
+	for _, c := range res {
+		selected[c.Miner] = struct{}{}
+		f.ExcludedMiners = append(f.ExcludedMiners, c.Miner)
+	}
+	// Trusted miners are selected even if excluded.
+	var trusted []string
+	for _, m := range f.TrustedMiners {
+		if _, ok := selected[m]; !ok {
+			trusted = append(trusted, m)
+		}
+	}
+	f.TrustedMiners = trusted
+	regular, err := makeDealConfigs(ms, cntMiners-verified, f, fastRetrieval, dealStartOffset)
+	if err != nil {
+		return nil, fmt.Errorf("selecting regular deal miners: %s", err)
+	}
+	return append(res, regular...), nil
+}
+
+// endSpan ends a span, recording the error of the traced operation.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,496 @@
+package filcold
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/ipfs/go-cid"
+	format "github.com/ipfs/go-ipld-format"
+	"github.com/ipfs/go-merkledag"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/minerselector/fixed"
+	"github.com/textileio/powergate/v2/lotus"
+	"github.com/textileio/powergate/v2/tests"
+	"github.com/textileio/powergate/v2/wallet"
+	"go.opentelemetry.io/otel"
+)
+
+func TestWaitForDealAcceptance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("AcceptedAfterDelay", func(t *testing.T) {
+		t.Parallel()
+		chDi := make(chan deals.StorageDealInfo)
+		go func() {
+			chDi <- deals.StorageDealInfo{Miner: "f01", StateID: storagemarket.StorageDealCheckForAcceptance}
+			time.Sleep(time.Millisecond * 100)
+			chDi <- deals.StorageDealInfo{Miner: "f01", StateID: storagemarket.StorageDealProposalAccepted}
+			// Sealing takes longer than the acceptance timeout, which
+			// shouldn't matter once the deal was accepted.
+			time.Sleep(time.Millisecond * 500)
+			chDi <- deals.StorageDealInfo{Miner: "f01", StateID: storagemarket.StorageDealActive, DealID: 1}
+		}()
+		fs, err := runWaitForDeal(t, chDi, time.Millisecond*300)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), fs.DealID)
+	})
+
+	t.Run("NeverResponds", func(t *testing.T) {
+		t.Parallel()
+		chDi := make(chan deals.StorageDealInfo)
+		go func() {
+			chDi <- deals.StorageDealInfo{Miner: "f01", StateID: storagemarket.StorageDealCheckForAcceptance}
+		}()
+		_, err := runWaitForDeal(t, chDi, time.Millisecond*300)
+		var de ffs.DealError
+		require.True(t, errors.As(err, &de))
+		require.Equal(t, ffs.DealUnresponsive, de.Reason)
+		require.Equal(t, "f01", de.Miner)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		t.Parallel()
+		chDi := make(chan deals.StorageDealInfo)
+		go func() {
+			chDi <- deals.StorageDealInfo{Miner: "f01", StateID: storagemarket.StorageDealProposalRejected}
+		}()
+		_, err := runWaitForDeal(t, chDi, time.Millisecond*300)
+		var de ffs.DealError
+		require.True(t, errors.As(err, &de))
+		require.Equal(t, ffs.DealRejected, de.Reason)
+	})
+
+	t.Run("FailedAfterAcceptance", func(t *testing.T) {
+		t.Parallel()
+		chDi := make(chan deals.StorageDealInfo)
+		go func() {
+			chDi <- deals.StorageDealInfo{Miner: "f01", StateID: storagemarket.StorageDealProposalAccepted}
+			chDi <- deals.StorageDealInfo{Miner: "f01", StateID: storagemarket.StorageDealError}
+		}()
+		_, err := runWaitForDeal(t, chDi, 0)
+		var de ffs.DealError
+		require.True(t, errors.As(err, &de))
+		require.Equal(t, ffs.DealFailed, de.Reason)
+	})
+}
+
+func TestWaitForDealStartEpochRetry(t *testing.T) {
+	t.Parallel()
+
+	rejection := deals.StorageDealInfo{
+		Miner:   "f01",
+		StateID: storagemarket.StorageDealFailing,
+		Message: "deal rejected: deal start epoch is too soon or deal already expired",
+	}
+	run := func(retried ...deals.StorageDealInfo) (*retryDealManager, ffs.FilStorage, error) {
+		chDi := make(chan deals.StorageDealInfo, 1)
+		chDi <- rejection
+		dm := &retryDealManager{updates: retried}
+		fc := &FilCold{l: &nopLogger{}, dm: dm}
+		dealUpdates := make(chan deals.StorageDealInfo, 10)
+		fs, err := fc.waitForDeal(context.Background(), cid.Undef, cid.Undef, chDi, time.Second*5, 0, dealUpdates)
+		return dm, fs, err
+	}
+
+	// The rejected proposal is retried, and the new one becomes active.
+	dm, fs, err := run(deals.StorageDealInfo{Miner: "f01", StateID: storagemarket.StorageDealActive, DealID: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), fs.DealID)
+	require.Equal(t, 1, dm.retries)
+
+	// A retried proposal isn't retried again.
+	dm, _, err = run(rejection)
+	var de ffs.DealError
+	require.True(t, errors.As(err, &de))
+	require.Equal(t, ffs.DealRejected, de.Reason)
+	require.Equal(t, 1, dm.retries)
+}
+
+// retryDealManager retries proposals, and notifies updates of retried
+// proposals.
+type retryDealManager struct {
+	dealManager
+	updates []deals.StorageDealInfo
+	retries int
+}
+
+func (dm *retryDealManager) RetryProposal(context.Context, cid.Cid) (cid.Cid, error) {
+	dm.retries++
+	return cid.Undef, nil
+}
+
+func (dm *retryDealManager) Watch(context.Context, cid.Cid) (<-chan deals.StorageDealInfo, error) {
+	ch := make(chan deals.StorageDealInfo, len(dm.updates))
+	for _, u := range dm.updates {
+		ch <- u
+	}
+	return ch, nil
+}
+
+func TestWaitForDealSpans(t *testing.T) {
+	t.Parallel()
+	sr := tests.RecordSpans()
+
+	ctx, root := otel.Tracer("test").Start(context.Background(), "test")
+	chDi := make(chan deals.StorageDealInfo, 3)
+	chDi <- deals.StorageDealInfo{Miner: "f01", StateID: storagemarket.StorageDealTransferring}
+	chDi <- deals.StorageDealInfo{Miner: "f01", StateID: storagemarket.StorageDealProposalAccepted}
+	chDi <- deals.StorageDealInfo{Miner: "f01", StateID: storagemarket.StorageDealActive, DealID: 1}
+	fc := &FilCold{l: &nopLogger{}}
+	dealUpdates := make(chan deals.StorageDealInfo, 10)
+	_, err := fc.waitForDeal(ctx, cid.Undef, cid.Undef, chDi, time.Second*5, 0, dealUpdates)
+	require.NoError(t, err)
+	root.End()
+
+	// The deal phases are children of the deal span.
+	spans := tests.TraceSpans(sr, root.SpanContext().TraceID())
+	deal := tests.RequireSpan(t, spans, "deal", root.SpanContext().SpanID())
+	tests.RequireSpan(t, spans, "data transfer", deal.SpanContext().SpanID())
+	tests.RequireSpan(t, spans, "activation", deal.SpanContext().SpanID())
+}
+
+func runWaitForDeal(t *testing.T, chDi chan deals.StorageDealInfo, acceptanceTimeout time.Duration) (ffs.FilStorage, error) {
+	fc := &FilCold{l: &nopLogger{}}
+	dealUpdates := make(chan deals.StorageDealInfo, 10)
+	defer close(dealUpdates)
+	return fc.waitForDeal(context.Background(), cid.Undef, cid.Undef, chDi, time.Second*5, acceptanceTimeout, dealUpdates)
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Log(context.Context, string, ...interface{}) {}
+
+func (nopLogger) Watch(context.Context, chan<- ffs.LogEntry) error {
+	return nil
+}
+
+func (nopLogger) GetByCid(context.Context, ffs.APIID, cid.Cid) ([]ffs.LogEntry, error) {
+	return nil, nil
+}
+
+func (nopLogger) GetByCidPage(context.Context, ffs.APIID, cid.Cid, int, int) ([]ffs.LogEntry, error) {
+	return nil, nil
+}
+
+func TestVerifyDAG(t *testing.T) {
+	t.Parallel()
+
+	leaf := merkledag.NewRawNode([]byte("leaf"))
+	root := merkledag.NodeWithData([]byte("root"))
+	require.NoError(t, root.AddNodeLink("leaf", leaf))
+	blocks := map[cid.Cid][]byte{
+		root.Cid(): root.RawData(),
+		leaf.Cid(): leaf.RawData(),
+	}
+	getBlock := func(_ context.Context, c cid.Cid) ([]byte, error) {
+		data, ok := blocks[c]
+		if !ok {
+			return nil, format.ErrNotFound
+		}
+		return data, nil
+	}
+
+	require.NoError(t, verifyDAG(context.Background(), root.Cid(), getBlock))
+
+	// Tamper the leaf data.
+	blocks[leaf.Cid()] = []byte("tampered")
+	require.Error(t, verifyDAG(context.Background(), root.Cid(), getBlock))
+
+	// Missing blocks don't reconstruct the root either.
+	delete(blocks, leaf.Cid())
+	require.Error(t, verifyDAG(context.Background(), root.Cid(), getBlock))
+}
+
+func TestMinVerifiedReplicas(t *testing.T) {
+	t.Parallel()
+
+	pieceSize := abi.PaddedPieceSize(1 << 20)
+	cfg := ffs.FilConfig{Addr: "f3client", RepFactor: 3, MinVerifiedReplicas: 2}
+	verified := verifiedReplicas(cfg)
+	require.Equal(t, 2, verified)
+	require.Equal(t, 3, verifiedReplicas(ffs.FilConfig{RepFactor: 3, VerifiedDeal: true}))
+
+	t.Run("SufficientDataCap", func(t *testing.T) {
+		t.Parallel()
+		fc := &FilCold{l: &nopLogger{}, wm: &dataCapWallet{remaining: big.NewInt(2 << 20)}}
+		require.NoError(t, fc.checkDataCap(context.Background(), cfg.Addr, pieceSize, verified))
+
+		ms := fixed.New([]fixed.Miner{{Addr: "f01", EpochPrice: 10}, {Addr: "f02", EpochPrice: 10}, {Addr: "f03", EpochPrice: 10}})
+		f := ffs.MinerSelectorFilter{TrustedMiners: []string{"f01"}, PieceSize: uint64(pieceSize)}
+		cfgs, err := makeMixedDealConfigs(ms, cfg.RepFactor, verified, f, false, 0)
+		require.NoError(t, err)
+		require.Len(t, cfgs, 3)
+		miners := make(map[string]struct{})
+		var cntVerified int
+		for _, c := range cfgs {
+			miners[c.Miner] = struct{}{}
+			if c.VerifiedDeal {
+				cntVerified++
+			}
+		}
+		require.Len(t, miners, 3)
+		require.Equal(t, 2, cntVerified)
+	})
+
+	t.Run("InsufficientDataCap", func(t *testing.T) {
+		t.Parallel()
+		fc := &FilCold{l: &nopLogger{}, wm: &dataCapWallet{remaining: big.NewInt(1 << 20)}}
+		require.Error(t, fc.checkDataCap(context.Background(), cfg.Addr, pieceSize, verified))
+		require.NoError(t, fc.checkDataCap(context.Background(), cfg.Addr, pieceSize, 1))
+	})
+
+	t.Run("NotVerifiedClient", func(t *testing.T) {
+		t.Parallel()
+		fc := &FilCold{l: &nopLogger{}, wm: &dataCapWallet{}}
+		require.Error(t, fc.checkDataCap(context.Background(), cfg.Addr, pieceSize, verified))
+	})
+}
+
+// dataCapWallet is a wallet whose address has the remaining DataCap, or
+// isn't a verified client if it's nil.
+type dataCapWallet struct {
+	wallet.Module
+	remaining *big.Int
+}
+
+func (w *dataCapWallet) GetVerifiedClientInfo(context.Context, string) (wallet.VerifiedClientInfo, error) {
+	if w.remaining == nil {
+		return wallet.VerifiedClientInfo{}, wallet.ErrNoVerifiedClient
+	}
+	return wallet.VerifiedClientInfo{RemainingDatacapBytes: w.remaining}, nil
+}
+
+func TestRepairReusesPiece(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, err := cid.Decode("QmfYpC6AZDgVDbwwMDkhbEjXX6NTuYyWm7GCzMuxiKqmVj")
+	require.NoError(t, err)
+	pieceCid, err := cid.Decode("baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq")
+	require.NoError(t, err)
+	dm := &pieceDealManager{piece: api.DataCIDSize{PayloadSize: 1000, PieceSize: 2048, PieceCID: pieceCid}}
+	ms := fixed.New([]fixed.Miner{{Addr: "f01", EpochPrice: 10}, {Addr: "f02", EpochPrice: 10}, {Addr: "f03", EpochPrice: 10}})
+	fc := &FilCold{
+		ms:             ms,
+		dm:             dm,
+		l:              &nopLogger{},
+		lsm:            &lotus.SyncMonitor{},
+		semaphDealPrep: make(chan struct{}, 1),
+		pieces:         newPieceCache(),
+	}
+	fc.initMetrics()
+
+	cfg := ffs.FilConfig{Addr: "f3client", RepFactor: 2, DealMinDuration: 1000}
+	okDeals, failed, inf, err := fc.Store(ctx, c, ffs.FilInfo{}, cfg)
+	require.NoError(t, err)
+	require.Empty(t, failed)
+	require.Len(t, okDeals, 2)
+	require.Equal(t, uint64(2048), inf.Size)
+	require.Equal(t, int64(1000), inf.PayloadSize)
+	require.Equal(t, 1, dm.calculated)
+	require.Len(t, dm.stored, 2)
+
+	// The second replica is dropped, so the repair makes a single deal
+	// with another miner to replace it, reusing the calculated piece.
+	repair := cfg
+	repair.RepFactor = 1
+	repair.ExcludedMiners = []string{dm.stored[0].Miner}
+	okDeals, failed, inf, err = fc.Store(ctx, c, ffs.FilInfo{}, repair)
+	require.NoError(t, err)
+	require.Empty(t, failed)
+	require.Len(t, okDeals, 1)
+	require.Equal(t, uint64(2048), inf.Size)
+	require.Equal(t, 1, dm.calculated)
+	require.Len(t, dm.stored, 3)
+	require.NotEqual(t, dm.stored[0].Miner, dm.stored[2].Miner)
+}
+
+func TestStoreReusesRecordedPiece(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, err := cid.Decode("QmfYpC6AZDgVDbwwMDkhbEjXX6NTuYyWm7GCzMuxiKqmVj")
+	require.NoError(t, err)
+	pieceCid, err := cid.Decode("baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq")
+	require.NoError(t, err)
+	dm := &pieceDealManager{piece: api.DataCIDSize{PayloadSize: 1000, PieceSize: 2048, PieceCID: pieceCid}}
+	fc := &FilCold{
+		ms:             fixed.New([]fixed.Miner{{Addr: "f01", EpochPrice: 10}, {Addr: "f02", EpochPrice: 10}}),
+		dm:             dm,
+		l:              &nopLogger{},
+		lsm:            &lotus.SyncMonitor{},
+		semaphDealPrep: make(chan struct{}, 1),
+		pieces:         newPieceCache(),
+	}
+	fc.initMetrics()
+
+	// The piece recorded by a previous run, e.g. before a restart, is
+	// reused by the repair.
+	recorded := ffs.FilInfo{
+		DataCid:     c,
+		Size:        2048,
+		PayloadSize: 1000,
+		Proposals:   []ffs.FilStorage{{Miner: "f01", PieceCid: pieceCid}},
+	}
+	cfg := ffs.FilConfig{Addr: "f3client", RepFactor: 1, DealMinDuration: 1000, ExcludedMiners: []string{"f01"}}
+	okDeals, failed, inf, err := fc.Store(ctx, c, recorded, cfg)
+	require.NoError(t, err)
+	require.Empty(t, failed)
+	require.Len(t, okDeals, 1)
+	require.Equal(t, uint64(2048), inf.Size)
+	require.Equal(t, int64(1000), inf.PayloadSize)
+	require.Equal(t, 0, dm.calculations())
+
+	// Infos recorded without the payload size calculate the piece.
+	recorded.PayloadSize = 0
+	_, err = fc.renewDeal(ctx, cid.NewCidV1(cid.Raw, c.Hash()), recorded, ffs.FilStorage{Miner: "f02"}, cfg, time.Second*5, make(chan deals.StorageDealInfo, 1))
+	require.NoError(t, err)
+	require.Equal(t, 1, dm.calculations())
+}
+
+func TestCalculateDealPieceInFlight(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, err := cid.Decode("QmfYpC6AZDgVDbwwMDkhbEjXX6NTuYyWm7GCzMuxiKqmVj")
+	require.NoError(t, err)
+	pieceCid, err := cid.Decode("baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq")
+	require.NoError(t, err)
+	dm := &pieceDealManager{
+		piece:   api.DataCIDSize{PayloadSize: 1000, PieceSize: 2048, PieceCID: pieceCid},
+		release: make(chan struct{}),
+	}
+	fc := &FilCold{
+		dm:             dm,
+		l:              &nopLogger{},
+		lsm:            &lotus.SyncMonitor{},
+		semaphDealPrep: make(chan struct{}, 2),
+		pieces:         newPieceCache(),
+	}
+	fc.initMetrics()
+
+	const calls = 5
+	errs := make(chan error, calls)
+	for i := 0; i < calls; i++ {
+		go func() {
+			_, pieceSize, _, err := fc.calculateDealPiece(ctx, c, ffs.FilInfo{})
+			if err == nil && pieceSize != 2048 {
+				err = fmt.Errorf("unexpected piece size %d", pieceSize)
+			}
+			errs <- err
+		}()
+	}
+	// The calls which start while the piece is calculated wait for it,
+	// and the later ones get it from the cache.
+	require.Eventually(t, func() bool { return dm.calculations() == 1 }, time.Second*5, time.Millisecond*10)
+	close(dm.release)
+	for i := 0; i < calls; i++ {
+		require.NoError(t, <-errs)
+	}
+	require.Equal(t, 1, dm.calculations())
+	require.Empty(t, fc.calcs)
+}
+
+func TestRenewKeepsVerified(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, err := cid.Decode("QmfYpC6AZDgVDbwwMDkhbEjXX6NTuYyWm7GCzMuxiKqmVj")
+	require.NoError(t, err)
+	pieceCid, err := cid.Decode("baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq")
+	require.NoError(t, err)
+	newFilCold := func(remaining int64) (*FilCold, *pieceDealManager) {
+		dm := &pieceDealManager{piece: api.DataCIDSize{PayloadSize: 1000, PieceSize: 2048, PieceCID: pieceCid}}
+		fc := &FilCold{
+			ms:             fixed.New([]fixed.Miner{{Addr: "f01", EpochPrice: 10}, {Addr: "f02", EpochPrice: 10}}),
+			dm:             dm,
+			wm:             &dataCapWallet{remaining: big.NewInt(remaining)},
+			l:              &nopLogger{},
+			lsm:            &lotus.SyncMonitor{},
+			semaphDealPrep: make(chan struct{}, 1),
+			pieces:         newPieceCache(),
+		}
+		fc.initMetrics()
+		return fc, dm
+	}
+	// Regular deals are the default for new ones.
+	cfg := ffs.FilConfig{Addr: "f3client", RepFactor: 2, DealMinDuration: 1000, MinVerifiedReplicas: 1}
+	updates := make(chan deals.StorageDealInfo, 10)
+
+	fc, dm := newFilCold(4096)
+	fs, err := fc.renewDeal(ctx, c, ffs.FilInfo{}, ffs.FilStorage{Miner: "f01", Verified: true}, cfg, time.Second*5, updates)
+	require.NoError(t, err)
+	require.True(t, fs.Verified)
+	require.Len(t, dm.stored, 1)
+	require.True(t, dm.stored[0].VerifiedDeal)
+
+	fs, err = fc.renewDeal(ctx, c, ffs.FilInfo{}, ffs.FilStorage{Miner: "f02"}, cfg, time.Second*5, updates)
+	require.NoError(t, err)
+	require.False(t, fs.Verified)
+	require.Len(t, dm.stored, 2)
+	require.False(t, dm.stored[1].VerifiedDeal)
+
+	// Verified renewals need DataCap.
+	fc, dm = newFilCold(1024)
+	_, err = fc.renewDeal(ctx, c, ffs.FilInfo{}, ffs.FilStorage{Miner: "f01", Verified: true}, cfg, time.Second*5, updates)
+	require.Error(t, err)
+	require.Empty(t, dm.stored)
+}
+
+// pieceDealManager calculates the same piece for any payload, and
+// successfully proposes every deal, which becomes active.
+type pieceDealManager struct {
+	dealManager
+	piece  api.DataCIDSize
+	stored []deals.StorageDealConfig
+	// release, if set, blocks calculations until it's closed.
+	release chan struct{}
+
+	lock       sync.Mutex
+	calculated int
+}
+
+func (dm *pieceDealManager) CalculateDealPiece(context.Context, cid.Cid) (api.DataCIDSize, error) {
+	dm.lock.Lock()
+	dm.calculated++
+	dm.lock.Unlock()
+	if dm.release != nil {
+		<-dm.release
+	}
+	return dm.piece, nil
+}
+
+func (dm *pieceDealManager) calculations() int {
+	dm.lock.Lock()
+	defer dm.lock.Unlock()
+	return dm.calculated
+}
+
+func (dm *pieceDealManager) Store(_ context.Context, _ string, dataCid cid.Cid, dataSize int64, pieceSize abi.PaddedPieceSize, pieceCid cid.Cid, cfgs []deals.StorageDealConfig, _ uint64) ([]deals.StoreResult, error) {
+	if dataSize != dm.piece.PayloadSize || pieceSize != dm.piece.PieceSize || !pieceCid.Equals(dm.piece.PieceCID) {
+		return nil, errors.New("unexpected deal piece")
+	}
+	res := make([]deals.StoreResult, len(cfgs))
+	for i, c := range cfgs {
+		dm.stored = append(dm.stored, c)
+		res[i] = deals.StoreResult{Config: c, ProposalCid: dataCid, Success: true}
+	}
+	return res, nil
+}
+
+func (dm *pieceDealManager) Watch(context.Context, cid.Cid) (<-chan deals.StorageDealInfo, error) {
+	last := dm.stored[len(dm.stored)-1]
+	ch := make(chan deals.StorageDealInfo, 1)
+	ch <- deals.StorageDealInfo{Miner: last.Miner, StateID: storagemarket.StorageDealActive, DealID: 1, Verified: last.VerifiedDeal}
+	return ch, nil
+}
@@ -358,3 +358,33 @@ func TestRemove(t *testing.T) {
 		require.Equal(t, api.ErrNotFound, err)
 	})
 }
+
+func TestPushUnchanged(t *testing.T) {
+	t.Parallel()
+
+	tests.RunFlaky(t, func(t *tests.FlakyT) {
+		ipfs, _, fapi, cls := itmanager.NewAPI(t, 1, 300)
+		defer cls()
+
+		r := rand.New(rand.NewSource(22))
+		c1, _ := it.AddRandomFile(t, r, ipfs)
+		jid, err := fapi.PushStorageConfig(c1)
+		require.NoError(t, err)
+		it.RequireEventualJobState(t, fapi, jid, ffs.Success)
+
+		// Pushing the same data is a no-op returning the existing Job.
+		jid2, err := fapi.PushStorageConfig(c1, api.WithPriorCid(c1))
+		require.NoError(t, err)
+		require.Equal(t, jid, jid2)
+		jobs, _, _, err := fapi.ListStorageJobs(api.ListStorageJobsConfig{CidFilter: c1})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+
+		// Pushing changed data creates a new Job.
+		c2, _ := it.AddRandomFile(t, r, ipfs)
+		jid3, err := fapi.PushStorageConfig(c2, api.WithPriorCid(c1))
+		require.NoError(t, err)
+		require.NotEqual(t, jid, jid3)
+		it.RequireEventualJobState(t, fapi, jid3, ffs.Success)
+	})
+}
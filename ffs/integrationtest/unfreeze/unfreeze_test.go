@@ -103,3 +103,71 @@ func TestUnfreezeRecords(t *testing.T) {
 		require.Greater(t, rr.BytesReceived, uint64(0))
 	})
 }
+
+func TestRehydrate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("FromIPFS", func(t *testing.T) {
+		tests.RunFlaky(t, func(t *tests.FlakyT) {
+			ipfsAPI, _, fapi, cls := itmanager.NewAPI(t, 1, 300)
+			defer cls()
+
+			ra := rand.New(rand.NewSource(22))
+			ctx := context.Background()
+			cid, data := it.AddRandomFile(t, ra, ipfsAPI)
+
+			config := fapi.DefaultStorageConfig().WithHotEnabled(false)
+			jid, err := fapi.PushStorageConfig(cid, api.WithStorageConfig(config))
+			require.NoError(t, err)
+			it.RequireEventualJobState(t, fapi, jid, ffs.Success)
+
+			// The data is still available in the IPFS network, so
+			// it's pinned without a retrieval.
+			jid, err = fapi.Rehydrate(cid)
+			require.NoError(t, err)
+			it.RequireEventualJobState(t, fapi, jid, ffs.Success)
+			expected := config.WithHotEnabled(true).WithHotAllowUnfreeze(true)
+			it.RequireStorageConfig(t, fapi, cid, &expected)
+
+			r, err := fapi.Get(ctx, cid)
+			require.NoError(t, err)
+			fetched, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(data, fetched))
+			recs, err := fapi.RetrievalDealRecords()
+			require.NoError(t, err)
+			require.Empty(t, recs)
+		})
+	})
+
+	t.Run("FromFilecoin", func(t *testing.T) {
+		tests.RunFlaky(t, func(t *tests.FlakyT) {
+			ipfsAPI, _, fapi, cls := itmanager.NewAPI(t, 1, 300)
+			defer cls()
+
+			ra := rand.New(rand.NewSource(22))
+			ctx := context.Background()
+			cid, data := it.AddRandomFile(t, ra, ipfsAPI)
+
+			config := fapi.DefaultStorageConfig().WithHotEnabled(false)
+			jid, err := fapi.PushStorageConfig(cid, api.WithStorageConfig(config))
+			require.NoError(t, err)
+			it.RequireEventualJobState(t, fapi, jid, ffs.Success)
+
+			// The data isn't available in the IPFS network anymore, so
+			// it's retrieved from Filecoin.
+			err = ipfsAPI.Dag().Remove(ctx, cid)
+			require.NoError(t, err)
+			jid, err = fapi.Rehydrate(cid)
+			require.NoError(t, err)
+			it.RequireEventualJobState(t, fapi, jid, ffs.Success)
+
+			r, err := fapi.Get(ctx, cid)
+			require.NoError(t, err)
+			fetched, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(data, fetched))
+			it.RequireRetrievalDealRecord(t, fapi, cid)
+		})
+	})
+}
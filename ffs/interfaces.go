@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/filecoin-project/go-address"
-	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/lotus/api"
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/v2/deals"
@@ -45,6 +44,9 @@ type HotStorage interface {
 	// StageCid pulls Cid data and stage-pin it.
 	StageCid(context.Context, APIID, cid.Cid) error
 
+	// StageFromCarURL streams a CAR file from a URL and stage-pins its root.
+	StageFromCarURL(context.Context, APIID, string) (cid.Cid, error)
+
 	// Unpin unpins a Cid.
 	Unpin(context.Context, APIID, cid.Cid) error
 
@@ -77,8 +79,23 @@ type DealError struct {
 	ProposalCid cid.Cid
 	Miner       string
 	Message     string
+	Reason      DealErrorReason
 }
 
+// DealErrorReason classifies why a deal failed.
+type DealErrorReason int
+
+const (
+	// DealFailed indicates the deal failed after being accepted by
+	// the miner, or the cause is unknown.
+	DealFailed DealErrorReason = iota
+	// DealRejected indicates the miner rejected the deal proposal.
+	DealRejected
+	// DealUnresponsive indicates the miner didn't accept nor reject the
+	// deal proposal within the configured acceptance timeout.
+	DealUnresponsive
+)
+
 // Error returns an stringified message of the
 // underlying error cause.
 func (de DealError) Error() string {
@@ -101,15 +118,19 @@ var (
 // native support for Filecoin storage.
 type ColdStorage interface {
 	// Store stores a Cid using the provided configuration and
-	// account address. It returns a slice of accepted proposed deals,
-	// a slice of rejected proposal deals, and the size of the data.
-	Store(context.Context, cid.Cid, FilConfig) ([]cid.Cid, []DealError, abi.PaddedPieceSize, error)
+	// account address. The piece recorded in the current FilInfo of
+	// the Cid is reused if known. It returns a slice of accepted proposed
+	// deals, a slice of rejected proposal deals, and a FilInfo with the
+	// piece of the data.
+	Store(context.Context, cid.Cid, FilInfo, FilConfig) ([]cid.Cid, []DealError, FilInfo, error)
 
 	// WaitForDeal blocks the provided Deal Proposal reach a
 	// final state. If the deal finishes successfully it returns a FilStorage
 	// result. If the deal finished with error, it returns a ffs.DealError
 	// error result, so it should be considered in error handling.
-	WaitForDeal(context.Context, cid.Cid, cid.Cid, time.Duration, chan deals.StorageDealInfo) (FilStorage, error)
+	// If the acceptance timeout is greater than zero and the miner doesn't
+	// accept the proposal within it, the deal is considered failed.
+	WaitForDeal(context.Context, cid.Cid, cid.Cid, time.Duration, time.Duration, chan deals.StorageDealInfo) (FilStorage, error)
 
 	// Fetch fetches the cid data in the underlying storage.
 	Fetch(context.Context, cid.Cid, *cid.Cid, string, []string, uint64, string) (FetchInfo, error)
@@ -124,6 +145,18 @@ type ColdStorage interface {
 	GetDealInfo(context.Context, uint64) (api.MarketDeal, error)
 }
 
+// RetrievalPrefetcher is implemented by ColdStorages which can prepare
+// retrievals before executing them.
+type RetrievalPrefetcher interface {
+	// PrefetchRetrieval warms up connections with the miners of a
+	// retrieval, and returns which of them can serve it.
+	PrefetchRetrieval(ctx context.Context, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string) ([]deals.RetrievalAvailability, error)
+
+	// FetchPrefetched fetches the cid data from the miners of a
+	// PrefetchRetrieval result, trying them in the provided order.
+	FetchPrefetched(ctx context.Context, payloadCid cid.Cid, waddr string, avs []deals.RetrievalAvailability, maxPrice uint64, selector string) (FetchInfo, error)
+}
+
 // MinerSelector returns miner addresses and ask storage information using a
 // desired strategy.
 type MinerSelector interface {
@@ -132,6 +165,20 @@ type MinerSelector interface {
 	GetMiners(int, MinerSelectorFilter) ([]MinerProposal, error)
 }
 
+// DealSettingsProvider provides the current deal acceptance settings
+// of miners.
+type DealSettingsProvider interface {
+	// MinerDealSettings returns the deal acceptance settings of a miner.
+	MinerDealSettings(ctx context.Context, miner string) (DealSettings, error)
+}
+
+// MinerConnector connects to the hosts of miners.
+type MinerConnector interface {
+	// ConnectMiner returns a non-nil error if the miner host can't be
+	// reached.
+	ConnectMiner(ctx context.Context, miner string) error
+}
+
 // MinerSelectorFilter establishes filters that should be considered when
 // returning miners.
 type MinerSelectorFilter struct {
@@ -153,6 +200,13 @@ type MinerSelectorFilter struct {
 	PieceSize uint64
 	// VerifiedDeal indicates it should take verified storage prices.
 	VerifiedDeal bool
+	// FastRetrieval indicates the deals have fast retrieval.
+	FastRetrieval bool
+	// DealDuration is the duration of the deals in epochs.
+	DealDuration int64
+	// Policy is the acceptance policy of the deal terms. Miners
+	// not satisfying it aren't selected.
+	Policy AcceptancePolicy
 }
 
 // MinerProposal contains a miners address and storage ask information
@@ -0,0 +1,104 @@
+package joblogger
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression is the algorithm used to compress persisted log entries.
+type Compression string
+
+const (
+	// CompressionNone stores log entries as plain JSON.
+	CompressionNone Compression = "none"
+	// CompressionGzip stores log entries compressed with gzip.
+	CompressionGzip Compression = "gzip"
+	// CompressionZstd stores log entries compressed with zstd.
+	CompressionZstd Compression = "zstd"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+	// zstdDecoder is safe for concurrent use with DecodeAll.
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// ParseCompression returns the Compression named by s. An empty string
+// is interpreted as CompressionNone.
+func ParseCompression(s string) (Compression, error) {
+	switch Compression(s) {
+	case "", CompressionNone:
+		return CompressionNone, nil
+	case CompressionGzip, CompressionZstd:
+		return Compression(s), nil
+	default:
+		return "", fmt.Errorf("unknown compression %q", s)
+	}
+}
+
+func compress(c Compression, b []byte) ([]byte, error) {
+	switch c {
+	case "", CompressionNone:
+		return b, nil
+	case CompressionGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(b); err != nil {
+			return nil, fmt.Errorf("writing gzip data: %s", err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, fmt.Errorf("closing gzip writer: %s", err)
+		}
+		return buf.Bytes(), nil
+	case CompressionZstd:
+		enc, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, fmt.Errorf("creating zstd encoder: %s", err)
+		}
+		defer func() {
+			if err := enc.Close(); err != nil {
+				log.Errorf("closing zstd encoder: %s", err)
+			}
+		}()
+		return enc.EncodeAll(b, nil), nil
+	default:
+		return nil, fmt.Errorf("unknown compression %q", c)
+	}
+}
+
+// decompress returns the original bytes of a stored log entry. The
+// algorithm is detected from the data itself, so entries written with
+// any Compression, including plain JSON ones, can be read back.
+func decompress(b []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(b, gzipMagic):
+		r, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, fmt.Errorf("creating gzip reader: %s", err)
+		}
+		defer func() {
+			if err := r.Close(); err != nil {
+				log.Errorf("closing gzip reader: %s", err)
+			}
+		}()
+		d, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading gzip data: %s", err)
+		}
+		return d, nil
+	case bytes.HasPrefix(b, zstdMagic):
+		d, err := zstdDecoder.DecodeAll(b, nil)
+		if err != nil {
+			return nil, fmt.Errorf("decoding zstd data: %s", err)
+		}
+		return d, nil
+	default:
+		return b, nil
+	}
+}
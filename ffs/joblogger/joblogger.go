@@ -23,7 +23,8 @@ var (
 
 // Logger is a datastore backed implementation of ffs.Logger.
 type Logger struct {
-	ds datastore.Datastore
+	ds          datastore.Datastore
+	compression Compression
 
 	lock     sync.Mutex
 	watchers []chan<- ffs.LogEntry
@@ -40,11 +41,28 @@ type logEntry struct {
 
 var _ ffs.JobLogger = (*Logger)(nil)
 
+// Option configures a Logger.
+type Option func(*Logger)
+
+// WithCompression sets the compression applied to new persisted log
+// entries. Entries are read back transparently regardless of how they
+// were stored.
+func WithCompression(c Compression) Option {
+	return func(l *Logger) {
+		l.compression = c
+	}
+}
+
 // New returns a new CidLogger.
-func New(ds datastore.Datastore) *Logger {
-	return &Logger{
-		ds: ds,
+func New(ds datastore.Datastore, opts ...Option) *Logger {
+	l := &Logger{
+		ds:          ds,
+		compression: CompressionNone,
+	}
+	for _, opt := range opts {
+		opt(l)
 	}
+	return l
 }
 
 // Log logs a log entry for a Cid. The ctx can contain an optional ffs.CtxKeyJid to add
@@ -73,6 +91,11 @@ func (cl *Logger) Log(ctx context.Context, format string, a ...interface{}) {
 		log.Errorf("marshaling to json: %s", err)
 		return
 	}
+	b, err = compress(cl.compression, b)
+	if err != nil {
+		log.Errorf("compressing log entry: %s", err)
+		return
+	}
 	if err := cl.ds.Put(key, b); err != nil {
 		log.Error("saving to datastore: %s", err)
 		return
@@ -99,6 +122,30 @@ func (cl *Logger) Log(ctx context.Context, format string, a ...interface{}) {
 // GetByCid returns history logs for a Cid.
 func (cl *Logger) GetByCid(ctx context.Context, iid ffs.APIID, c cid.Cid) ([]ffs.LogEntry, error) {
 	q := query.Query{Prefix: makeStorageCidKey(iid, c).String()}
+	lgs, err := cl.query(q)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(lgs, func(a, b int) bool {
+		return lgs[a].Timestamp.Before(lgs[b].Timestamp)
+	})
+	return lgs, nil
+}
+
+// GetByCidPage returns at most limit history logs for a Cid, skipping
+// the first offset ones. Logs are sorted by timestamp, so consecutive
+// pages can be concatenated to get the full history.
+func (cl *Logger) GetByCidPage(ctx context.Context, iid ffs.APIID, c cid.Cid, offset, limit int) ([]ffs.LogEntry, error) {
+	q := query.Query{
+		Prefix: makeStorageCidKey(iid, c).String(),
+		Orders: []query.Order{query.OrderByKey{}},
+		Offset: offset,
+		Limit:  limit,
+	}
+	return cl.query(q)
+}
+
+func (cl *Logger) query(q query.Query) ([]ffs.LogEntry, error) {
 	res, err := cl.ds.Query(q)
 	if err != nil {
 		return nil, fmt.Errorf("running query: %s", err)
@@ -113,8 +160,12 @@ func (cl *Logger) GetByCid(ctx context.Context, iid ffs.APIID, c cid.Cid) ([]ffs
 		if r.Error != nil {
 			return nil, fmt.Errorf("iter next: %s", r.Error)
 		}
+		b, err := decompress(r.Value)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing log entry: %s", err)
+		}
 		var le logEntry
-		if err := json.Unmarshal(r.Value, &le); err != nil {
+		if err := json.Unmarshal(b, &le); err != nil {
 			return nil, fmt.Errorf("unmarshaling log entry: %s", err)
 		}
 		lgs = append(lgs, ffs.LogEntry{
@@ -124,9 +175,6 @@ func (cl *Logger) GetByCid(ctx context.Context, iid ffs.APIID, c cid.Cid) ([]ffs
 			Timestamp: time.Unix(0, le.Timestamp),
 		})
 	}
-	sort.Slice(lgs, func(a, b int) bool {
-		return lgs[a].Timestamp.Before(lgs[b].Timestamp)
-	})
 	return lgs, nil
 }
 
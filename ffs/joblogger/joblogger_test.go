@@ -0,0 +1,111 @@
+package joblogger
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/tests"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestCompressionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`{"Msg":"` + strings.Repeat("deal proposal accepted ", 50) + `"}`)
+	for _, c := range []Compression{CompressionNone, CompressionGzip, CompressionZstd} {
+		c := c
+		t.Run(string(c), func(t *testing.T) {
+			t.Parallel()
+			b, err := compress(c, data)
+			require.NoError(t, err)
+			if c != CompressionNone {
+				require.Less(t, len(b), len(data))
+			}
+			d, err := decompress(b)
+			require.NoError(t, err)
+			require.Equal(t, data, d)
+		})
+	}
+}
+
+func TestLogCompressed(t *testing.T) {
+	t.Parallel()
+
+	iid := ffs.NewAPIID()
+	c, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	ctx := context.WithValue(context.Background(), ffs.CtxAPIID, iid)
+	ctx = context.WithValue(ctx, ffs.CtxStorageCid, c)
+
+	ds := tests.NewTxMapDatastore()
+	plain := New(ds)
+	plain.Log(ctx, "plain entry %d", 1)
+	for _, comp := range []Compression{CompressionGzip, CompressionZstd} {
+		l := New(ds, WithCompression(comp))
+		l.Log(ctx, "%s entry", comp)
+	}
+
+	// Stored values other than the plain one aren't JSON.
+	var compressed int
+	res, err := ds.Query(query.Query{Prefix: datastore.NewKey(iid.String()).String()})
+	require.NoError(t, err)
+	all, err := res.Rest()
+	require.NoError(t, err)
+	for _, r := range all {
+		if r.Value[0] != '{' {
+			compressed++
+		}
+	}
+	require.Equal(t, 2, compressed)
+
+	// Any Logger reads back all entries regardless of compression.
+	lgs, err := plain.GetByCid(ctx, iid, c)
+	require.NoError(t, err)
+	require.Len(t, lgs, 3)
+	require.Equal(t, "plain entry 1", lgs[0].Msg)
+	require.Equal(t, "gzip entry", lgs[1].Msg)
+	require.Equal(t, "zstd entry", lgs[2].Msg)
+	for _, l := range lgs {
+		require.Equal(t, c, l.Cid)
+	}
+}
+
+func TestGetByCidPage(t *testing.T) {
+	t.Parallel()
+
+	iid := ffs.NewAPIID()
+	c, _ := util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	ctx := context.WithValue(context.Background(), ffs.CtxAPIID, iid)
+	ctx = context.WithValue(ctx, ffs.CtxStorageCid, c)
+
+	l := New(tests.NewTxMapDatastore(), WithCompression(CompressionGzip))
+	for i := 0; i < 25; i++ {
+		l.Log(ctx, "entry %d", i)
+		time.Sleep(time.Millisecond)
+	}
+
+	all, err := l.GetByCid(ctx, iid, c)
+	require.NoError(t, err)
+	require.Len(t, all, 25)
+
+	var paged []ffs.LogEntry
+	for offset := 0; ; offset += 7 {
+		page, err := l.GetByCidPage(ctx, iid, c, offset, 7)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page), 7)
+		paged = append(paged, page...)
+		if len(page) < 7 {
+			break
+		}
+	}
+	require.Equal(t, all, paged)
+	for i, le := range paged {
+		require.Equal(t, fmt.Sprintf("entry %d", i), le.Msg)
+	}
+}
@@ -0,0 +1,55 @@
+package ffs
+
+import (
+	"fmt"
+
+	"github.com/textileio/powergate/v2/util"
+)
+
+// Warning is a non-fatal advisory about a StorageConfig setting
+// that is valid but probably suboptimal.
+type Warning struct {
+	// Field is the dotted path of the field, e.g: Cold.Filecoin.RepFactor.
+	Field string
+	// Message describes the concern and what could be done about it.
+	Message string
+}
+
+// String returns a string representation of the Warning.
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s", w.Field, w.Message)
+}
+
+// LintConfig returns advisories about suboptimal settings of a
+// StorageConfig. It doesn't validate the config, so it's expected to be
+// called with configs that already passed validation.
+func LintConfig(cfg StorageConfig) []Warning {
+	var ws []Warning
+	if !cfg.Hot.Enabled && !cfg.Cold.Enabled {
+		ws = append(ws, Warning{Field: "Hot.Enabled", Message: "hot and cold storage are disabled, data won't be stored anywhere"})
+	}
+	if cfg.Hot.Enabled && !cfg.Hot.AllowUnfreeze && cfg.Cold.Enabled {
+		ws = append(ws, Warning{Field: "Hot.AllowUnfreeze", Message: "data can't be recovered from Filecoin if it's lost from hot storage"})
+	}
+	if !cfg.Cold.Enabled {
+		return ws
+	}
+
+	fc := cfg.Cold.Filecoin
+	if fc.RepFactor == 1 {
+		ws = append(ws, Warning{Field: "Cold.Filecoin.RepFactor", Message: "a single replica gives no redundancy if the miner fails"})
+	}
+	if !cfg.Hot.Enabled && !fc.FastRetrieval {
+		ws = append(ws, Warning{Field: "Cold.Filecoin.FastRetrieval", Message: "data is only in cold storage and fast retrieval is disabled, retrievals will be slow"})
+	}
+	if !fc.Renew.Enabled {
+		ws = append(ws, Warning{Field: "Cold.Filecoin.Renew.Enabled", Message: "deals won't be renewed and data will be lost when they expire"})
+	}
+	if fc.MaxPrice == 0 {
+		ws = append(ws, Warning{Field: "Cold.Filecoin.MaxPrice", Message: "no max price set, deals can be made at any price"})
+	}
+	if fc.DealStartOffset > 0 && fc.DealStartOffset < util.MinDealStartOffset {
+		ws = append(ws, Warning{Field: "Cold.Filecoin.DealStartOffset", Message: fmt.Sprintf("deal start offset lower than %d epochs is rejected unless the minimum of Powergate is lowered", util.MinDealStartOffset)})
+	}
+	return ws
+}
@@ -0,0 +1,54 @@
+package ffs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		mutate func(c *StorageConfig)
+		fields []string
+	}{
+		{"optimal", func(c *StorageConfig) {}, nil},
+		{"single replica", func(c *StorageConfig) { c.Cold.Filecoin.RepFactor = 1 }, []string{"Cold.Filecoin.RepFactor"}},
+		{"cold only without fast retrieval", func(c *StorageConfig) {
+			c.Hot.Enabled = false
+			c.Hot.AllowUnfreeze = false
+			c.Cold.Filecoin.Renew.Enabled = true
+			c.Cold.Filecoin.FastRetrieval = false
+		}, []string{"Cold.Filecoin.FastRetrieval"}},
+		{"no unfreeze", func(c *StorageConfig) { c.Hot.AllowUnfreeze = false }, []string{"Hot.AllowUnfreeze"}},
+		{"no renewals", func(c *StorageConfig) { c.Cold.Filecoin.Renew.Enabled = false }, []string{"Cold.Filecoin.Renew.Enabled"}},
+		{"no max price", func(c *StorageConfig) { c.Cold.Filecoin.MaxPrice = 0 }, []string{"Cold.Filecoin.MaxPrice"}},
+		{"short start offset", func(c *StorageConfig) { c.Cold.Filecoin.DealStartOffset = 10 }, []string{"Cold.Filecoin.DealStartOffset"}},
+		{"nothing stored", func(c *StorageConfig) {
+			c.Hot.Enabled = false
+			c.Cold.Enabled = false
+		}, []string{"Hot.Enabled"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := validStorageConfig()
+			cfg.Hot.AllowUnfreeze = true
+			cfg.Cold.Filecoin.RepFactor = 2
+			cfg.Cold.Filecoin.FastRetrieval = true
+			cfg.Cold.Filecoin.MaxPrice = 1000
+			cfg.Cold.Filecoin.Renew = FilRenew{Enabled: true, Threshold: 100}
+			tt.mutate(&cfg)
+
+			ws := LintConfig(cfg)
+			var fields []string
+			for _, w := range ws {
+				fields = append(fields, w.Field)
+			}
+			require.Equal(t, tt.fields, fields)
+		})
+	}
+}
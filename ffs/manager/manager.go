@@ -92,7 +92,7 @@ func New(ds datastore.TxnDatastore, wm ffs.WalletManager, drm ffs.DealRecordsMan
 	if err != nil {
 		return nil, fmt.Errorf("loading default storage config: %s", err)
 	}
-	return &Manager{
+	m := &Manager{
 		auth:             auth.New(txndstr.Wrap(ds, "auth")),
 		ds:               ds,
 		wm:               wm,
@@ -101,7 +101,9 @@ func New(ds datastore.TxnDatastore, wm ffs.WalletManager, drm ffs.DealRecordsMan
 		instances:        make(map[ffs.APIID]*api.API),
 		defaultConfig:    storageConfig,
 		ffsUseMasterAddr: ffsUseMasterAddr,
-	}, nil
+	}
+	sched.SetScheduledPushExecutor(m)
+	return m, nil
 }
 
 // Create creates a new Api instance and an auth-token mapped to it.
@@ -180,6 +182,65 @@ func (m *Manager) List() ([]ffs.AuthEntry, error) {
 	return res, nil
 }
 
+// InstanceSummary summarizes the state of an API instance.
+type InstanceSummary struct {
+	// ID is the instance id.
+	ID ffs.APIID
+	// DataUnderManagement is the total size in bytes of the data stored
+	// by the instance, considering the biggest of hot and cold storage sizes.
+	DataUnderManagement uint64
+	// ActiveDeals is the number of known Filecoin deals storing
+	// the instance data.
+	ActiveDeals int
+	// FailedCids is the number of Cids whose latest Job failed.
+	FailedCids int
+	// Healthy is true if no Cid of the instance has a failed latest Job.
+	Healthy bool
+}
+
+// ListInstances returns a summary of every existing API instance. The
+// storage information of all instances is gathered in a single pass.
+func (m *Manager) ListInstances() ([]InstanceSummary, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	entries, err := m.auth.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing existing instances: %s", err)
+	}
+	infos, err := m.sched.ListStorageInfo(nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing storage information: %s", err)
+	}
+	return summarizeInstances(entries, infos, m.sched.FailedStorageCounts()), nil
+}
+
+func summarizeInstances(entries []ffs.AuthEntry, infos []ffs.StorageInfo, failed map[ffs.APIID]int) []InstanceSummary {
+	idx := make(map[ffs.APIID]int, len(entries))
+	res := make([]InstanceSummary, len(entries))
+	for i, e := range entries {
+		idx[e.APIID] = i
+		res[i] = InstanceSummary{
+			ID:         e.APIID,
+			FailedCids: failed[e.APIID],
+			Healthy:    failed[e.APIID] == 0,
+		}
+	}
+	for _, si := range infos {
+		i, ok := idx[si.APIID]
+		if !ok {
+			continue
+		}
+		size := uint64(si.Hot.Size)
+		if si.Cold.Filecoin.Size > size {
+			size = si.Cold.Filecoin.Size
+		}
+		res[i].DataUnderManagement += size
+		res[i].ActiveDeals += len(si.Cold.Filecoin.Proposals)
+	}
+	return res
+}
+
 // RegenerateAuthToken invalidates the provided token replacing it with a new one.
 func (m *Manager) RegenerateAuthToken(token string) (string, error) {
 	m.lock.Lock()
@@ -204,9 +265,27 @@ func (m *Manager) GetByAuthToken(token string) (*api.API, error) {
 		return nil, ErrAuthTokenNotFound
 	}
 
+	return m.getInstance(iid)
+}
+
+// ExecuteScheduledPush executes a due scheduled push in its instance.
+func (m *Manager) ExecuteScheduledPush(sp ffs.ScheduledPush) (ffs.JobID, error) {
+	m.lock.Lock()
+	i, err := m.getInstance(sp.APIID)
+	m.lock.Unlock()
+	if err != nil {
+		return ffs.EmptyJobID, err
+	}
+	return i.ExecuteScheduledPush(sp)
+}
+
+// getInstance returns the instance iid, loading it if it isn't cached.
+// It should be called with m.lock held.
+func (m *Manager) getInstance(iid ffs.APIID) (*api.API, error) {
 	i, ok := m.instances[iid]
 	if !ok {
 		log.Debugf("loading uncached instance %s", iid)
+		var err error
 		i, err = api.Load(namespace.Wrap(m.ds, datastore.NewKey("api/"+iid.String())), iid, m.sched, m.wm, m.drm)
 		if err != nil {
 			return nil, fmt.Errorf("loading instance %s: %s", iid, err)
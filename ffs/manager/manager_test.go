@@ -12,6 +12,7 @@ import (
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/stretchr/testify/require"
 	dealsModule "github.com/textileio/powergate/v2/deals/module"
+	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/lotus"
 	"github.com/textileio/powergate/v2/tests"
 	txndstr "github.com/textileio/powergate/v2/txndstransform"
@@ -127,6 +128,26 @@ func TestList(t *testing.T) {
 	require.Equal(t, 2, len(lst))
 }
 
+func TestSummarizeInstances(t *testing.T) {
+	t.Parallel()
+	entries := []ffs.AuthEntry{{APIID: "iid1"}, {APIID: "iid2"}, {APIID: "iid3"}}
+	infos := []ffs.StorageInfo{
+		{APIID: "iid1", Hot: ffs.HotInfo{Size: 100}, Cold: ffs.ColdInfo{Filecoin: ffs.FilInfo{Size: 128, Proposals: make([]ffs.FilStorage, 2)}}},
+		{APIID: "iid1", Hot: ffs.HotInfo{Size: 50}},
+		{APIID: "iid2", Cold: ffs.ColdInfo{Filecoin: ffs.FilInfo{Size: 256, Proposals: make([]ffs.FilStorage, 1)}}},
+		// Data from unknown instances is ignored.
+		{APIID: "iid4", Hot: ffs.HotInfo{Size: 10}},
+	}
+	failed := map[ffs.APIID]int{"iid2": 1}
+
+	res := summarizeInstances(entries, infos, failed)
+	require.Equal(t, []InstanceSummary{
+		{ID: "iid1", DataUnderManagement: 178, ActiveDeals: 2, Healthy: true},
+		{ID: "iid2", DataUnderManagement: 256, ActiveDeals: 1, FailedCids: 1, Healthy: false},
+		{ID: "iid3", Healthy: true},
+	}, res)
+}
+
 func TestGetByAuthToken(t *testing.T) {
 	t.Parallel()
 	ds := tests.NewTxMapDatastore()
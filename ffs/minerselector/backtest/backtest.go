@@ -0,0 +1,206 @@
+package backtest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/index/ask"
+)
+
+// Policy is a miner selection strategy evaluated over a historical
+// ask index snapshot instead of live miner data.
+type Policy interface {
+	// Select returns n miners to make deals with, from the asks
+	// available in idx, satisfying the filter.
+	Select(idx ask.Index, n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error)
+}
+
+// PolicyFunc is an adapter to use a function as a Policy.
+type PolicyFunc func(idx ask.Index, n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error)
+
+// Select implements Policy.
+func (pf PolicyFunc) Select(idx ask.Index, n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error) {
+	return pf(idx, n, f)
+}
+
+// SelectorPolicy is a Policy which runs a miner selector against each
+// snapshot. It builds the selector for a snapshot with ds, which provides
+// the deal settings of miners from the snapshot asks, e.g. configuring
+// the SR2 or RepTop selectors with their WithDealSettings options.
+type SelectorPolicy func(ds ffs.DealSettingsProvider) (ffs.MinerSelector, error)
+
+// Select implements Policy.
+func (sp SelectorPolicy) Select(idx ask.Index, n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error) {
+	ms, err := sp(snapshotSettings(idx))
+	if err != nil {
+		return nil, fmt.Errorf("creating miner selector: %s", err)
+	}
+	return ms.GetMiners(n, f)
+}
+
+// snapshotSettings provides the deal settings of miners from the asks of
+// an ask index snapshot. Miners without an ask in the snapshot are
+// unavailable.
+type snapshotSettings ask.Index
+
+var _ ffs.DealSettingsProvider = snapshotSettings{}
+
+// MinerDealSettings implements ffs.DealSettingsProvider.
+func (ss snapshotSettings) MinerDealSettings(_ context.Context, miner string) (ffs.DealSettings, error) {
+	a, ok := ss.Storage[miner]
+	if !ok {
+		return ffs.DealSettings{}, fmt.Errorf("miner %s has no ask in the snapshot", miner)
+	}
+	return ffs.DealSettings{
+		Accepting:     true,
+		Price:         a.Price,
+		VerifiedPrice: a.VerifiedPrice,
+		MinPieceSize:  a.MinPieceSize,
+		MaxPieceSize:  a.MaxPieceSize,
+	}, nil
+}
+
+// Config describes the deals made on each replayed snapshot.
+type Config struct {
+	// RepFactor is the number of deals made per snapshot.
+	RepFactor int
+	// DealDuration is the duration of deals in epochs.
+	DealDuration int64
+	// Filter is the filter passed to the Policy. Its PieceSize is the
+	// size of the data stored in each deal.
+	Filter ffs.MinerSelectorFilter
+}
+
+// Round is the result of replaying a snapshot through a Policy.
+type Round struct {
+	// Time is the time the snapshot was taken.
+	Time time.Time
+	// Deals are the miners selected by the Policy.
+	Deals []ffs.MinerProposal
+	// Cost is the total cost in attoFIL of the deals.
+	Cost big.Int
+	// Error is the reason the Policy couldn't select miners, if any.
+	Error string
+}
+
+// Report summarizes the deals a Policy would have produced
+// over an ask history.
+type Report struct {
+	Rounds []Round
+	// TotalDeals is the number of deals made in all rounds.
+	TotalDeals int
+	// FailedRounds is the number of rounds where the Policy
+	// couldn't select miners.
+	FailedRounds int
+	// TotalCost is the total cost in attoFIL of all deals.
+	TotalCost big.Int
+	// DealsByMiner is the number of deals made with each miner.
+	DealsByMiner map[string]int
+}
+
+// Run replays the snapshots in chronological order through the Policy,
+// and reports the deals and cost it would have produced.
+func Run(snapshots []ask.Index, p Policy, cfg Config) (Report, error) {
+	if cfg.RepFactor <= 0 {
+		return Report{}, fmt.Errorf("replication factor should be greater than zero")
+	}
+	if cfg.DealDuration <= 0 {
+		return Report{}, fmt.Errorf("deal duration should be greater than zero")
+	}
+	if cfg.Filter.PieceSize == 0 {
+		return Report{}, fmt.Errorf("piece size should be greater than zero")
+	}
+
+	snaps := make([]ask.Index, len(snapshots))
+	copy(snaps, snapshots)
+	sort.SliceStable(snaps, func(i, j int) bool {
+		return snaps[i].LastUpdated.Before(snaps[j].LastUpdated)
+	})
+
+	r := Report{
+		Rounds:       make([]Round, 0, len(snaps)),
+		TotalCost:    big.Zero(),
+		DealsByMiner: map[string]int{},
+	}
+	for _, idx := range snaps {
+		round := Round{Time: idx.LastUpdated, Cost: big.Zero()}
+		mps, err := p.Select(idx, cfg.RepFactor, cfg.Filter)
+		if err == nil && len(mps) != cfg.RepFactor {
+			err = fmt.Errorf("policy returned %d miners, expected %d", len(mps), cfg.RepFactor)
+		}
+		if err != nil {
+			round.Error = err.Error()
+			r.FailedRounds++
+			r.Rounds = append(r.Rounds, round)
+			continue
+		}
+		round.Deals = mps
+		for _, mp := range mps {
+			round.Cost = big.Add(round.Cost, ffs.AskDealCost(mp.EpochPrice, cfg.Filter.PieceSize, cfg.DealDuration))
+			r.DealsByMiner[mp.Addr]++
+		}
+		r.TotalDeals += len(mps)
+		r.TotalCost = big.Add(r.TotalCost, round.Cost)
+		r.Rounds = append(r.Rounds, round)
+	}
+	return r, nil
+}
+
+// Cheapest is a Policy that selects trusted miners first, and then the
+// cheapest asks satisfying the filter. Country codes can't be evaluated
+// from asks, so they're ignored.
+var Cheapest Policy = PolicyFunc(cheapest)
+
+func cheapest(idx ask.Index, n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error) {
+	excluded := make(map[string]struct{}, len(f.ExcludedMiners))
+	for _, m := range f.ExcludedMiners {
+		excluded[m] = struct{}{}
+	}
+	trusted := make(map[string]int, len(f.TrustedMiners))
+	for i, m := range f.TrustedMiners {
+		trusted[m] = i
+	}
+
+	var candidates []ffs.MinerProposal
+	for _, sa := range idx.Storage {
+		if _, ok := excluded[sa.Miner]; ok {
+			continue
+		}
+		price := sa.Price
+		if f.VerifiedDeal {
+			price = sa.VerifiedPrice
+		}
+		if f.MaxPrice > 0 && price > f.MaxPrice {
+			continue
+		}
+		if f.PieceSize < sa.MinPieceSize || f.PieceSize > sa.MaxPieceSize {
+			continue
+		}
+		if err := f.Accepts(price, sa.MinPieceSize, sa.MaxPieceSize); err != nil {
+			continue
+		}
+		candidates = append(candidates, ffs.MinerProposal{Addr: sa.Miner, EpochPrice: price})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		ti, iok := trusted[candidates[i].Addr]
+		tj, jok := trusted[candidates[j].Addr]
+		if iok != jok {
+			return iok
+		}
+		if iok {
+			return ti < tj
+		}
+		if candidates[i].EpochPrice != candidates[j].EpochPrice {
+			return candidates[i].EpochPrice < candidates[j].EpochPrice
+		}
+		return candidates[i].Addr < candidates[j].Addr
+	})
+	if len(candidates) < n {
+		return nil, fmt.Errorf("not enough miners satisfy the constraints: %d<%d", len(candidates), n)
+	}
+	return candidates[:n], nil
+}
@@ -0,0 +1,165 @@
+package backtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/minerselector/sr2"
+	"github.com/textileio/powergate/v2/index/ask"
+)
+
+const gib = 1 << 30
+
+// history is a fixture of ask snapshots, not sorted by time.
+var history = []ask.Index{
+	snapshot(2, map[string]uint64{"f01": 300, "f02": 100, "f03": 200}),
+	snapshot(0, map[string]uint64{"f01": 100, "f02": 200, "f03": 300}),
+	snapshot(1, map[string]uint64{"f01": 150, "f03": 50}),
+}
+
+func TestRunCheapest(t *testing.T) {
+	t.Parallel()
+	cfg := Config{
+		RepFactor:    2,
+		DealDuration: 1000,
+		Filter:       ffs.MinerSelectorFilter{PieceSize: 2 * gib},
+	}
+	r, err := Run(history, Cheapest, cfg)
+	require.NoError(t, err)
+
+	require.Len(t, r.Rounds, 3)
+	requireRound(t, r.Rounds[0], 0, []string{"f01", "f02"}, (100+200)*2*1000)
+	requireRound(t, r.Rounds[1], 1, []string{"f03", "f01"}, (50+150)*2*1000)
+	requireRound(t, r.Rounds[2], 2, []string{"f02", "f03"}, (100+200)*2*1000)
+
+	require.Equal(t, 6, r.TotalDeals)
+	require.Equal(t, 0, r.FailedRounds)
+	require.Equal(t, big.NewInt(800*2*1000).String(), r.TotalCost.String())
+	require.Equal(t, map[string]int{"f01": 2, "f02": 2, "f03": 2}, r.DealsByMiner)
+}
+
+func TestRunFilters(t *testing.T) {
+	t.Parallel()
+	cfg := Config{
+		RepFactor:    2,
+		DealDuration: 1000,
+		Filter: ffs.MinerSelectorFilter{
+			PieceSize:      gib,
+			MaxPrice:       250,
+			ExcludedMiners: []string{"f02"},
+			TrustedMiners:  []string{"f03"},
+		},
+	}
+	r, err := Run(history, Cheapest, cfg)
+	require.NoError(t, err)
+
+	// Only f01 and f03 are eligible, and f01 is too expensive in the last round.
+	require.Len(t, r.Rounds, 3)
+	requireRound(t, r.Rounds[0], 0, nil, 0)
+	requireRound(t, r.Rounds[1], 1, []string{"f03", "f01"}, (50+150)*1000)
+	requireRound(t, r.Rounds[2], 2, nil, 0)
+	require.Equal(t, 2, r.FailedRounds)
+	require.Equal(t, 2, r.TotalDeals)
+	require.Equal(t, big.NewInt(200*1000).String(), r.TotalCost.String())
+}
+
+func TestRunCustomPolicy(t *testing.T) {
+	t.Parallel()
+	var seen []time.Time
+	p := PolicyFunc(func(idx ask.Index, n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error) {
+		seen = append(seen, idx.LastUpdated)
+		if len(idx.Storage) < 3 {
+			return nil, fmt.Errorf("not enough asks")
+		}
+		// Returns less miners than asked.
+		return []ffs.MinerProposal{{Addr: "f01", EpochPrice: idx.Storage["f01"].Price}}, nil
+	})
+	cfg := Config{RepFactor: 1, DealDuration: 10, Filter: ffs.MinerSelectorFilter{PieceSize: gib}}
+	r, err := Run(history, p, cfg)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{at(0), at(1), at(2)}, seen)
+	require.Equal(t, 1, r.FailedRounds)
+	require.Equal(t, "not enough asks", r.Rounds[1].Error)
+	require.Equal(t, big.NewInt((100+300)*10).String(), r.TotalCost.String())
+
+	cfg.RepFactor = 2
+	r, err = Run(history, p, cfg)
+	require.NoError(t, err)
+	require.Equal(t, 3, r.FailedRounds)
+
+	_, err = Run(history, p, Config{RepFactor: 1, DealDuration: 10})
+	require.Error(t, err)
+}
+
+func TestRunSelector(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"Buckets": []map[string]interface{}{
+				{"Amount": 1, "MinerAddresses": []string{"f01"}},
+				{"Amount": 1, "MinerAddresses": []string{"f02"}},
+			},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	p := SelectorPolicy(func(ds ffs.DealSettingsProvider) (ffs.MinerSelector, error) {
+		return sr2.New(srv.URL, nil, sr2.WithDealSettings(ds))
+	})
+	cfg := Config{
+		RepFactor:    2,
+		DealDuration: 1000,
+		Filter:       ffs.MinerSelectorFilter{PieceSize: 2 * gib},
+	}
+	r, err := Run(history, p, cfg)
+	require.NoError(t, err)
+
+	// f02 has no ask in the second snapshot.
+	require.Len(t, r.Rounds, 3)
+	requireRound(t, r.Rounds[0], 0, []string{"f01", "f02"}, (100+200)*2*1000)
+	requireRound(t, r.Rounds[1], 1, nil, 0)
+	requireRound(t, r.Rounds[2], 2, []string{"f01", "f02"}, (300+100)*2*1000)
+	require.Equal(t, 1, r.FailedRounds)
+	require.Equal(t, map[string]int{"f01": 2, "f02": 2}, r.DealsByMiner)
+}
+
+func requireRound(t *testing.T, r Round, i int, miners []string, cost int64) {
+	t.Helper()
+	require.Equal(t, at(i), r.Time)
+	if miners == nil {
+		require.NotEmpty(t, r.Error)
+		require.Empty(t, r.Deals)
+		return
+	}
+	require.Empty(t, r.Error)
+	require.Len(t, r.Deals, len(miners))
+	for j, m := range miners {
+		require.Equal(t, m, r.Deals[j].Addr)
+	}
+	require.Equal(t, big.NewInt(cost).String(), r.Cost.String())
+}
+
+func at(i int) time.Time {
+	return time.Unix(1600000000, 0).Add(time.Duration(i) * time.Hour).UTC()
+}
+
+func snapshot(i int, prices map[string]uint64) ask.Index {
+	idx := ask.Index{LastUpdated: at(i), Storage: map[string]ask.StorageAsk{}}
+	for m, p := range prices {
+		idx.Storage[m] = ask.StorageAsk{
+			Miner:         m,
+			Price:         p,
+			VerifiedPrice: p / 10,
+			MinPieceSize:  256,
+			MaxPieceSize:  32 * gib,
+		}
+	}
+	return idx
+}
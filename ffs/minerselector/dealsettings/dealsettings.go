@@ -0,0 +1,187 @@
+package dealsettings
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/filecoin-project/lotus/chain/actors/policy"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/lotus"
+)
+
+const (
+	// DefaultTTL is the default duration queried settings are cached.
+	DefaultTTL = time.Minute
+
+	queryTimeout = time.Second * 20
+)
+
+// Cache queries the deal acceptance settings of miners, and caches
+// them briefly so consecutive selections don't query miners again.
+type Cache struct {
+	cb    lotus.ClientBuilder
+	ttl   time.Duration
+	query func(ctx context.Context, miner string) (ffs.DealSettings, error)
+
+	lock    sync.Mutex
+	entries map[string]entry
+}
+
+type entry struct {
+	settings ffs.DealSettings
+	expires  time.Time
+}
+
+var (
+	_ ffs.DealSettingsProvider = (*Cache)(nil)
+	_ ffs.MinerConnector       = (*Cache)(nil)
+)
+
+// New returns a new Cache querying miners with the provided Lotus
+// client, and caching results for ttl.
+func New(cb lotus.ClientBuilder, ttl time.Duration) *Cache {
+	c := newCache(ttl, func(ctx context.Context, miner string) (ffs.DealSettings, error) {
+		return queryMiner(ctx, cb, miner)
+	})
+	c.cb = cb
+	return c
+}
+
+func newCache(ttl time.Duration, query func(context.Context, string) (ffs.DealSettings, error)) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		query:   query,
+		entries: make(map[string]entry),
+	}
+}
+
+// MinerDealSettings returns the deal acceptance settings of a miner.
+// Failed queries aren't cached.
+func (c *Cache) MinerDealSettings(ctx context.Context, miner string) (ffs.DealSettings, error) {
+	c.lock.Lock()
+	e, ok := c.entries[miner]
+	c.lock.Unlock()
+	if ok && time.Now().Before(e.expires) {
+		return e.settings, nil
+	}
+
+	s, err := c.query(ctx, miner)
+	if err != nil {
+		return ffs.DealSettings{}, fmt.Errorf("querying miner %s deal settings: %s", miner, err)
+	}
+
+	c.lock.Lock()
+	c.entries[miner] = entry{settings: s, expires: time.Now().Add(c.ttl)}
+	c.lock.Unlock()
+
+	return s, nil
+}
+
+// ConnectMiner connects the Lotus node to the miner host. Connections
+// aren't cached.
+func (c *Cache) ConnectMiner(ctx context.Context, miner string) error {
+	client, cls, err := c.cb(ctx)
+	if err != nil {
+		return fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+
+	addr, err := address.NewFromString(miner)
+	if err != nil {
+		return fmt.Errorf("miner address is invalid: %s", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	mi, err := client.StateMinerInfo(ctx, addr, types.EmptyTSK)
+	if err != nil {
+		return fmt.Errorf("getting miner %s info: %s", addr, err)
+	}
+	if mi.PeerId == nil {
+		return fmt.Errorf("the miner %s doesn't specify a peer id", addr)
+	}
+	ai := peer.AddrInfo{ID: *mi.PeerId}
+	for _, b := range mi.Multiaddrs {
+		maddr, err := multiaddr.NewMultiaddrBytes(b)
+		if err != nil {
+			continue
+		}
+		ai.Addrs = append(ai.Addrs, maddr)
+	}
+	if err := client.NetConnect(ctx, ai); err != nil {
+		return fmt.Errorf("connecting to miner %s: %s", addr, err)
+	}
+	return nil
+}
+
+func queryMiner(ctx context.Context, cb lotus.ClientBuilder, miner string) (ffs.DealSettings, error) {
+	c, cls, err := cb(ctx)
+	if err != nil {
+		return ffs.DealSettings{}, fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+
+	addr, err := address.NewFromString(miner)
+	if err != nil {
+		return ffs.DealSettings{}, fmt.Errorf("miner address is invalid: %s", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	mi, err := c.StateMinerInfo(ctx, addr, types.EmptyTSK)
+	if err != nil {
+		return ffs.DealSettings{}, fmt.Errorf("getting miner %s info: %s", addr, err)
+	}
+	if mi.PeerId == nil {
+		return ffs.DealSettings{}, fmt.Errorf("the miner %s doesn't specify a peer id", addr)
+	}
+	head, err := c.ChainHead(ctx)
+	if err != nil {
+		return ffs.DealSettings{}, fmt.Errorf("getting chain head: %s", err)
+	}
+
+	type chAskRes struct {
+		Error string
+		Ask   *storagemarket.StorageAsk
+	}
+	chAsk := make(chan chAskRes, 1)
+	go func() {
+		sask, err := c.ClientQueryAsk(ctx, *mi.PeerId, addr)
+		if err != nil {
+			chAsk <- chAskRes{Error: err.Error()}
+			return
+		}
+		chAsk <- chAskRes{Ask: sask}
+	}()
+
+	var ask *storagemarket.StorageAsk
+	select {
+	case <-ctx.Done():
+		return ffs.DealSettings{}, fmt.Errorf("query asking timed out")
+	case r := <-chAsk:
+		if r.Error != "" {
+			return ffs.DealSettings{}, fmt.Errorf("query ask had controlled error: %s", r.Error)
+		}
+		ask = r.Ask
+	}
+
+	// Duration bounds are enforced by the market actor, so they're the
+	// same for every miner.
+	minDuration, maxDuration := policy.DealDurationBounds(ask.MinPieceSize)
+	return ffs.DealSettings{
+		Accepting:     ask.Expiry > head.Height(),
+		Price:         ask.Price.Uint64(),
+		VerifiedPrice: ask.VerifiedPrice.Uint64(),
+		MinPieceSize:  uint64(ask.MinPieceSize),
+		MaxPieceSize:  uint64(ask.MaxPieceSize),
+		MinDuration:   int64(minDuration),
+		MaxDuration:   int64(maxDuration),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package dealsettings
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+func TestMinerDealSettingsCache(t *testing.T) {
+	t.Parallel()
+
+	miners := &mockMiners{settings: map[string]ffs.DealSettings{
+		"f01": {Accepting: true, Price: 100, MinPieceSize: 256, MaxPieceSize: 1024},
+	}}
+	c := newCache(time.Millisecond*100, miners.query)
+	ctx := context.Background()
+
+	s, err := c.MinerDealSettings(ctx, "f01")
+	require.NoError(t, err)
+	require.Equal(t, miners.settings["f01"], s)
+
+	// Cached settings don't query the miner again.
+	miners.set("f01", ffs.DealSettings{Accepting: false})
+	s, err = c.MinerDealSettings(ctx, "f01")
+	require.NoError(t, err)
+	require.True(t, s.Accepting)
+	require.Equal(t, 1, miners.queried("f01"))
+
+	// Expired settings are queried again.
+	time.Sleep(time.Millisecond * 150)
+	s, err = c.MinerDealSettings(ctx, "f01")
+	require.NoError(t, err)
+	require.False(t, s.Accepting)
+	require.Equal(t, 2, miners.queried("f01"))
+
+	// Failed queries aren't cached.
+	_, err = c.MinerDealSettings(ctx, "f02")
+	require.Error(t, err)
+	_, err = c.MinerDealSettings(ctx, "f02")
+	require.Error(t, err)
+	require.Equal(t, 2, miners.queried("f02"))
+}
+
+type mockMiners struct {
+	lock     sync.Mutex
+	settings map[string]ffs.DealSettings
+	queries  map[string]int
+}
+
+func (mm *mockMiners) query(_ context.Context, miner string) (ffs.DealSettings, error) {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	if mm.queries == nil {
+		mm.queries = map[string]int{}
+	}
+	mm.queries[miner]++
+	s, ok := mm.settings[miner]
+	if !ok {
+		return ffs.DealSettings{}, fmt.Errorf("miner %s unreachable", miner)
+	}
+	return s, nil
+}
+
+func (mm *mockMiners) set(miner string, s ffs.DealSettings) {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	mm.settings[miner] = s
+}
+
+func (mm *mockMiners) queried(miner string) int {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	return mm.queries[miner]
+}
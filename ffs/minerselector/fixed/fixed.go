@@ -1,6 +1,8 @@
 package fixed
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/textileio/powergate/v2/ffs"
@@ -9,7 +11,9 @@ import (
 // MinerSelector is a MinerSelector implementation which
 // always return a single miner address with an fixed epochPrice.
 type MinerSelector struct {
-	miners []Miner
+	miners      []Miner
+	ds          ffs.DealSettingsProvider
+	unavailable ffs.AskUnavailablePolicy
 }
 
 // Miner contains miner information.
@@ -21,14 +25,37 @@ type Miner struct {
 
 var _ ffs.MinerSelector = (*MinerSelector)(nil)
 
+// Option configures a MinerSelector.
+type Option func(*MinerSelector)
+
+// WithDealSettings makes the selector skip miners whose current deal
+// acceptance settings don't satisfy the filter.
+func WithDealSettings(ds ffs.DealSettingsProvider) Option {
+	return func(fms *MinerSelector) {
+		fms.ds = ds
+	}
+}
+
+// WithAskUnavailablePolicy sets the behavior for miners whose deal
+// settings can't be queried. It's only used with WithDealSettings.
+func WithAskUnavailablePolicy(p ffs.AskUnavailablePolicy) Option {
+	return func(fms *MinerSelector) {
+		fms.unavailable = p
+	}
+}
+
 // New returns a new FixedMinerSelector that always return addr as the miner address
 // and epochPrice.
-func New(miners []Miner) *MinerSelector {
+func New(miners []Miner, opts ...Option) *MinerSelector {
 	fixedMiners := make([]Miner, len(miners))
 	copy(fixedMiners, miners)
-	return &MinerSelector{
+	fms := &MinerSelector{
 		miners: fixedMiners,
 	}
+	for _, o := range opts {
+		o(fms)
+	}
+	return fms
 }
 
 // GetMiners returns the single allowed miner in the selector.
@@ -38,7 +65,11 @@ func (fms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Min
 	for _, pm := range f.TrustedMiners {
 		for _, m := range fms.miners {
 			if m.Addr == pm {
-				if f.MaxPrice > 0 && m.EpochPrice > f.MaxPrice {
+				ok, err := fms.accepts(f, m)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
 					continue
 				}
 				mres[m.Addr] = struct{}{}
@@ -58,7 +89,11 @@ func (fms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Min
 		if _, ok := mres[m.Addr]; ok {
 			continue
 		}
-		if f.MaxPrice > 0 && m.EpochPrice > f.MaxPrice {
+		ok, err := fms.accepts(f, m)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
 			continue
 		}
 		skip := false
@@ -96,3 +131,31 @@ func (fms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Min
 	}
 	return res, nil
 }
+
+// accepts returns true if the miner satisfies the price constraints and
+// acceptance policy of the filter. An error is returned if the whole
+// selection should fail.
+func (fms *MinerSelector) accepts(f ffs.MinerSelectorFilter, m Miner) (bool, error) {
+	if f.MaxPrice > 0 && m.EpochPrice > f.MaxPrice {
+		return false, nil
+	}
+	if err := f.Accepts(m.EpochPrice, 0, 0); err != nil {
+		return false, nil
+	}
+	if fms.ds == nil {
+		return true, nil
+	}
+	s, err := fms.ds.MinerDealSettings(context.Background(), m.Addr)
+	if err != nil {
+		s, err = fms.unavailable.Unavailable(m.Addr, err)
+		if errors.Is(err, ffs.ErrAskUnavailable) {
+			return false, err
+		}
+		if err != nil {
+			return false, nil
+		}
+	}
+	// The fixed epoch price takes precedence over the asked one.
+	s.Price, s.VerifiedPrice = m.EpochPrice, m.EpochPrice
+	return f.AcceptsSettings(s) == nil, nil
+}
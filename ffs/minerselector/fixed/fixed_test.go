@@ -0,0 +1,102 @@
+package fixed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+func TestGetMinersAcceptancePolicy(t *testing.T) {
+	t.Parallel()
+	ms := New([]Miner{
+		{Addr: "f01", EpochPrice: 100},
+		{Addr: "f02", EpochPrice: 300},
+		{Addr: "f03", EpochPrice: 200},
+	})
+	f := ffs.MinerSelectorFilter{
+		TrustedMiners: []string{"f02"},
+		DealDuration:  1000,
+		Policy:        ffs.AcceptancePolicy{MaxPrice: 200, MinDuration: 1000},
+	}
+
+	// f02 is trusted, but too expensive for the policy.
+	mps, err := ms.GetMiners(2, f)
+	require.NoError(t, err)
+	require.Equal(t, []ffs.MinerProposal{{Addr: "f01", EpochPrice: 100}, {Addr: "f03", EpochPrice: 200}}, mps)
+
+	_, err = ms.GetMiners(3, f)
+	require.Error(t, err)
+
+	// Deal terms failing the policy reject every miner.
+	f.DealDuration = 999
+	_, err = ms.GetMiners(1, f)
+	require.Error(t, err)
+}
+
+func TestGetMinersDealSettings(t *testing.T) {
+	t.Parallel()
+	ds := dealSettings{
+		"f01": {Accepting: false, MinPieceSize: 256, MaxPieceSize: 4096},
+		"f02": {Accepting: true, MinPieceSize: 2048, MaxPieceSize: 4096},
+		"f03": {Accepting: true, MinPieceSize: 256, MaxPieceSize: 4096, MinDuration: 2000},
+		"f04": {Accepting: true, MinPieceSize: 256, MaxPieceSize: 4096, MinDuration: 500},
+	}
+	ms := New([]Miner{
+		{Addr: "f01", EpochPrice: 100},
+		{Addr: "f02", EpochPrice: 100},
+		{Addr: "f03", EpochPrice: 100},
+		{Addr: "f04", EpochPrice: 100},
+		{Addr: "f05", EpochPrice: 100},
+	}, WithDealSettings(ds))
+	f := ffs.MinerSelectorFilter{PieceSize: 1024, DealDuration: 1000}
+
+	// f01 isn't accepting deals, f02 rejects the piece size, f03
+	// rejects the duration, and f05 settings can't be queried.
+	mps, err := ms.GetMiners(1, f)
+	require.NoError(t, err)
+	require.Equal(t, []ffs.MinerProposal{{Addr: "f04", EpochPrice: 100}}, mps)
+
+	_, err = ms.GetMiners(2, f)
+	require.Error(t, err)
+
+	// Without settings, every miner is selected.
+	mps, err = New([]Miner{{Addr: "f01", EpochPrice: 100}}).GetMiners(1, f)
+	require.NoError(t, err)
+	require.Len(t, mps, 1)
+}
+
+func TestGetMinersAskUnavailable(t *testing.T) {
+	t.Parallel()
+	ds := dealSettings{"f01": {Accepting: true}}
+	miners := []Miner{{Addr: "f01", EpochPrice: 100}, {Addr: "f02", EpochPrice: 100}}
+	f := ffs.MinerSelectorFilter{PieceSize: 1024, DealDuration: 1000}
+
+	// Skipping is the default policy.
+	mps, err := New(miners, WithDealSettings(ds)).GetMiners(1, f)
+	require.NoError(t, err)
+	require.Equal(t, "f01", mps[0].Addr)
+	_, err = New(miners, WithDealSettings(ds)).GetMiners(2, f)
+	require.Error(t, err)
+	require.False(t, errors.Is(err, ffs.ErrAskUnavailable))
+
+	mps, err = New(miners, WithDealSettings(ds), WithAskUnavailablePolicy(ffs.AskUnavailableFree)).GetMiners(2, f)
+	require.NoError(t, err)
+	require.Len(t, mps, 2)
+
+	_, err = New(miners, WithDealSettings(ds), WithAskUnavailablePolicy(ffs.AskUnavailableFail)).GetMiners(2, f)
+	require.True(t, errors.Is(err, ffs.ErrAskUnavailable))
+}
+
+type dealSettings map[string]ffs.DealSettings
+
+func (ds dealSettings) MinerDealSettings(_ context.Context, miner string) (ffs.DealSettings, error) {
+	s, ok := ds[miner]
+	if !ok {
+		return ffs.DealSettings{}, fmt.Errorf("miner %s unreachable", miner)
+	}
+	return s, nil
+}
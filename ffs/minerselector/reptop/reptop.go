@@ -2,14 +2,12 @@ package reptop
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
 
-	"github.com/filecoin-project/go-address"
-	"github.com/filecoin-project/go-fil-markets/storagemarket"
-	"github.com/filecoin-project/lotus/chain/types"
 	logger "github.com/ipfs/go-log/v2"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/minerselector/dealsettings"
 	askRunner "github.com/textileio/powergate/v2/index/ask/runner"
 	"github.com/textileio/powergate/v2/lotus"
 	"github.com/textileio/powergate/v2/reputation"
@@ -22,21 +20,53 @@ var (
 // RepTop is a ffs.MinerSelector implementation that returns the top N
 // miners from a Reputations Module and an Ask Index.
 type RepTop struct {
-	rm *reputation.Module
-	ai *askRunner.Runner
-	cb lotus.ClientBuilder
+	rm          *reputation.Module
+	ai          *askRunner.Runner
+	ds          ffs.DealSettingsProvider
+	pf          *ffs.Preflight
+	unavailable ffs.AskUnavailablePolicy
 }
 
 var _ ffs.MinerSelector = (*RepTop)(nil)
 
+// Option configures a RepTop.
+type Option func(*RepTop)
+
+// WithAskUnavailablePolicy sets the behavior for miners without an ask
+// in the Ask Index which can't be queried either.
+func WithAskUnavailablePolicy(p ffs.AskUnavailablePolicy) Option {
+	return func(rt *RepTop) {
+		rt.unavailable = p
+	}
+}
+
+// WithDealSettings sets the provider of the deal acceptance settings of
+// miners, instead of querying them. Miners' connectivity isn't checked,
+// so it's meant to evaluate the selection against recorded settings,
+// e.g. in backtests.
+func WithDealSettings(ds ffs.DealSettingsProvider) Option {
+	return func(rt *RepTop) {
+		rt.ds = ds
+		rt.pf = ffs.NewPreflight(nil, ds, nil)
+	}
+}
+
 // New returns a new RetTop instance that uses the specified Reputation Module
-// to select miners and the AskIndex for their epoch prices.
-func New(cb lotus.ClientBuilder, rm *reputation.Module, ai *askRunner.Runner) *RepTop {
-	return &RepTop{
+// to select miners and the AskIndex for their epoch prices. Miners are
+// selected only if they pass the preflight checks with their current deal
+// acceptance settings.
+func New(cb lotus.ClientBuilder, rm *reputation.Module, ai *askRunner.Runner, opts ...Option) *RepTop {
+	ds := dealsettings.New(cb, dealsettings.DefaultTTL)
+	rt := &RepTop{
 		rm: rm,
 		ai: ai,
-		cb: cb,
+		ds: ds,
+		pf: ffs.NewPreflight(ds, ds, nil),
+	}
+	for _, o := range opts {
+		o(rt)
 	}
+	return rt
 }
 
 // GetMiners returns n miners using the configured Reputation Module and
@@ -50,22 +80,28 @@ func (rt *RepTop) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.MinerPropos
 	// This is done to circumvent index building restrictions such as excluding miners
 	// with zero power. Trusted miners are trusted by definition, so if they have zero
 	// power, that's a risk that the client is accepting.
-	trustedMiners := rt.genTrustedMiners(f, n)
+	trustedMiners, err := rt.genTrustedMiners(f, n)
+	if err != nil {
+		return nil, fmt.Errorf("getting trusted miners: %w", err)
+	}
 
 	// The remaining needed miners are gathered from the reputation index.
 	reputationMiners, err := rt.genFromReputation(f, n-len(trustedMiners))
 	if err != nil {
-		return nil, fmt.Errorf("getting miners from reputation module: %s", err)
+		return nil, fmt.Errorf("getting miners from reputation module: %w", err)
 	}
 
 	// Return both lists.
 	return append(trustedMiners, reputationMiners...), nil
 }
 
-func (rt *RepTop) genTrustedMiners(f ffs.MinerSelectorFilter, n int) []ffs.MinerProposal {
+func (rt *RepTop) genTrustedMiners(f ffs.MinerSelectorFilter, n int) ([]ffs.MinerProposal, error) {
 	ret := make([]ffs.MinerProposal, 0, len(f.TrustedMiners))
 	for _, m := range f.TrustedMiners {
 		mp, err := rt.getMinerProposal(f, m)
+		if errors.Is(err, ffs.ErrAskUnavailable) {
+			return nil, err
+		}
 		if err != nil {
 			log.Warnf("trusted miner %s query asking: %s", m, err)
 			continue
@@ -76,7 +112,7 @@ func (rt *RepTop) genTrustedMiners(f ffs.MinerSelectorFilter, n int) []ffs.Miner
 		}
 	}
 
-	return ret
+	return ret, nil
 }
 
 func (rt *RepTop) genFromReputation(f ffs.MinerSelectorFilter, n int) ([]ffs.MinerProposal, error) {
@@ -96,6 +132,9 @@ func (rt *RepTop) genFromReputation(f ffs.MinerSelectorFilter, n int) ([]ffs.Min
 	res := make([]ffs.MinerProposal, 0, n)
 	for _, m := range ms {
 		mp, err := rt.getMinerProposal(f, m.Addr)
+		if errors.Is(err, ffs.ErrAskUnavailable) {
+			return nil, err
+		}
 		if err != nil {
 			if len(minerErrors) < maxMinerErrors {
 				minerErrors = append(minerErrors, err)
@@ -116,61 +155,37 @@ func (rt *RepTop) genFromReputation(f ffs.MinerSelectorFilter, n int) ([]ffs.Min
 }
 
 func (rt *RepTop) getMinerProposal(f ffs.MinerSelectorFilter, addrStr string) (ffs.MinerProposal, error) {
-	c, cls, err := rt.cb(context.Background())
-	if err != nil {
-		return ffs.MinerProposal{}, fmt.Errorf("creating lotus client: %s", err)
-	}
-	defer cls()
-
-	addr, err := address.NewFromString(addrStr)
+	s, err := rt.ds.MinerDealSettings(context.Background(), addrStr)
 	if err != nil {
-		return ffs.MinerProposal{}, fmt.Errorf("miner address is invalid: %s", err)
-	}
-	ctx, cls := context.WithTimeout(context.Background(), time.Second*10)
-	defer cls()
-
-	mi, err := c.StateMinerInfo(ctx, addr, types.EmptyTSK)
-	if err != nil {
-		return ffs.MinerProposal{}, fmt.Errorf("getting miner %s info: %s", addr, err)
+		var ok bool
+		if s, ok = rt.indexedSettings(addrStr); !ok {
+			if s, err = rt.unavailable.Unavailable(addrStr, err); err != nil {
+				return ffs.MinerProposal{}, err
+			}
+		}
 	}
-
-	if mi.PeerId == nil {
-		return ffs.MinerProposal{}, fmt.Errorf("the miner %s doesn't specify a peer id", addr)
+	res := rt.pf.PreflightSettings(context.Background(), addrStr, s, ffs.DealParams{Filter: f})
+	if err := res.Err(); err != nil {
+		return ffs.MinerProposal{}, err
 	}
+	return ffs.MinerProposal{Addr: addrStr, EpochPrice: s.EpochPrice(f.VerifiedDeal)}, nil
+}
 
-	type chAskRes struct {
-		Error string
-		Ask   *storagemarket.StorageAsk
+// indexedSettings returns the deal settings of a miner from its ask in
+// the Ask Index.
+func (rt *RepTop) indexedSettings(addr string) (ffs.DealSettings, bool) {
+	if rt.ai == nil {
+		return ffs.DealSettings{}, false
 	}
-	chAsk := make(chan chAskRes)
-	go func() {
-		sask, err := c.ClientQueryAsk(ctx, *mi.PeerId, addr)
-		if err != nil {
-			chAsk <- chAskRes{Error: err.Error()}
-			return
-		}
-
-		chAsk <- chAskRes{Ask: sask}
-	}()
-
-	select {
-	case <-time.After(time.Second * 20):
-		return ffs.MinerProposal{}, fmt.Errorf("query asking timed out")
-	case r := <-chAsk:
-		if r.Error != "" {
-			return ffs.MinerProposal{}, fmt.Errorf("query ask had controlled error: %s", r.Error)
-		}
-		price := r.Ask.Price.Uint64()
-		if f.VerifiedDeal {
-			price = r.Ask.VerifiedPrice.Uint64()
-		}
-		if f.MaxPrice > 0 && price > f.MaxPrice {
-			return ffs.MinerProposal{}, fmt.Errorf("miner's price doesn't satisfy price constraints: %d>%d", r.Ask.Price, f.MaxPrice)
-		}
-		if f.PieceSize < uint64(r.Ask.MinPieceSize) || f.PieceSize > uint64(r.Ask.MaxPieceSize) {
-			return ffs.MinerProposal{}, fmt.Errorf("miner doesn't satisfy piece size constraints: %d<%d<%d", r.Ask.MinPieceSize, f.PieceSize, r.Ask.MaxPieceSize)
-		}
-
-		return ffs.MinerProposal{Addr: addrStr, EpochPrice: price}, nil
+	a, ok := rt.ai.Get().Storage[addr]
+	if !ok {
+		return ffs.DealSettings{}, false
 	}
+	return ffs.DealSettings{
+		Accepting:     true,
+		Price:         a.Price,
+		VerifiedPrice: a.VerifiedPrice,
+		MinPieceSize:  a.MinPieceSize,
+		MaxPieceSize:  a.MaxPieceSize,
+	}, true
 }
@@ -3,19 +3,16 @@ package sr2
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
-	"math/rand"
 	"net/http"
-	"time"
 
-	"github.com/filecoin-project/go-address"
-	"github.com/filecoin-project/go-fil-markets/storagemarket"
-	"github.com/filecoin-project/lotus/api"
-	"github.com/filecoin-project/lotus/chain/types"
 	logger "github.com/ipfs/go-log/v2"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/minerselector/dealsettings"
 	"github.com/textileio/powergate/v2/lotus"
+	"github.com/textileio/powergate/v2/random"
 )
 
 const (
@@ -28,12 +25,45 @@ var (
 
 // MinerSelector chooses miner under SR2 strategy.
 type MinerSelector struct {
-	url string
-	cb  lotus.ClientBuilder
+	url         string
+	ds          ffs.DealSettingsProvider
+	pf          *ffs.Preflight
+	unavailable ffs.AskUnavailablePolicy
+	rand        random.Source
 }
 
 var _ ffs.MinerSelector = (*MinerSelector)(nil)
 
+// Option configures a MinerSelector.
+type Option func(*MinerSelector)
+
+// WithAskUnavailablePolicy sets the behavior for miners which can't be
+// query asked.
+func WithAskUnavailablePolicy(p ffs.AskUnavailablePolicy) Option {
+	return func(ms *MinerSelector) {
+		ms.unavailable = p
+	}
+}
+
+// WithRandSource sets the source of randomness used to shuffle the miners
+// of each bucket. The default is random.Default.
+func WithRandSource(src random.Source) Option {
+	return func(ms *MinerSelector) {
+		ms.rand = src
+	}
+}
+
+// WithDealSettings sets the provider of the deal acceptance settings of
+// miners, instead of querying them. Miners' connectivity isn't checked,
+// so it's meant to evaluate the selection against recorded settings,
+// e.g. in backtests.
+func WithDealSettings(ds ffs.DealSettingsProvider) Option {
+	return func(ms *MinerSelector) {
+		ms.ds = ds
+		ms.pf = ffs.NewPreflight(nil, ds, nil)
+	}
+}
+
 type minersBuckets struct {
 	Buckets []bucket
 }
@@ -43,9 +73,14 @@ type bucket struct {
 	MinerAddresses []string
 }
 
-// New returns a new SR2 miner selector.
-func New(url string, cb lotus.ClientBuilder) (*MinerSelector, error) {
-	ms := &MinerSelector{url: url, cb: cb}
+// New returns a new SR2 miner selector. Miners are selected only if they
+// pass the preflight checks.
+func New(url string, cb lotus.ClientBuilder, opts ...Option) (*MinerSelector, error) {
+	ds := dealsettings.New(cb, dealsettings.DefaultTTL)
+	ms := &MinerSelector{url: url, ds: ds, pf: ffs.NewPreflight(ds, ds, nil), rand: random.Default}
+	for _, o := range opts {
+		o(ms)
+	}
 
 	_, err := ms.getMiners()
 	if err != nil {
@@ -62,17 +97,10 @@ func (ms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Mine
 		return nil, fmt.Errorf("getting miners from url: %s", err)
 	}
 
-	c, cls, err := ms.cb(context.Background())
-	if err != nil {
-		return nil, fmt.Errorf("creating lotus client: %s", err)
-	}
-	defer cls()
-
-	rand.Seed(time.Now().UnixNano())
 	var selected []ffs.MinerProposal
 	for _, bucket := range mb.Buckets {
 		miners := bucket.MinerAddresses
-		rand.Shuffle(len(miners), func(i, j int) { miners[i], miners[j] = miners[j], miners[i] })
+		ms.rand.Shuffle(len(miners), func(i, j int) { miners[i], miners[j] = miners[j], miners[i] })
 
 		// Stay safe.
 		if bucket.Amount < 0 {
@@ -83,29 +111,30 @@ func (ms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Mine
 		}
 		var regionSelected int
 		for i := 0; regionSelected < bucket.Amount && i < len(miners); i++ {
-			sask, err := getMinerQueryAsk(c, miners[i])
+			s, err := ms.ds.MinerDealSettings(context.Background(), miners[i])
 			if err != nil {
-				log.Warnf("sr2 miner %s query-ask errored: %s", miners[i], err)
-				continue
-			}
-			price := sask.Price.Uint64()
-			if f.VerifiedDeal {
-				price = sask.VerifiedPrice.Uint64()
+				s, err = ms.unavailable.Unavailable(miners[i], err)
+				if errors.Is(err, ffs.ErrAskUnavailable) {
+					return nil, err
+				}
+				if err != nil {
+					log.Warnf("sr2 miner %s query-ask errored: %s", miners[i], err)
+					continue
+				}
 			}
+			price := s.EpochPrice(f.VerifiedDeal)
 			if price > maxSR2Price {
-				log.Warnf("skipping miner %s since has price %d above maximum allowed for SR2", miners[i], sask.Price)
+				log.Warnf("skipping miner %s since has price %d above maximum allowed for SR2", miners[i], price)
 				continue
 			}
-			if f.MaxPrice > 0 && price > f.MaxPrice {
-				log.Warnf("skipping miner %s with price %d higher than max-price %d", miners[i], sask.Price, f.MaxPrice)
+			res := ms.pf.PreflightSettings(context.Background(), miners[i], s, ffs.DealParams{Filter: f})
+			if err := res.Err(); err != nil {
+				log.Warnf("skipping miner %s: %s", miners[i], err)
 				continue
 			}
-			if f.PieceSize < uint64(sask.MinPieceSize) || f.PieceSize > uint64(sask.MaxPieceSize) {
-				log.Warnf("skipping miner %s since needed piece size %d doesn't fit bounds (%d, %d)", miners[i], f.PieceSize, sask.MinPieceSize, sask.MaxPieceSize)
-			}
 			selected = append(selected, ffs.MinerProposal{
 				Addr:       miners[i],
-				EpochPrice: sask.Price.Uint64(),
+				EpochPrice: s.Price,
 			})
 			regionSelected++
 		}
@@ -153,40 +182,3 @@ func (ms *MinerSelector) getMiners() (minersBuckets, error) {
 	}
 	return res, nil
 }
-
-func getMinerQueryAsk(c *api.FullNodeStruct, addrStr string) (*storagemarket.StorageAsk, error) {
-	addr, err := address.NewFromString(addrStr)
-	if err != nil {
-		return nil, fmt.Errorf("miner address is invalid: %s", err)
-	}
-	ctx, cls := context.WithTimeout(context.Background(), time.Second*10)
-	defer cls()
-	mi, err := c.StateMinerInfo(ctx, addr, types.EmptyTSK)
-	if err != nil {
-		return nil, fmt.Errorf("getting miner %s info: %s", addr, err)
-	}
-
-	type chAskRes struct {
-		Error string
-		Ask   *storagemarket.StorageAsk
-	}
-	chAsk := make(chan chAskRes)
-	go func() {
-		sask, err := c.ClientQueryAsk(ctx, *mi.PeerId, addr)
-		if err != nil {
-			chAsk <- chAskRes{Error: err.Error()}
-			return
-		}
-		chAsk <- chAskRes{Ask: sask}
-	}()
-
-	select {
-	case <-time.After(time.Second * 10):
-		return nil, fmt.Errorf("query asking timed out")
-	case r := <-chAsk:
-		if r.Error != "" {
-			return nil, fmt.Errorf("query ask had controlled error: %s", r.Error)
-		}
-		return r.Ask, nil
-	}
-}
@@ -2,7 +2,10 @@ package sr2
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/filecoin-project/go-address"
@@ -10,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/lotus"
+	"github.com/textileio/powergate/v2/random"
 )
 
 // TestMS is meant to be runned locally since it needs a fully
@@ -33,6 +37,93 @@ func TestMS(t *testing.T) {
 	}
 }
 
+func TestGetMinersDeterministic(t *testing.T) {
+	t.Parallel()
+
+	mb := minersBuckets{Buckets: []bucket{
+		{Amount: 2, MinerAddresses: []string{"f01", "f02", "f03", "f04", "f05", "f06"}},
+		{Amount: 1, MinerAddresses: []string{"f07", "f08", "f09", "f10"}},
+	}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(mb)
+	}))
+	t.Cleanup(srv.Close)
+
+	selection := func(seed int64) []string {
+		ms := &MinerSelector{url: srv.URL, ds: acceptingDealSettings{}, pf: ffs.NewPreflight(nil, acceptingDealSettings{}, nil), rand: random.New(seed)}
+		var res []string
+		for i := 0; i < 3; i++ {
+			mps, err := ms.GetMiners(3, ffs.MinerSelectorFilter{})
+			require.NoError(t, err)
+			require.Len(t, mps, 3)
+			for _, mp := range mps {
+				res = append(res, mp.Addr)
+			}
+		}
+		return res
+	}
+
+	// The same seed selects the same miners.
+	require.Equal(t, selection(1), selection(1))
+	require.NotEqual(t, selection(1), selection(2))
+}
+
+func TestGetMinersPreflight(t *testing.T) {
+	t.Parallel()
+
+	mb := minersBuckets{Buckets: []bucket{
+		{Amount: 3, MinerAddresses: []string{"f01", "f02", "f03", "f04"}},
+	}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(mb)
+	}))
+	t.Cleanup(srv.Close)
+
+	ds := dealSettings{
+		"f01": {Accepting: true, Price: 100, MaxPieceSize: 1 << 30},
+		// The piece doesn't fit the miner bounds.
+		"f02": {Accepting: true, Price: 100, MinPieceSize: 1 << 31, MaxPieceSize: 1 << 32},
+		"f03": {Accepting: true, Price: 100, MaxPieceSize: 1 << 30},
+		"f04": {Accepting: true, Price: 100, MaxPieceSize: 1 << 30},
+	}
+	mc := unreachableMiners{"f03": true}
+	ms := &MinerSelector{url: srv.URL, ds: ds, pf: ffs.NewPreflight(mc, ds, nil), rand: random.New(1)}
+
+	mps, err := ms.GetMiners(3, ffs.MinerSelectorFilter{PieceSize: 1 << 20})
+	require.NoError(t, err)
+	var addrs []string
+	for _, mp := range mps {
+		addrs = append(addrs, mp.Addr)
+	}
+	// Miners failing preflight checks aren't selected.
+	require.ElementsMatch(t, []string{"f01", "f04"}, addrs)
+}
+
+type dealSettings map[string]ffs.DealSettings
+
+func (ds dealSettings) MinerDealSettings(_ context.Context, miner string) (ffs.DealSettings, error) {
+	s, ok := ds[miner]
+	if !ok {
+		return ffs.DealSettings{}, fmt.Errorf("miner %s unavailable", miner)
+	}
+	return s, nil
+}
+
+type unreachableMiners map[string]bool
+
+func (um unreachableMiners) ConnectMiner(_ context.Context, miner string) error {
+	if um[miner] {
+		return fmt.Errorf("miner %s unreachable", miner)
+	}
+	return nil
+}
+
+type acceptingDealSettings struct{}
+
+func (acceptingDealSettings) MinerDealSettings(context.Context, string) (ffs.DealSettings, error) {
+	return ffs.DealSettings{Accepting: true, Price: 100}, nil
+}
+
 func TestCustom(t *testing.T) {
 	t.SkipNow()
 	lotusHost, err := multiaddr.NewMultiaddr("/ip4/127.0.0.1/tcp/5555")
@@ -0,0 +1,157 @@
+package ffs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/filecoin-project/go-state-types/big"
+)
+
+const (
+	// PreflightConnectivity checks the miner host can be reached.
+	PreflightConnectivity = "connectivity"
+	// PreflightLiveAsk checks the miner has a current ask.
+	PreflightLiveAsk = "live-ask"
+	// PreflightAccepting checks the miner accepts the deal terms.
+	PreflightAccepting = "accepting-deals"
+	// PreflightPieceSize checks the piece size fits the miner bounds.
+	PreflightPieceSize = "piece-size"
+	// PreflightFunds checks the wallet can pay the deal.
+	PreflightFunds = "sufficient-funds"
+)
+
+// DealParams are the parameters of a deal to be proposed to a miner.
+type DealParams struct {
+	// Filter has the deal terms and the acceptance policy.
+	Filter MinerSelectorFilter
+	// Wallet is the address paying the deal. If empty, the funds
+	// check is skipped.
+	Wallet string
+}
+
+// PreflightCheck is the result of a preflight check.
+type PreflightCheck struct {
+	// Name is the name of the check.
+	Name string
+	// Err describes why the check failed. It's nil if it passed.
+	Err error
+	// Skipped indicates the check didn't run, so it passed.
+	Skipped bool
+}
+
+// PreflightResult has the results of every preflight check of a miner.
+type PreflightResult struct {
+	Miner  string
+	Checks []PreflightCheck
+}
+
+// Suitable returns true if every check passed, so a deal can be
+// proposed to the miner.
+func (r PreflightResult) Suitable() bool {
+	return r.Err() == nil
+}
+
+// Err returns a non-nil error describing the failed checks.
+func (r PreflightResult) Err() error {
+	var failed []string
+	for _, c := range r.Checks {
+		if c.Err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", c.Name, c.Err))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("miner %s failed preflight checks: %s", r.Miner, strings.Join(failed, "; "))
+}
+
+// Preflight checks miners before proposing deals to them.
+type Preflight struct {
+	mc MinerConnector
+	ds DealSettingsProvider
+	wm WalletManager
+}
+
+// NewPreflight returns a new Preflight. If mc is nil, the connectivity
+// check is skipped.
+func NewPreflight(mc MinerConnector, ds DealSettingsProvider, wm WalletManager) *Preflight {
+	return &Preflight{mc: mc, ds: ds, wm: wm}
+}
+
+// PreflightMiner runs every preflight check for a deal with the miner.
+// Every check runs even if others fail, so all the reasons for a miner
+// being unsuitable are reported.
+func (p *Preflight) PreflightMiner(ctx context.Context, miner string, params DealParams) (PreflightResult, error) {
+	if miner == "" {
+		return PreflightResult{}, fmt.Errorf("miner address is empty")
+	}
+	s, err := p.ds.MinerDealSettings(ctx, miner)
+	if err != nil {
+		res := PreflightResult{Miner: miner}
+		res.Checks = append(res.Checks, p.checkConnectivity(ctx, miner))
+		err = fmt.Errorf("ask unavailable: %s", err)
+		for _, name := range []string{PreflightLiveAsk, PreflightAccepting, PreflightPieceSize, PreflightFunds} {
+			res.Checks = append(res.Checks, PreflightCheck{Name: name, Err: err})
+		}
+		return res, nil
+	}
+	return p.PreflightSettings(ctx, miner, s, params), nil
+}
+
+// PreflightSettings runs every preflight check for a deal with the miner
+// with the provided deal settings. Miner selectors use it with the
+// settings they resolved for the miner, so selection and diagnostics
+// share the same checks.
+func (p *Preflight) PreflightSettings(ctx context.Context, miner string, s DealSettings, params DealParams) PreflightResult {
+	res := PreflightResult{Miner: miner}
+	check := func(name string, err error) {
+		res.Checks = append(res.Checks, PreflightCheck{Name: name, Err: err})
+	}
+
+	res.Checks = append(res.Checks, p.checkConnectivity(ctx, miner))
+
+	f := params.Filter
+	if !s.Accepting {
+		check(PreflightLiveAsk, fmt.Errorf("miner ask is expired"))
+	} else {
+		check(PreflightLiveAsk, nil)
+	}
+	// Piece size bounds are checked separately.
+	unbounded := s
+	unbounded.Accepting = true
+	unbounded.MaxPieceSize = 0
+	check(PreflightAccepting, f.AcceptsSettings(unbounded))
+	check(PreflightPieceSize, DealTerms{
+		MinPieceSize: s.MinPieceSize,
+		MaxPieceSize: s.MaxPieceSize,
+		PieceSize:    f.PieceSize,
+	}.FitsPieceSize())
+
+	if params.Wallet == "" || p.wm == nil {
+		res.Checks = append(res.Checks, PreflightCheck{Name: PreflightFunds, Skipped: true})
+		return res
+	}
+	check(PreflightFunds, p.checkFunds(ctx, params.Wallet, AskDealCost(s.EpochPrice(f.VerifiedDeal), f.PieceSize, f.DealDuration)))
+
+	return res
+}
+
+func (p *Preflight) checkConnectivity(ctx context.Context, miner string) PreflightCheck {
+	if p.mc == nil {
+		return PreflightCheck{Name: PreflightConnectivity, Skipped: true}
+	}
+	return PreflightCheck{Name: PreflightConnectivity, Err: p.mc.ConnectMiner(ctx, miner)}
+}
+
+func (p *Preflight) checkFunds(ctx context.Context, wallet string, cost big.Int) error {
+	b, err := p.wm.Balance(ctx, wallet)
+	if err != nil {
+		return fmt.Errorf("getting wallet balance: %s", err)
+	}
+	balance := big.NewFromGo(b)
+	if balance.LessThan(cost) {
+		return fmt.Errorf("balance %s is below the deal cost %s", balance, cost)
+	}
+	return nil
+}
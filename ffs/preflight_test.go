@@ -0,0 +1,150 @@
+package ffs
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreflightMiner(t *testing.T) {
+	t.Parallel()
+
+	settings := DealSettings{
+		Accepting:    true,
+		Price:        1000,
+		MinPieceSize: 1 << 10,
+		MaxPieceSize: 1 << 30,
+	}
+	// The deal costs 1000 * 100 attoFIL.
+	params := DealParams{
+		Filter: MinerSelectorFilter{PieceSize: 1 << 30, DealDuration: 100},
+		Wallet: "f1wallet",
+	}
+
+	tests := []struct {
+		name        string
+		unreachable bool
+		settings    *DealSettings
+		params      func(DealParams) DealParams
+		balance     int64
+		failed      []string
+	}{
+		{name: "Suitable", balance: 100000},
+		{name: "Unreachable", unreachable: true, balance: 100000, failed: []string{PreflightConnectivity}},
+		{name: "AskUnavailable", balance: 100000, failed: []string{PreflightLiveAsk, PreflightAccepting, PreflightPieceSize, PreflightFunds}},
+		{name: "ExpiredAsk", settings: &DealSettings{Price: 1000, MaxPieceSize: 1 << 30}, balance: 100000, failed: []string{PreflightLiveAsk}},
+		{
+			name:    "NotAccepting",
+			params:  func(p DealParams) DealParams { p.Filter.MaxPrice = 999; return p },
+			balance: 100000,
+			failed:  []string{PreflightAccepting},
+		},
+		{
+			name:    "SmallPiece",
+			params:  func(p DealParams) DealParams { p.Filter.PieceSize = 1 << 9; return p },
+			balance: 100000,
+			failed:  []string{PreflightPieceSize},
+		},
+		{name: "InsufficientFunds", balance: 99999, failed: []string{PreflightFunds}},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ds := &preflightDealSettings{settings: map[string]DealSettings{}}
+			if tc.name != "AskUnavailable" {
+				ds.settings["f01"] = settings
+				if tc.settings != nil {
+					ds.settings["f01"] = *tc.settings
+				}
+			}
+			p := NewPreflight(&preflightConnector{fail: tc.unreachable}, ds, &preflightWallet{balance: big.NewInt(tc.balance)})
+			dp := params
+			if tc.params != nil {
+				dp = tc.params(dp)
+			}
+
+			res, err := p.PreflightMiner(context.Background(), "f01", dp)
+			require.NoError(t, err)
+			require.Equal(t, "f01", res.Miner)
+			// Every check is reported, even if others fail.
+			var names, failed []string
+			for _, c := range res.Checks {
+				names = append(names, c.Name)
+				if c.Err != nil {
+					failed = append(failed, c.Name)
+				}
+			}
+			require.Equal(t, []string{PreflightConnectivity, PreflightLiveAsk, PreflightAccepting, PreflightPieceSize, PreflightFunds}, names)
+			require.Equal(t, tc.failed, failed)
+			require.Equal(t, len(tc.failed) == 0, res.Suitable())
+		})
+	}
+
+	t.Run("WithoutWallet", func(t *testing.T) {
+		t.Parallel()
+		ds := &preflightDealSettings{settings: map[string]DealSettings{"f01": settings}}
+		p := NewPreflight(&preflightConnector{}, ds, nil)
+		dp := params
+		dp.Wallet = ""
+		res, err := p.PreflightMiner(context.Background(), "f01", dp)
+		require.NoError(t, err)
+		require.True(t, res.Suitable())
+		require.Equal(t, PreflightFunds, res.Checks[4].Name)
+		require.True(t, res.Checks[4].Skipped)
+
+		_, err = p.PreflightMiner(context.Background(), "", dp)
+		require.Error(t, err)
+	})
+
+	t.Run("WithoutConnector", func(t *testing.T) {
+		t.Parallel()
+		p := NewPreflight(nil, &preflightDealSettings{}, nil)
+		res := p.PreflightSettings(context.Background(), "f01", settings, params)
+		require.True(t, res.Suitable())
+		require.NoError(t, res.Err())
+		require.Equal(t, PreflightConnectivity, res.Checks[0].Name)
+		require.True(t, res.Checks[0].Skipped)
+
+		dp := params
+		dp.Filter.MaxPrice = 999
+		res = p.PreflightSettings(context.Background(), "f01", settings, dp)
+		require.False(t, res.Suitable())
+		require.Contains(t, res.Err().Error(), PreflightAccepting)
+	})
+}
+
+type preflightConnector struct {
+	fail bool
+}
+
+func (c *preflightConnector) ConnectMiner(_ context.Context, miner string) error {
+	if c.fail {
+		return fmt.Errorf("miner %s unreachable", miner)
+	}
+	return nil
+}
+
+type preflightDealSettings struct {
+	settings map[string]DealSettings
+}
+
+func (ds *preflightDealSettings) MinerDealSettings(_ context.Context, miner string) (DealSettings, error) {
+	s, ok := ds.settings[miner]
+	if !ok {
+		return DealSettings{}, fmt.Errorf("query ask timed out")
+	}
+	return s, nil
+}
+
+type preflightWallet struct {
+	WalletManager
+	balance *big.Int
+}
+
+func (w *preflightWallet) Balance(context.Context, string) (*big.Int, error) {
+	return w.balance, nil
+}
@@ -0,0 +1,90 @@
+package mpstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+var dsBaseMaintenancePause = datastore.NewKey("maintenancepause")
+
+// Store persists which background maintenance tasks are paused
+// for each instance.
+type Store struct {
+	lock sync.Mutex
+	ds   datastore.Datastore
+}
+
+// New returns a new Store backed by the Datastore.
+func New(ds datastore.Datastore) *Store {
+	return &Store{
+		ds: ds,
+	}
+}
+
+// Get returns the paused maintenance tasks of an instance. If nothing
+// was paused for the instance, the zero value is returned.
+func (s *Store) Get(iid ffs.APIID) (ffs.MaintenancePause, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.get(iid)
+}
+
+// SetRenewals pauses or resumes automatic renewals for an instance.
+func (s *Store) SetRenewals(iid ffs.APIID, paused bool) error {
+	return s.update(iid, func(mp *ffs.MaintenancePause) { mp.Renewals = paused })
+}
+
+// SetRepairs pauses or resumes automatic repairs for an instance.
+func (s *Store) SetRepairs(iid ffs.APIID, paused bool) error {
+	return s.update(iid, func(mp *ffs.MaintenancePause) { mp.Repairs = paused })
+}
+
+func (s *Store) update(iid ffs.APIID, f func(*ffs.MaintenancePause)) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	mp, err := s.get(iid)
+	if err != nil {
+		return err
+	}
+	f(&mp)
+
+	// Instances without paused tasks don't need an entry.
+	if mp == (ffs.MaintenancePause{}) {
+		if err := s.ds.Delete(makeKey(iid)); err != nil {
+			return fmt.Errorf("deleting from datastore: %s", err)
+		}
+		return nil
+	}
+	buf, err := json.Marshal(mp)
+	if err != nil {
+		return fmt.Errorf("json marshaling: %s", err)
+	}
+	if err := s.ds.Put(makeKey(iid), buf); err != nil {
+		return fmt.Errorf("saving in datastore: %s", err)
+	}
+	return nil
+}
+
+func (s *Store) get(iid ffs.APIID) (ffs.MaintenancePause, error) {
+	var mp ffs.MaintenancePause
+	buf, err := s.ds.Get(makeKey(iid))
+	if err == datastore.ErrNotFound {
+		return mp, nil
+	}
+	if err != nil {
+		return mp, fmt.Errorf("get from datastore: %s", err)
+	}
+	if err := json.Unmarshal(buf, &mp); err != nil {
+		return mp, fmt.Errorf("unmarshaling from datastore: %s", err)
+	}
+	return mp, nil
+}
+
+func makeKey(iid ffs.APIID) datastore.Key {
+	return dsBaseMaintenancePause.ChildString(iid.String())
+}
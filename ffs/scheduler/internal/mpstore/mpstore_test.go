@@ -0,0 +1,45 @@
+package mpstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestIndependentToggles(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	s := New(ds)
+
+	mp, err := s.Get("iid1")
+	require.NoError(t, err)
+	require.Equal(t, ffs.MaintenancePause{}, mp)
+
+	require.NoError(t, s.SetRenewals("iid1", true))
+	mp, err = s.Get("iid1")
+	require.NoError(t, err)
+	require.Equal(t, ffs.MaintenancePause{Renewals: true}, mp)
+
+	require.NoError(t, s.SetRepairs("iid1", true))
+	require.NoError(t, s.SetRenewals("iid1", false))
+	mp, err = s.Get("iid1")
+	require.NoError(t, err)
+	require.Equal(t, ffs.MaintenancePause{Repairs: true}, mp)
+
+	// Other instances aren't affected.
+	mp, err = s.Get("iid2")
+	require.NoError(t, err)
+	require.Equal(t, ffs.MaintenancePause{}, mp)
+
+	// Pauses are persisted.
+	mp, err = New(ds).Get("iid1")
+	require.NoError(t, err)
+	require.Equal(t, ffs.MaintenancePause{Repairs: true}, mp)
+
+	require.NoError(t, s.SetRepairs("iid1", false))
+	ok, err := ds.Has(makeKey("iid1"))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -101,6 +101,36 @@ func (s *Store) Dequeue() (*ffs.RetrievalJob, error) {
 	return nil, nil
 }
 
+// Queued returns the queued retrieval jobs.
+func (s *Store) Queued() ([]ffs.RetrievalJob, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	q := query.Query{Prefix: dsBaseJob.String()}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing queued query result: %s", err)
+		}
+	}()
+	var ret []ffs.RetrievalJob
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		var j ffs.RetrievalJob
+		if err := json.Unmarshal(r.Value, &j); err != nil {
+			return nil, fmt.Errorf("unmarshalling job: %s", err)
+		}
+		if j.Status == ffs.Queued {
+			ret = append(ret, j)
+		}
+	}
+	return ret, nil
+}
+
 // Enqueue queues a new retrieval job.
 func (s *Store) Enqueue(j ffs.RetrievalJob) error {
 	s.lock.Lock()
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
@@ -29,6 +30,7 @@ Might be useful in a future migration or datastore maintenance.
 /apiid/<api-id>/<cid>/<timestamp>: Index on api-id primarily, cid secondarily, with timestamp, values of job-id
 /cid/<cid>/<api-id>/<timestamp>: Index on cid primarily, api-id secondarily, with timestamp, values of job-id
 /starteddeals_v2/<instance-id>/<cid>: Stores StartedDeals data by instance-id and cid
+/prunesummary/<instance-id>: Stores PruneSummary data by instance-id
 */
 
 var (
@@ -41,6 +43,12 @@ var (
 	dsBaseAPIID        = datastore.NewKey("apiid")
 	dsBaseCid          = datastore.NewKey("cid")
 	dsBaseStartedDeals = datastore.NewKey("starteddeals_v2")
+	dsBasePruneSummary = datastore.NewKey("prunesummary")
+
+	// MaxPriorityBypasses is the number of times a queued Job can be
+	// bypassed by higher priority Jobs before it's dequeued ahead of them.
+	// It prevents low priority Jobs from starving.
+	MaxPriorityBypasses = 10
 )
 
 // Store is a Datastore implementation of JobStore, which saves
@@ -62,6 +70,9 @@ type Store struct {
 	queuedIDs    map[ffs.JobID]struct{}
 	executingIDs map[ffs.JobID]struct{}
 
+	bypasses    map[ffs.JobID]int
+	maxBypasses int
+
 	// Metrics
 	metricJobCounter metric.Int64UpDownCounter
 }
@@ -88,6 +99,8 @@ func New(ds datastore.TxnDatastore) (*Store, error) {
 		lastSuccessfulJobs: make(map[ffs.APIID]map[cid.Cid]*ffs.StorageJob),
 		queuedIDs:          make(map[ffs.JobID]struct{}),
 		executingIDs:       make(map[ffs.JobID]struct{}),
+		bypasses:           make(map[ffs.JobID]int),
+		maxBypasses:        MaxPriorityBypasses,
 	}
 	s.initMetrics()
 	if err := s.loadCaches(); err != nil {
@@ -177,27 +190,27 @@ func (s *Store) Finalize(jid ffs.JobID, st ffs.JobStatus, jobError error, dealEr
 // job Status is automatically changed to Executing. If an instance id is provided,
 // only a job for that instance id will be dequeued. If no jobs are available to dequeue
 // it returns a nil *ffs.Job and no-error.
+// Jobs with higher priority are dequeued first, and jobs with the same priority
+// are dequeued in FIFO order. A job bypassed by higher priority ones more than
+// MaxPriorityBypasses times is dequeued ahead of them.
 func (s *Store) Dequeue(iid ffs.APIID) (*ffs.StorageJob, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	for _, job := range s.queued {
+	var eligible []int
+	next := -1
+	for i, job := range s.queued {
 		execJob, ok := s.executingJobs[job.APIID][job.Cid]
 		isAPIIDMatch := true
 		if iid != ffs.EmptyInstanceID {
 			isAPIIDMatch = iid == job.APIID
 		}
 		if job.Status == ffs.Queued && !ok && isAPIIDMatch {
-			job.Status = ffs.Executing
-			if err := s.put(job, false); err != nil {
-				return nil, err
+			eligible = append(eligible, i)
+			if next == -1 || s.runsBefore(job, s.queued[next]) {
+				next = i
 			}
-
-			ctx := context.Background()
-			s.metricJobCounter.Add(ctx, -1, attrStatusQueued)
-			s.metricJobCounter.Add(ctx, 1, attrStatusExecuting)
-
-			return &job, nil
+			continue
 		}
 		if ok {
 			// ToDo: Maybe remove this since there might be lots of reasons we skip over a job.
@@ -205,7 +218,40 @@ func (s *Store) Dequeue(iid ffs.APIID) (*ffs.StorageJob, error) {
 			log.Infof("queued %s is delayed since job %s is running", job.ID, execJob.ID)
 		}
 	}
-	return nil, nil
+	if next == -1 {
+		return nil, nil
+	}
+
+	job := s.queued[next]
+	for _, i := range eligible {
+		if s.queued[i].Priority < job.Priority {
+			s.bypasses[s.queued[i].ID]++
+		}
+	}
+	job.Status = ffs.Executing
+	if err := s.put(job, false); err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	s.metricJobCounter.Add(ctx, -1, attrStatusQueued)
+	s.metricJobCounter.Add(ctx, 1, attrStatusExecuting)
+
+	return &job, nil
+}
+
+// runsBefore returns true if queued job a should be dequeued before
+// queued job b, assuming a is after b in the queue.
+func (s *Store) runsBefore(a, b ffs.StorageJob) bool {
+	aStarved := s.bypasses[a.ID] >= s.maxBypasses
+	bStarved := s.bypasses[b.ID] >= s.maxBypasses
+	if aStarved != bStarved {
+		return aStarved
+	}
+	if aStarved {
+		return false
+	}
+	return a.Priority > b.Priority
 }
 
 // Enqueue queues a new Job. If other Job for the same Cid is in Queued status,
@@ -256,6 +302,22 @@ func (s *Store) getStats() Stats {
 	}
 }
 
+// GetFailedCounts returns, for each instance, the number of Cids
+// whose latest final Job failed.
+func (s *Store) GetFailedCounts() map[ffs.APIID]int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	res := make(map[ffs.APIID]int)
+	for iid, jobs := range s.lastFinalJobs {
+		for _, j := range jobs {
+			if j.Status == ffs.Failed {
+				res[iid]++
+			}
+		}
+	}
+	return res
+}
+
 // GetExecutingJobIDs returns the JobIDs of all Jobs in Executing status.
 func (s *Store) GetExecutingJobIDs() []ffs.JobID {
 	s.lock.Lock()
@@ -414,6 +476,149 @@ func (s *Store) GetStartedDeals(iid ffs.APIID, c cid.Cid) ([]cid.Cid, error) {
 	return sd.ProposalCids, nil
 }
 
+// PruneSummary accounts for the final Jobs of an instance that were pruned.
+type PruneSummary struct {
+	Success  int
+	Failed   int
+	Canceled int
+}
+
+// Prune removes final Jobs created before olderThan, or exceeding the
+// maxPerInstance most recent final Jobs of each instance. A zero olderThan
+// or maxPerInstance disables the corresponding criteria. The latest final
+// and latest successful Jobs of each Cid are always kept. Pruned Jobs are accounted in the
+// PruneSummary of their instance. It returns the number of pruned Jobs.
+func (s *Store) Prune(olderThan time.Time, maxPerInstance int) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	q := query.Query{Prefix: dsBaseJob.String()}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return 0, fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing prune query result: %s", err)
+		}
+	}()
+	finalJobs := make(map[ffs.APIID][]ffs.StorageJob)
+	for r := range res.Next() {
+		if r.Error != nil {
+			return 0, fmt.Errorf("iter next: %s", r.Error)
+		}
+		var j ffs.StorageJob
+		if err := json.Unmarshal(r.Value, &j); err != nil {
+			return 0, fmt.Errorf("unmarshalling job: %s", err)
+		}
+		if isFinal(j) {
+			finalJobs[j.APIID] = append(finalJobs[j.APIID], j)
+		}
+	}
+
+	var pruned int
+	for iid, jobs := range finalJobs {
+		sort.Slice(jobs, func(a, b int) bool {
+			return jobs[a].CreatedAt > jobs[b].CreatedAt
+		})
+		var summary PruneSummary
+		for i, j := range jobs {
+			if s.isLatest(j) {
+				continue
+			}
+			tooOld := !olderThan.IsZero() && j.CreatedAt < olderThan.Unix()
+			tooMany := maxPerInstance > 0 && i >= maxPerInstance
+			if !tooOld && !tooMany {
+				continue
+			}
+			if err := s.remove(j); err != nil {
+				return pruned, fmt.Errorf("removing job %s: %s", j.ID, err)
+			}
+			switch j.Status {
+			case ffs.Success:
+				summary.Success++
+			case ffs.Failed:
+				summary.Failed++
+			case ffs.Canceled:
+				summary.Canceled++
+			}
+			pruned++
+		}
+		if summary != (PruneSummary{}) {
+			if err := s.addPruneSummary(iid, summary); err != nil {
+				return pruned, fmt.Errorf("saving prune summary: %s", err)
+			}
+		}
+	}
+	return pruned, nil
+}
+
+// isLatest returns true if j is the latest final or latest
+// successful Job of its Cid.
+func (s *Store) isLatest(j ffs.StorageJob) bool {
+	if latest, ok := s.lastFinalJobs[j.APIID][j.Cid]; ok && latest.ID == j.ID {
+		return true
+	}
+	if latest, ok := s.lastSuccessfulJobs[j.APIID][j.Cid]; ok && latest.ID == j.ID {
+		return true
+	}
+	return false
+}
+
+// GetPruneSummary returns the accounting of pruned Jobs of an instance.
+func (s *Store) GetPruneSummary(iid ffs.APIID) (PruneSummary, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.getPruneSummary(iid)
+}
+
+func (s *Store) getPruneSummary(iid ffs.APIID) (PruneSummary, error) {
+	var ps PruneSummary
+	buf, err := s.ds.Get(dsBasePruneSummary.ChildString(iid.String()))
+	if err == datastore.ErrNotFound {
+		return ps, nil
+	}
+	if err != nil {
+		return ps, fmt.Errorf("getting prune summary from datastore: %s", err)
+	}
+	if err := json.Unmarshal(buf, &ps); err != nil {
+		return ps, fmt.Errorf("unmarshaling prune summary: %s", err)
+	}
+	return ps, nil
+}
+
+func (s *Store) addPruneSummary(iid ffs.APIID, delta PruneSummary) error {
+	ps, err := s.getPruneSummary(iid)
+	if err != nil {
+		return err
+	}
+	ps.Success += delta.Success
+	ps.Failed += delta.Failed
+	ps.Canceled += delta.Canceled
+	buf, err := json.Marshal(ps)
+	if err != nil {
+		return fmt.Errorf("marshaling prune summary: %s", err)
+	}
+	if err := s.ds.Put(dsBasePruneSummary.ChildString(iid.String()), buf); err != nil {
+		return fmt.Errorf("saving prune summary in datastore: %s", err)
+	}
+	return nil
+}
+
+// remove deletes a final Job and its index entries.
+func (s *Store) remove(j ffs.StorageJob) error {
+	if err := s.ds.Delete(makeKey(j.ID)); err != nil {
+		return fmt.Errorf("deleting job: %s", err)
+	}
+	if err := s.ds.Delete(makeAPIIDKey(j)); err != nil {
+		return fmt.Errorf("deleting api id index: %s", err)
+	}
+	if err := s.ds.Delete(makeCidKey(j)); err != nil {
+		return fmt.Errorf("deleting cid index: %s", err)
+	}
+	return nil
+}
+
 // Select specifies which StorageJobs to list.
 type Select int
 
@@ -659,6 +864,7 @@ func (s *Store) put(j ffs.StorageJob, updateIndex bool) error {
 			s.queuedJobs[j.APIID][j.Cid] = append(s.queuedJobs[j.APIID][j.Cid][:delIndex], s.queuedJobs[j.APIID][j.Cid][delIndex+1:]...)
 		}
 		delete(s.queuedIDs, j.ID)
+		delete(s.bypasses, j.ID)
 	}
 
 	// Update the cache of latest final jobs
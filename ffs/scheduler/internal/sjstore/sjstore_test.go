@@ -424,6 +424,55 @@ func TestDequeue(t *testing.T) {
 	})
 }
 
+func TestDequeuePriority(t *testing.T) {
+	t.Parallel()
+	t.Run("HighFirst", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+
+		low := createJob(t, "apiid", cid.Undef)
+		require.NoError(t, s.Enqueue(low))
+		high := createJob(t, "apiid", cid.Undef)
+		high.Priority = 10
+		require.NoError(t, s.Enqueue(high))
+		mid := createJob(t, "apiid", cid.Undef)
+		mid.Priority = 5
+		require.NoError(t, s.Enqueue(mid))
+
+		for _, expected := range []ffs.JobID{high.ID, mid.ID, low.ID} {
+			j, err := s.Dequeue(ffs.EmptyInstanceID)
+			require.NoError(t, err)
+			require.NotNil(t, j)
+			require.Equal(t, expected, j.ID)
+		}
+	})
+	t.Run("NoStarvation", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		s.maxBypasses = 3
+
+		low := createJob(t, "apiid", cid.Undef)
+		require.NoError(t, s.Enqueue(low))
+
+		// Keep a steady flow of high priority jobs, the low priority one
+		// should be dequeued after being bypassed maxBypasses times.
+		for i := 0; i < s.maxBypasses; i++ {
+			high := createJob(t, "apiid", cid.Undef)
+			high.Priority = 10
+			require.NoError(t, s.Enqueue(high))
+			j, err := s.Dequeue(ffs.EmptyInstanceID)
+			require.NoError(t, err)
+			require.Equal(t, high.ID, j.ID)
+		}
+		high := createJob(t, "apiid", cid.Undef)
+		high.Priority = 10
+		require.NoError(t, s.Enqueue(high))
+		j, err := s.Dequeue(ffs.EmptyInstanceID)
+		require.NoError(t, err)
+		require.Equal(t, low.ID, j.ID)
+	})
+}
+
 func TestCancelation(t *testing.T) {
 	t.Parallel()
 	s := create(t)
@@ -445,6 +494,74 @@ func TestCancelation(t *testing.T) {
 	require.Equal(t, ffs.Queued, j2Queued.Status)
 }
 
+func TestPrune(t *testing.T) {
+	t.Parallel()
+	t.Run("MaxAge", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		now := time.Now()
+
+		c1 := createJob(t, "iid", cid.Undef).Cid
+		old1 := putFinalJob(t, s, "iid", c1, now.Add(-time.Hour*24*10), ffs.Success)
+		old2 := putFinalJob(t, s, "iid", c1, now.Add(-time.Hour*24*9), ffs.Failed)
+		recent := putFinalJob(t, s, "iid", c1, now.Add(-time.Hour), ffs.Success)
+		// The only Job of c2 is old, but is kept since it's the latest.
+		onlyC2 := putFinalJob(t, s, "iid", cid.Undef, now.Add(-time.Hour*24*20), ffs.Failed)
+
+		pruned, err := s.Prune(now.Add(-time.Hour*24*7), 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, pruned)
+
+		for _, j := range []ffs.StorageJob{old1, old2} {
+			_, err := s.Get(j.ID)
+			require.Equal(t, ErrNotFound, err)
+		}
+		for _, j := range []ffs.StorageJob{recent, onlyC2} {
+			_, err := s.Get(j.ID)
+			require.NoError(t, err)
+		}
+		jobs, _, _, err := s.List(ListConfig{APIIDFilter: "iid"})
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+
+		ps, err := s.GetPruneSummary("iid")
+		require.NoError(t, err)
+		require.Equal(t, PruneSummary{Success: 1, Failed: 1}, ps)
+	})
+	t.Run("MaxCount", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		now := time.Now()
+
+		c := createJob(t, "iid", cid.Undef).Cid
+		oldest := putFinalJob(t, s, "iid", c, now.Add(-time.Hour*3), ffs.Canceled)
+		putFinalJob(t, s, "iid", c, now.Add(-time.Hour*2), ffs.Success)
+		putFinalJob(t, s, "iid", c, now.Add(-time.Hour), ffs.Success)
+		putFinalJob(t, s, "iid2", cid.Undef, now.Add(-time.Hour*3), ffs.Success)
+
+		pruned, err := s.Prune(time.Time{}, 2)
+		require.NoError(t, err)
+		require.Equal(t, 1, pruned)
+		_, err = s.Get(oldest.ID)
+		require.Equal(t, ErrNotFound, err)
+
+		ps, err := s.GetPruneSummary("iid")
+		require.NoError(t, err)
+		require.Equal(t, PruneSummary{Canceled: 1}, ps)
+		ps, err = s.GetPruneSummary("iid2")
+		require.NoError(t, err)
+		require.Equal(t, PruneSummary{}, ps)
+	})
+}
+
+func putFinalJob(t *testing.T, s *Store, iid string, c cid.Cid, createdAt time.Time, st ffs.JobStatus) ffs.StorageJob {
+	j := createJob(t, iid, c)
+	j.CreatedAt = createdAt.Unix()
+	j.Status = st
+	require.NoError(t, s.put(j, true))
+	return j
+}
+
 func TestStartedDeals(t *testing.T) {
 	t.Parallel()
 	s := create(t)
@@ -0,0 +1,146 @@
+package spstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+var (
+	log = logging.Logger("ffs-sched-spstore")
+
+	// ErrNotFound indicates the scheduled push doesn't exist.
+	ErrNotFound = errors.New("scheduled push not found")
+
+	dsBaseScheduledPush = datastore.NewKey("scheduledpush")
+)
+
+// Store persists pushes scheduled to be executed in the future.
+type Store struct {
+	ds datastore.Datastore
+}
+
+// New returns a new Store backed by the Datastore.
+func New(ds datastore.Datastore) *Store {
+	return &Store{
+		ds: ds,
+	}
+}
+
+// Put saves a scheduled push.
+func (s *Store) Put(sp ffs.ScheduledPush) error {
+	buf, err := json.Marshal(sp)
+	if err != nil {
+		return fmt.Errorf("json marshaling: %s", err)
+	}
+	if err := s.ds.Put(makeKey(sp.ID), buf); err != nil {
+		return fmt.Errorf("saving in datastore: %s", err)
+	}
+	return nil
+}
+
+// Get returns a scheduled push. If doesn't exist, returns ErrNotFound.
+func (s *Store) Get(id ffs.ScheduledPushID) (ffs.ScheduledPush, error) {
+	var sp ffs.ScheduledPush
+	buf, err := s.ds.Get(makeKey(id))
+	if err == datastore.ErrNotFound {
+		return sp, ErrNotFound
+	}
+	if err != nil {
+		return sp, fmt.Errorf("get from datastore: %s", err)
+	}
+	if err := json.Unmarshal(buf, &sp); err != nil {
+		return sp, fmt.Errorf("unmarshaling from datastore: %s", err)
+	}
+	return sp, nil
+}
+
+// Remove removes a scheduled push. If doesn't exist, returns ErrNotFound.
+func (s *Store) Remove(id ffs.ScheduledPushID) error {
+	ok, err := s.ds.Has(makeKey(id))
+	if err != nil {
+		return fmt.Errorf("checking existence in datastore: %s", err)
+	}
+	if !ok {
+		return ErrNotFound
+	}
+	if err := s.ds.Delete(makeKey(id)); err != nil {
+		return fmt.Errorf("deleting from datastore: %s", err)
+	}
+	return nil
+}
+
+// List returns the scheduled pushes of an instance sorted by execution
+// time. If iid is empty, scheduled pushes of all instances are returned.
+func (s *Store) List(iid ffs.APIID) ([]ffs.ScheduledPush, error) {
+	all, err := s.all()
+	if err != nil {
+		return nil, err
+	}
+	if iid == ffs.EmptyInstanceID {
+		return all, nil
+	}
+	var ret []ffs.ScheduledPush
+	for _, sp := range all {
+		if sp.APIID == iid {
+			ret = append(ret, sp)
+		}
+	}
+	return ret, nil
+}
+
+// Due returns the scheduled pushes which should be executed at
+// time now, sorted by execution time.
+func (s *Store) Due(now time.Time) ([]ffs.ScheduledPush, error) {
+	all, err := s.all()
+	if err != nil {
+		return nil, err
+	}
+	var ret []ffs.ScheduledPush
+	for _, sp := range all {
+		if sp.ExecuteAt.After(now) {
+			break
+		}
+		ret = append(ret, sp)
+	}
+	return ret, nil
+}
+
+func (s *Store) all() ([]ffs.ScheduledPush, error) {
+	q := query.Query{Prefix: dsBaseScheduledPush.String()}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	var ret []ffs.ScheduledPush
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		var sp ffs.ScheduledPush
+		if err := json.Unmarshal(r.Value, &sp); err != nil {
+			return nil, fmt.Errorf("unmarshaling from datastore: %s", err)
+		}
+		ret = append(ret, sp)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ExecuteAt.Before(ret[j].ExecuteAt)
+	})
+	return ret, nil
+}
+
+func makeKey(id ffs.ScheduledPushID) datastore.Key {
+	return dsBaseScheduledPush.ChildString(id.String())
+}
@@ -0,0 +1,92 @@
+package spstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestDue(t *testing.T) {
+	t.Parallel()
+	s := New(tests.NewTxMapDatastore())
+
+	now := time.Unix(1600000000, 0)
+	sp1 := createScheduledPush(t, "iid1", now.Add(time.Hour))
+	sp2 := createScheduledPush(t, "iid1", now.Add(time.Minute))
+	require.NoError(t, s.Put(sp1))
+	require.NoError(t, s.Put(sp2))
+
+	due, err := s.Due(now)
+	require.NoError(t, err)
+	require.Empty(t, due)
+
+	due, err = s.Due(now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, sp2.ID, due[0].ID)
+
+	due, err = s.Due(now.Add(2 * time.Hour))
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	require.Equal(t, sp2.ID, due[0].ID)
+	require.Equal(t, sp1.ID, due[1].ID)
+}
+
+func TestListAndRemove(t *testing.T) {
+	t.Parallel()
+	s := New(tests.NewTxMapDatastore())
+
+	now := time.Now()
+	sp1 := createScheduledPush(t, "iid1", now.Add(time.Hour))
+	sp2 := createScheduledPush(t, "iid2", now.Add(time.Hour))
+	require.NoError(t, s.Put(sp1))
+	require.NoError(t, s.Put(sp2))
+
+	l, err := s.List("iid1")
+	require.NoError(t, err)
+	require.Len(t, l, 1)
+	require.Equal(t, sp1.ID, l[0].ID)
+	l, err = s.List(ffs.EmptyInstanceID)
+	require.NoError(t, err)
+	require.Len(t, l, 2)
+
+	require.NoError(t, s.Remove(sp1.ID))
+	require.Equal(t, ErrNotFound, s.Remove(sp1.ID))
+	_, err = s.Get(sp1.ID)
+	require.Equal(t, ErrNotFound, err)
+	l, err = s.List("iid1")
+	require.NoError(t, err)
+	require.Empty(t, l)
+}
+
+func TestPersisted(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	s := New(ds)
+	sp := createScheduledPush(t, "iid1", time.Now().Add(time.Hour))
+	require.NoError(t, s.Put(sp))
+
+	// A new Store on the same datastore simulates a restart.
+	s = New(ds)
+	sp2, err := s.Get(sp.ID)
+	require.NoError(t, err)
+	require.Equal(t, sp.Cid, sp2.Cid)
+	require.True(t, sp.ExecuteAt.Equal(sp2.ExecuteAt))
+}
+
+func createScheduledPush(t *testing.T, iid ffs.APIID, at time.Time) ffs.ScheduledPush {
+	c, err := cid.Decode("QmSqL792vF4fGjStbYHRgazsEahAQKZmx68jrnvFi9hXMp")
+	require.NoError(t, err)
+	return ffs.ScheduledPush{
+		ID:        ffs.NewScheduledPushID(),
+		APIID:     iid,
+		Cid:       c,
+		Config:    ffs.StorageConfig{}.WithHotEnabled(true),
+		ExecuteAt: at,
+		CreatedAt: time.Now(),
+	}
+}
@@ -11,18 +11,27 @@ import (
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
 	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/v2/deals"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/cistore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/mpstore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/ristore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/rjstore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/sjstore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/spstore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/trackstore"
 	txndstr "github.com/textileio/powergate/v2/txndstransform"
+	"github.com/textileio/powergate/v2/util"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
-	log = logging.Logger("ffs-scheduler")
+	log    = logging.Logger("ffs-scheduler")
+	tracer = otel.Tracer("powergate/ffs/scheduler")
 
 	// ErrNotFound is returned when an item isn't found on a Store.
 	ErrNotFound = errors.New("item not found")
@@ -34,6 +43,18 @@ var (
 	// RepairEvalFrequency is the frequency in which repairable StorageConfigs
 	// will be evaluated.
 	RepairEvalFrequency = time.Hour * 24
+
+	// JobRetentionEvalFrequency is the frequency in which the job history
+	// retention policy will be applied.
+	JobRetentionEvalFrequency = time.Hour
+
+	// ScheduledPushEvalFrequency is the frequency in which scheduled pushes
+	// will be evaluated for execution.
+	ScheduledPushEvalFrequency = time.Minute
+
+	// ReconcileEvalFrequency is the frequency in which StorageInfos
+	// will be reconciled with the deals index.
+	ReconcileEvalFrequency = time.Hour * 24
 )
 
 // Scheduler receives actions to store a Cid in hot and cold storage. These actions are
@@ -43,20 +64,32 @@ var (
 type Scheduler struct {
 	cs  ffs.ColdStorage
 	hs  ffs.HotStorage
+	di  DealIndex
 	sjs *sjstore.Store
 	rjs *rjstore.Store
 	as  *astore.Store
 	ts  *trackstore.Store
 	cis *cistore.Store
 	ris *ristore.Store
+	sps *spstore.Store
+	mps *mpstore.Store
 	l   ffs.JobLogger
 
 	sr2RepFactor        func() (int, error)
 	dealFinalityTimeout time.Duration
 
+	// now is the clock of scheduled pushes.
+	now     func() time.Time
+	speLock sync.Mutex
+	spe     ScheduledPushExecutor
+
 	gcLock sync.Mutex
 	gc     GCConfig
 
+	// cisLock serializes updates of StorageInfos made outside
+	// of Jobs, such as imports and reconciliations.
+	cisLock sync.Mutex
+
 	sd         storageDaemon
 	rd         retrievalDaemon
 	cancelLock sync.Mutex
@@ -79,17 +112,31 @@ type storageDaemon struct {
 type retrievalDaemon struct {
 	rateLim       chan struct{}
 	evaluateQueue chan struct{}
+
+	// prefetchQueue signals there may be queued retrievals
+	// to prefetch, and prefetched keeps the results until
+	// the retrievals are executed.
+	prefetchQueue chan struct{}
+	prefetchLock  sync.Mutex
+	prefetched    map[ffs.JobID][]deals.RetrievalAvailability
 }
 
 // GCConfig provides configuration for FFS GC.
 type GCConfig struct {
 	StageGracePeriod time.Duration
 	AutoGCInterval   time.Duration
+	// JobRetentionMaxAge is the age after which final Jobs are pruned
+	// from the Job history. Zero disables pruning by age.
+	JobRetentionMaxAge time.Duration
+	// JobRetentionMaxCount is the number of final Jobs per instance kept
+	// in the Job history. Zero disables pruning by count.
+	JobRetentionMaxCount int
 }
 
 // New returns a new instance of Scheduler which uses JobStore as its backing repository for state,
-// HotStorage for the hot layer, and ColdStorage for the cold layer.
-func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.ColdStorage, maxParallel int, dealFinalityTimeout time.Duration, sr2rf func() (int, error), gcConfig GCConfig) (*Scheduler, error) {
+// HotStorage for the hot layer, and ColdStorage for the cold layer. If a DealIndex is provided,
+// it's periodically reconciled with the deals tracked by the scheduler.
+func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.ColdStorage, di DealIndex, maxParallel int, dealFinalityTimeout time.Duration, sr2rf func() (int, error), gcConfig GCConfig) (*Scheduler, error) {
 	sjs, err := sjstore.New(txndstr.Wrap(ds, "sjstore"))
 	if err != nil {
 		return nil, fmt.Errorf("loading stroage jobstore: %s", err)
@@ -106,11 +153,14 @@ func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.C
 
 	cis := cistore.New(txndstr.Wrap(ds, "cistore_v2"))
 	ris := ristore.New(txndstr.Wrap(ds, "ristore"))
+	sps := spstore.New(txndstr.Wrap(ds, "spstore"))
+	mps := mpstore.New(txndstr.Wrap(ds, "mpstore"))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	sch := &Scheduler{
 		cs: cs,
 		hs: hs,
+		di: di,
 
 		sjs: sjs,
 		rjs: rjs,
@@ -120,9 +170,12 @@ func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.C
 
 		cis: cis,
 		ris: ris,
+		sps: sps,
+		mps: mps,
 
-		l:  l,
-		gc: gcConfig,
+		l:   l,
+		gc:  gcConfig,
+		now: time.Now,
 
 		jobsCancel: make(map[ffs.JobID]chan struct{}),
 		sd: storageDaemon{
@@ -132,6 +185,8 @@ func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.C
 		rd: retrievalDaemon{
 			rateLim:       make(chan struct{}, maxParallel),
 			evaluateQueue: make(chan struct{}, 1),
+			prefetchQueue: make(chan struct{}, 1),
+			prefetched:    make(map[ffs.JobID][]deals.RetrievalAvailability),
 		},
 
 		ctx:      ctx,
@@ -313,6 +368,62 @@ func (s *Scheduler) run() {
 		}
 	}()
 
+	// Timer for applying the job history retention policy.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if s.gc.JobRetentionMaxAge == 0 && s.gc.JobRetentionMaxCount == 0 {
+			return
+		}
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(JobRetentionEvalFrequency):
+				s.pruneJobHistory()
+			}
+		}
+	}()
+
+	// Timer for executing due scheduled pushes.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(ScheduledPushEvalFrequency):
+				log.Debug("running scheduled pushes checks...")
+				s.execScheduledPushes(s.ctx, s.now())
+				log.Debug("scheduled pushes checks done")
+			}
+		}
+	}()
+
+	// Timer for reconciling the deals index.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if s.di == nil {
+			return
+		}
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(ReconcileEvalFrequency):
+				log.Debug("running deals index reconciliation...")
+				s.execReconcileCron(s.ctx)
+				log.Debug("deals index reconciliation done")
+			}
+		}
+	}()
+
 	// Loop for retrievals jobs.
 	wg.Add(1)
 	go func() {
@@ -325,6 +436,30 @@ func (s *Scheduler) run() {
 				log.Debug("evaluating retrieval job queue...")
 				s.execQueuedRetrievals(s.ctx)
 				log.Debug("retrieval job queue evaluated")
+				select {
+				case s.rd.prefetchQueue <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	// Loop for prefetching queued retrievals.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		pf, ok := s.cs.(ffs.RetrievalPrefetcher)
+		if !ok {
+			return
+		}
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-s.rd.prefetchQueue:
+				s.prefetchQueuedRetrievals(s.ctx, pf)
 			}
 		}
 	}()
@@ -348,6 +483,19 @@ func (s *Scheduler) run() {
 	}
 }
 
+func (s *Scheduler) pruneJobHistory() {
+	var olderThan time.Time
+	if s.gc.JobRetentionMaxAge > 0 {
+		olderThan = time.Now().Add(-s.gc.JobRetentionMaxAge)
+	}
+	pruned, err := s.sjs.Prune(olderThan, s.gc.JobRetentionMaxCount)
+	if err != nil {
+		log.Errorf("pruning job history: %s", err)
+		return
+	}
+	log.Infof("pruned %d jobs from job history", pruned)
+}
+
 func (s *Scheduler) printStats() {
 	stats := s.sjs.GetStats()
 	log.Infof("storage job total queued: %d, total executing: %d", stats.TotalQueued, stats.TotalExecuting)
@@ -391,19 +539,24 @@ func (s *Scheduler) resumeStartedDeals() error {
 // reschedule them as if they were pushed. The scheduler main executing logic
 // does whatever work is necessary to satisfy the storage config, thus
 // it has repairing semantics too. If no work is needed, this scheduled
-// job would have no real work done.
+// job would have no real work done. Instances with paused repairs
+// are skipped.
 func (s *Scheduler) execRepairCron(ctx context.Context) {
 	tcids, err := s.ts.GetRepairables()
 	if err != nil {
 		log.Errorf("getting repairable cid configs from store: %s", err)
 		return
 	}
+	paused := map[ffs.APIID]ffs.MaintenancePause{}
 	for _, tc := range tcids {
 		for _, sc := range tc.Tracked {
+			if s.isPaused(sc.IID, paused, func(mp ffs.MaintenancePause) bool { return mp.Repairs }) {
+				continue
+			}
 			lCtx := context.WithValue(ctx, ffs.CtxStorageCid, tc.Cid)
 			lCtx = context.WithValue(lCtx, ffs.CtxAPIID, sc.IID)
 			s.l.Log(lCtx, "Scheduling deal repair evaluation...")
-			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef)
+			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef, 0)
 			if err != nil {
 				s.l.Log(lCtx, "Scheduling deal repair errored: %s", err)
 			} else {
@@ -415,18 +568,23 @@ func (s *Scheduler) execRepairCron(ctx context.Context) {
 
 // execRenewCron gets all renewable storage configs and
 // reschedule them as if they were pushed. The scheduler main executing logic
-// will do renewals if necessary.
+// will do renewals if necessary. Instances with paused renewals are
+// skipped.
 func (s *Scheduler) execRenewCron(ctx context.Context) {
 	tcids, err := s.ts.GetRenewables()
 	if err != nil {
 		log.Errorf("getting repairable cid configs from store: %s", err)
 	}
+	paused := map[ffs.APIID]ffs.MaintenancePause{}
 	for _, tc := range tcids {
 		for _, sc := range tc.Tracked {
+			if s.isPaused(sc.IID, paused, func(mp ffs.MaintenancePause) bool { return mp.Renewals }) {
+				continue
+			}
 			lCtx := context.WithValue(ctx, ffs.CtxStorageCid, tc.Cid)
 			lCtx = context.WithValue(lCtx, ffs.CtxAPIID, sc.IID)
 			s.l.Log(lCtx, "Scheduling deal renew evaluation...")
-			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef)
+			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef, 0)
 			if err != nil {
 				s.l.Log(lCtx, "Scheduling deal renewal errored: %s", err)
 			} else {
@@ -496,6 +654,13 @@ func (s *Scheduler) executeQueuedStorage(j ffs.StorageJob) {
 	defer cancel()
 	ctx = context.WithValue(ctx, ffs.CtxStorageCid, j.Cid)
 	ctx = context.WithValue(ctx, ffs.CtxAPIID, j.APIID)
+	// Every phase of the Job is traced as a child of this span.
+	ctx, span := tracer.Start(ctx, "storage job", trace.WithAttributes(
+		attribute.String("job.id", j.ID.String()),
+		attribute.String("api.id", j.APIID.String()),
+		attribute.String("cid", util.CidToString(j.Cid)),
+	))
+	defer span.End()
 
 	var cancelLock sync.Mutex
 	var canceled bool
@@ -529,6 +694,8 @@ func (s *Scheduler) executeQueuedStorage(j ffs.StorageJob) {
 	// execution fail.
 	if err != nil {
 		log.Errorf("executing job %s: %s", j.ID, err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		if err := s.sjs.Finalize(j.ID, ffs.Failed, err, dealErrors); err != nil {
 			log.Errorf("changing job to failed: %s", err)
 		}
@@ -612,10 +779,13 @@ func (s *Scheduler) executeQueuedRetrievals(j ffs.RetrievalJob) {
 		delete(s.jobsCancel, j.ID)
 		s.cancelLock.Unlock()
 	}()
+	// Prefetched offers are only useful until the Job is final.
+	defer s.forgetPrefetched(j.ID)
 
 	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ffs.CtxKeyJid, j.ID))
 	defer cancel()
 	ctx = context.WithValue(ctx, ffs.CtxRetrievalID, j.RetrievalID)
+	ctx = context.WithValue(ctx, ffs.CtxAPIID, j.APIID)
 	go func() {
 		// If the user called Cancel to cancel Job execution,
 		// we cancel the context to finish.
@@ -668,3 +838,12 @@ func (s *Scheduler) executeQueuedRetrievals(j ffs.RetrievalJob) {
 	}
 	s.l.Log(ctx, "Retrieval job %s execution finished with status %s.", j.ID, ffs.JobStatusStr[finalStatus])
 }
+
+// endSpan ends a span, recording the error of the traced operation.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+var (
+	// EstimateTransferRate is the data transfer rate to miners, in bytes
+	// per second, assumed by storage time estimates.
+	EstimateTransferRate uint64 = 10 << 20
+
+	// EstimateSealingLatency is the typical time since the data is
+	// transferred to a miner until the deal is active on-chain, assumed
+	// by storage time estimates.
+	EstimateSealingLatency = time.Hour * 8
+)
+
+// Estimate is a rough estimation of the time a storage job takes until
+// the data is stored in Filecoin.
+type Estimate struct {
+	// QueueWait is the time the job waits for queued and executing
+	// jobs, assuming they're similar to the estimated one.
+	QueueWait time.Duration
+	// Transfer is the time transferring the data to every miner. The
+	// transfers share the bandwidth, so it grows with replication.
+	Transfer time.Duration
+	// Sealing is the time since the data is transferred until the deals
+	// are active. Miners seal in parallel, so it's the same for every
+	// replication factor.
+	Sealing time.Duration
+	// Total is the estimated time until the data is stored.
+	Total time.Duration
+}
+
+// EstimateStorageTime returns an estimation of the time until data of
+// the provided size, pushed now with cfg, is stored in Filecoin.
+func (s *Scheduler) EstimateStorageTime(cfg ffs.StorageConfig, size uint64) (Estimate, error) {
+	if err := cfg.Validate(); err != nil {
+		return Estimate{}, fmt.Errorf("validating storage config: %s", err)
+	}
+	if !cfg.Cold.Enabled {
+		return Estimate{}, fmt.Errorf("cold storage is disabled, so data isn't stored in Filecoin")
+	}
+	if size == 0 {
+		return Estimate{}, fmt.Errorf("size should be greater than zero")
+	}
+
+	stats := s.sjs.GetStats()
+	return estimateStorageTime(cfg, size, stats.TotalQueued+stats.TotalExecuting, cap(s.sd.rateLim)), nil
+}
+
+// estimateStorageTime estimates the time of a storage job which waits
+// for the provided number of jobs ahead, executed maxParallel at a time.
+func estimateStorageTime(cfg ffs.StorageConfig, size uint64, ahead, maxParallel int) Estimate {
+	var e Estimate
+	repFactor := uint64(cfg.Cold.Filecoin.RepFactor)
+	seconds := float64(size*repFactor) / float64(EstimateTransferRate)
+	e.Transfer = time.Duration(math.Round(seconds*1000)) * time.Millisecond
+	e.Sealing = EstimateSealingLatency
+	job := e.Transfer + e.Sealing
+	if maxParallel > 0 {
+		e.QueueWait = time.Duration(ahead/maxParallel) * job
+	}
+	e.Total = e.QueueWait + job
+	return e
+}
@@ -0,0 +1,58 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestEstimateStorageTime(t *testing.T) {
+	t.Parallel()
+	cfg := func(repFactor int) ffs.StorageConfig {
+		return ffs.StorageConfig{
+			Hot: ffs.HotConfig{Ipfs: ffs.IpfsConfig{AddTimeout: 30}},
+			Cold: ffs.ColdConfig{
+				Enabled: true,
+				Filecoin: ffs.FilConfig{
+					RepFactor:       repFactor,
+					DealMinDuration: util.MinDealDuration,
+					Addr:            "f01000",
+				},
+			},
+		}
+	}
+
+	small := estimateStorageTime(cfg(1), 1<<30, 0, 1)
+	require.Zero(t, small.QueueWait)
+	require.Equal(t, EstimateSealingLatency, small.Sealing)
+	require.Equal(t, small.Transfer+small.Sealing, small.Total)
+
+	// The transfer time grows with the size and the replication factor.
+	big := estimateStorageTime(cfg(1), 4<<30, 0, 1)
+	require.Equal(t, 4*small.Transfer, big.Transfer)
+	require.Greater(t, big.Total, small.Total)
+	replicated := estimateStorageTime(cfg(3), 1<<30, 0, 1)
+	require.Equal(t, 3*small.Transfer, replicated.Transfer)
+	require.Equal(t, small.Sealing, replicated.Sealing)
+	require.Greater(t, replicated.Total, small.Total)
+
+	// Jobs wait for a round of maxParallel jobs ahead.
+	queued := estimateStorageTime(cfg(1), 1<<30, 4, 2)
+	require.Equal(t, 2*small.Total, queued.QueueWait)
+	require.Equal(t, 3*small.Total, queued.Total)
+	require.Equal(t, small.QueueWait, estimateStorageTime(cfg(1), 1<<30, 1, 2).QueueWait)
+
+	s, _ := createScheduler(t)
+	e, err := s.EstimateStorageTime(cfg(2), 1<<20)
+	require.NoError(t, err)
+	require.Greater(t, e.Total, time.Duration(0))
+	hotOnly := cfg(1)
+	hotOnly.Cold.Enabled = false
+	_, err = s.EstimateStorageTime(hotOnly, 1<<20)
+	require.Error(t, err)
+	_, err = s.EstimateStorageTime(cfg(1), 0)
+	require.Error(t, err)
+}
@@ -13,6 +13,9 @@ import (
 // ImportDeals create or augments a Storageinfo for a Cid with the provided deal ids.
 // All deal ids must be active on-chain to let the operation succeed.
 func (s *Scheduler) ImportDeals(iid ffs.APIID, payloadCid cid.Cid, dealIDs []uint64) error {
+	s.cisLock.Lock()
+	defer s.cisLock.Unlock()
+
 	// 1. Get current StorageInfo.
 	si, err := s.cis.Get(iid, payloadCid)
 	if err == cistore.ErrNotFound {
@@ -120,5 +123,6 @@ func (s *Scheduler) genFilStorage(dealID uint64) (ffs.FilStorage, uint64, error)
 		StartEpoch: uint64(di.Proposal.StartEpoch),
 		Miner:      di.Proposal.Provider.String(),
 		EpochPrice: di.Proposal.StoragePricePerEpoch.Uint64(),
+		Verified:   di.Proposal.VerifiedDeal,
 	}, uint64(di.Proposal.PieceSize), nil
 }
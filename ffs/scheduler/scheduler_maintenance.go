@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"fmt"
+
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+// PauseRenewals pauses or resumes automatic deal renewals of an instance.
+// Renewals are also skipped by any other Job executed for the instance,
+// such as repairs, while paused. Repairs aren't otherwise affected.
+func (s *Scheduler) PauseRenewals(iid ffs.APIID, paused bool) error {
+	if iid == ffs.EmptyInstanceID {
+		return fmt.Errorf("empty API ID")
+	}
+	if err := s.mps.SetRenewals(iid, paused); err != nil {
+		return fmt.Errorf("saving renewals pause: %s", err)
+	}
+	return nil
+}
+
+// PauseRepairs pauses or resumes automatic repairs of an instance.
+// Renewals aren't affected.
+func (s *Scheduler) PauseRepairs(iid ffs.APIID, paused bool) error {
+	if iid == ffs.EmptyInstanceID {
+		return fmt.Errorf("empty API ID")
+	}
+	if err := s.mps.SetRepairs(iid, paused); err != nil {
+		return fmt.Errorf("saving repairs pause: %s", err)
+	}
+	return nil
+}
+
+// MaintenancePause returns which background maintenance tasks are
+// paused for an instance.
+func (s *Scheduler) MaintenancePause(iid ffs.APIID) (ffs.MaintenancePause, error) {
+	mp, err := s.mps.Get(iid)
+	if err != nil {
+		return ffs.MaintenancePause{}, fmt.Errorf("getting maintenance pause: %s", err)
+	}
+	return mp, nil
+}
+
+// isPaused returns true if the maintenance task selected by f is
+// paused for the instance. Lookups are memoized in cache, so a single
+// cron run reads the pause of each instance once.
+func (s *Scheduler) isPaused(iid ffs.APIID, cache map[ffs.APIID]ffs.MaintenancePause, f func(ffs.MaintenancePause) bool) bool {
+	mp, ok := cache[iid]
+	if !ok {
+		var err error
+		mp, err = s.mps.Get(iid)
+		if err != nil {
+			// Don't block maintenance on storage errors.
+			log.Errorf("getting maintenance pause of %s: %s", iid, err)
+		}
+		cache[iid] = mp
+	}
+	return f(mp)
+}
+
+// renewalsPaused returns true if deal renewals are paused for the
+// instance.
+func (s *Scheduler) renewalsPaused(iid ffs.APIID) bool {
+	mp, err := s.mps.Get(iid)
+	if err != nil {
+		log.Errorf("getting maintenance pause of %s: %s", iid, err)
+		return false
+	}
+	return mp.Renewals
+}
@@ -0,0 +1,176 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/joblogger"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/sjstore"
+	"github.com/textileio/powergate/v2/tests"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestPauseRenewals(t *testing.T) {
+	t.Parallel()
+	s, iid1, iid2 := createTrackingScheduler(t)
+
+	require.NoError(t, s.PauseRenewals(iid1, true))
+	mp, err := s.MaintenancePause(iid1)
+	require.NoError(t, err)
+	require.Equal(t, ffs.MaintenancePause{Renewals: true}, mp)
+
+	s.execRenewCron(s.ctx)
+	requirePushed(t, s, iid1, false)
+	requirePushed(t, s, iid2, true)
+
+	// Repairs keep running for the instance.
+	s.execRepairCron(s.ctx)
+	requirePushed(t, s, iid1, true)
+	requirePushed(t, s, iid2, true)
+
+	require.NoError(t, s.PauseRenewals(iid1, false))
+	s.execRenewCron(s.ctx)
+	requirePushed(t, s, iid1, true)
+}
+
+func TestPauseRepairs(t *testing.T) {
+	t.Parallel()
+	s, iid1, iid2 := createTrackingScheduler(t)
+
+	require.NoError(t, s.PauseRepairs(iid1, true))
+	mp, err := s.MaintenancePause(iid1)
+	require.NoError(t, err)
+	require.Equal(t, ffs.MaintenancePause{Repairs: true}, mp)
+
+	s.execRepairCron(s.ctx)
+	requirePushed(t, s, iid1, false)
+	requirePushed(t, s, iid2, true)
+
+	// Renewals keep running for the instance.
+	s.execRenewCron(s.ctx)
+	requirePushed(t, s, iid1, true)
+	requirePushed(t, s, iid2, true)
+
+	require.NoError(t, s.PauseRepairs(iid1, false))
+	s.execRepairCron(s.ctx)
+	requirePushed(t, s, iid1, true)
+}
+
+func TestPausedRenewalsSkippedByRepairs(t *testing.T) {
+	t.Parallel()
+	s, c := createScheduler(t)
+	cs := &renewalsColdStorage{}
+	s.cs = cs
+	iid := ffs.NewAPIID()
+	sc := trackedConfig()
+	curr := ffs.StorageInfo{
+		APIID: iid,
+		Cid:   c,
+		Hot:   ffs.HotInfo{Enabled: true},
+		Cold: ffs.ColdInfo{Filecoin: ffs.FilInfo{
+			DataCid:   c,
+			Proposals: []ffs.FilStorage{{DealID: 100}},
+		}},
+	}
+
+	ctx := context.WithValue(s.ctx, ffs.CtxStorageCid, c)
+	ctx = context.WithValue(ctx, ffs.CtxAPIID, iid)
+	ctx = context.WithValue(ctx, ffs.CtxKeyJid, ffs.NewJobID())
+
+	require.NoError(t, s.PauseRenewals(iid, true))
+	_, _, err := s.executeColdStorage(ctx, curr, sc.Cold, nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, cs.renewals)
+
+	require.NoError(t, s.PauseRenewals(iid, false))
+	_, _, err = s.executeColdStorage(ctx, curr, sc.Cold, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, cs.renewals)
+}
+
+func createScheduler(t *testing.T) (*Scheduler, cid.Cid) {
+	ds := tests.NewTxMapDatastore()
+	l := joblogger.New(txndstr.Wrap(ds, "joblogger"))
+	// Without execution slots queued jobs are never executed, so
+	// no hot or cold storage is needed.
+	s, err := New(ds, l, nil, nil, nil, 0, time.Minute, nil, GCConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+
+	c, err := cid.Decode("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	require.NoError(t, err)
+	return s, c
+}
+
+func track(t *testing.T, s *Scheduler, iid ffs.APIID, c cid.Cid) {
+	sc := trackedConfig()
+	require.NoError(t, sc.Validate())
+	require.NoError(t, s.ts.Put(iid, c, sc))
+}
+
+func trackedConfig() ffs.StorageConfig {
+	return ffs.StorageConfig{
+		Repairable: true,
+		Hot: ffs.HotConfig{
+			Enabled: true,
+			Ipfs:    ffs.IpfsConfig{AddTimeout: 30},
+		},
+		Cold: ffs.ColdConfig{
+			Enabled: true,
+			Filecoin: ffs.FilConfig{
+				RepFactor:       1,
+				DealMinDuration: util.MinDealDuration,
+				Addr:            "f01000",
+				Renew: ffs.FilRenew{
+					Enabled:   true,
+					Threshold: 1,
+				},
+			},
+		},
+	}
+}
+
+// createTrackingScheduler returns a scheduler tracking a different
+// Cid for each of two instances, since queued Jobs of a Cid are
+// canceled by newer ones.
+func createTrackingScheduler(t *testing.T) (*Scheduler, ffs.APIID, ffs.APIID) {
+	s, c1 := createScheduler(t)
+	c2, err := cid.Decode("QmPewMLNUWQ3mfBBd8nJ5xGmrt9MF2K5HaWHPNU8oFjUcE")
+	require.NoError(t, err)
+	iid1, iid2 := ffs.NewAPIID(), ffs.NewAPIID()
+	track(t, s, iid1, c1)
+	track(t, s, iid2, c2)
+	return s, iid1, iid2
+}
+
+// requirePushed asserts if a Job was pushed for an instance since the
+// last call, and cancels it.
+func requirePushed(t *testing.T, s *Scheduler, iid ffs.APIID, pushed bool) {
+	js, _, _, err := s.sjs.List(sjstore.ListConfig{APIIDFilter: iid, Select: sjstore.Queued})
+	require.NoError(t, err)
+	if !pushed {
+		require.Empty(t, js)
+		return
+	}
+	require.Len(t, js, 1)
+	j, err := s.sjs.Dequeue(iid)
+	require.NoError(t, err)
+	require.NoError(t, s.sjs.Finalize(j.ID, ffs.Canceled, nil, nil))
+}
+
+// renewalsColdStorage is a ColdStorage which counts renewal evaluations.
+type renewalsColdStorage struct {
+	ffs.ColdStorage
+	renewals int
+}
+
+func (rcs *renewalsColdStorage) EnsureRenewals(_ context.Context, _ cid.Cid, inf ffs.FilInfo, _ ffs.FilConfig, _ time.Duration, _ chan deals.StorageDealInfo) (ffs.FilInfo, []ffs.DealError, error) {
+	rcs.renewals++
+	return inf, nil, nil
+}
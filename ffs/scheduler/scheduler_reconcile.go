@@ -0,0 +1,266 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sm "github.com/filecoin-project/go-fil-markets/storagemarket"
+	market0 "github.com/filecoin-project/specs-actors/actors/builtin/market"
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
+)
+
+// DealIndex is the deals index reconciled with the Filecoin deals
+// tracked in StorageInfos.
+type DealIndex interface {
+	// ListStorageDealRecords lists storage deal records.
+	ListStorageDealRecords(opts ...deals.DealRecordsOption) ([]deals.StorageDealRecord, error)
+	// ImportStorageDealRecord saves a final record of a deal which
+	// wasn't made through the index.
+	ImportStorageDealRecord(dr deals.StorageDealRecord) error
+}
+
+// ReconciledDeal is a deal fixed by a reconciliation.
+type ReconciledDeal struct {
+	APIID  ffs.APIID
+	Cid    cid.Cid
+	DealID uint64
+}
+
+// ReconcileReport describes the fixes done by a reconciliation.
+type ReconcileReport struct {
+	// Indexed are deals tracked in a StorageInfo which were missing
+	// in the deals index, and were added to it.
+	Indexed []ReconciledDeal
+	// Tracked are deals in the deals index which were missing in the
+	// StorageInfo of the instance which made them, and were added to it.
+	Tracked []ReconciledDeal
+	// Errors are the problems found fixing discrepancies. They don't
+	// stop the reconciliation of other deals.
+	Errors []string
+}
+
+// Reconcile cross-checks the Filecoin deals of StorageInfos with
+// the deals index, and fixes deals known only by one of them. Only
+// deals which are active on-chain are fixed. Cids with an executing
+// Job are skipped, since their StorageInfo is about to change.
+func (s *Scheduler) Reconcile(ctx context.Context) (ReconcileReport, error) {
+	if s.di == nil {
+		return ReconcileReport{}, fmt.Errorf("no deals index configured")
+	}
+	var report ReconcileReport
+
+	records, err := s.di.ListStorageDealRecords(deals.WithIncludeFinal(true))
+	if err != nil {
+		return ReconcileReport{}, fmt.Errorf("listing deal records: %s", err)
+	}
+	indexed := map[uint64]struct{}{}
+	for _, r := range records {
+		if r.ErrMsg == "" && r.DealInfo.DealID != 0 {
+			indexed[r.DealInfo.DealID] = struct{}{}
+		}
+	}
+
+	sis, err := s.cis.List(nil, nil)
+	if err != nil {
+		return ReconcileReport{}, fmt.Errorf("listing storage infos: %s", err)
+	}
+	byCid := map[cid.Cid][]int{}
+	for i, si := range sis {
+		if s.sjs.GetExecutingJob(si.APIID, si.Cid) != nil {
+			continue
+		}
+		byCid[si.Cid] = append(byCid[si.Cid], i)
+		for _, p := range si.Cold.Filecoin.Proposals {
+			if _, ok := indexed[p.DealID]; ok {
+				continue
+			}
+			active, err := s.indexDeal(ctx, si, p.DealID)
+			if err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("indexing deal %d of %s: %s", p.DealID, si.Cid, err))
+				continue
+			}
+			if !active {
+				continue
+			}
+			indexed[p.DealID] = struct{}{}
+			report.Indexed = append(report.Indexed, ReconciledDeal{APIID: si.APIID, Cid: si.Cid, DealID: p.DealID})
+		}
+	}
+
+	for _, r := range records {
+		if r.ErrMsg != "" || r.DealInfo.DealID == 0 {
+			continue
+		}
+		for _, i := range byCid[r.RootCid] {
+			si := &sis[i]
+			ok, err := s.madeDeal(si, r)
+			if err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("checking deal %d of %s: %s", r.DealInfo.DealID, si.Cid, err))
+				continue
+			}
+			if !ok {
+				continue
+			}
+			fixed := *si
+			fixed.Cold.Filecoin.Proposals = append([]ffs.FilStorage(nil), si.Cold.Filecoin.Proposals...)
+			if err := s.augmentStorageInfo(&fixed.Cold.Filecoin, []uint64{r.DealInfo.DealID}); err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("tracking deal %d of %s: %s", r.DealInfo.DealID, si.Cid, err))
+				continue
+			}
+			// The StorageInfo listed above may be stale by now, so it's
+			// only saved if no Job changed it in the meantime.
+			saved, err := s.putIfUnchanged(*si, fixed)
+			if err != nil {
+				return report, err
+			}
+			if !saved {
+				report.Errors = append(report.Errors, fmt.Sprintf("tracking deal %d of %s: storage info changed during reconciliation", r.DealInfo.DealID, si.Cid))
+				continue
+			}
+			*si = fixed
+			report.Tracked = append(report.Tracked, ReconciledDeal{APIID: si.APIID, Cid: si.Cid, DealID: r.DealInfo.DealID})
+		}
+	}
+
+	return report, nil
+}
+
+func (s *Scheduler) execReconcileCron(ctx context.Context) {
+	report, err := s.Reconcile(ctx)
+	if err != nil {
+		log.Errorf("reconciling deals index: %s", err)
+		return
+	}
+	for _, d := range report.Indexed {
+		log.Infof("reconciliation added deal %d of %s (%s) to the deals index", d.DealID, d.Cid, d.APIID)
+	}
+	for _, d := range report.Tracked {
+		log.Infof("reconciliation added deal %d to storage info of %s (%s)", d.DealID, d.Cid, d.APIID)
+	}
+	for _, e := range report.Errors {
+		log.Warnf("reconciliation: %s", e)
+	}
+}
+
+// putIfUnchanged saves fixed if the stored StorageInfo still matches
+// the old one it was derived from, and no Job is executing for its Cid.
+// It returns false if the StorageInfo wasn't saved.
+func (s *Scheduler) putIfUnchanged(old, fixed ffs.StorageInfo) (bool, error) {
+	s.cisLock.Lock()
+	defer s.cisLock.Unlock()
+	if s.sjs.GetExecutingJob(old.APIID, old.Cid) != nil {
+		return false, nil
+	}
+	current, err := s.cis.Get(old.APIID, old.Cid)
+	if err != nil {
+		return false, fmt.Errorf("getting current storage info: %s", err)
+	}
+	if current.JobID != old.JobID || !sameDeals(current.Cold.Filecoin.Proposals, old.Cold.Filecoin.Proposals) {
+		return false, nil
+	}
+	if err := s.cis.Put(fixed); err != nil {
+		return false, fmt.Errorf("saving storage info: %s", err)
+	}
+	return true, nil
+}
+
+func sameDeals(a, b []ffs.FilStorage) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].DealID != b[i].DealID || a[i].Renewed != b[i].Renewed {
+			return false
+		}
+	}
+	return true
+}
+
+// indexDeal saves a deal record in the deals index from the on-chain
+// deal information. It returns false if the deal isn't active on-chain
+// yet, in which case nothing is saved.
+func (s *Scheduler) indexDeal(ctx context.Context, si ffs.StorageInfo, dealID uint64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+	md, err := s.cs.GetDealInfo(ctx, dealID)
+	if err != nil {
+		return false, fmt.Errorf("getting on-chain deal info: %s", err)
+	}
+	// SectorStartEpoch is -1 until the deal is included in a
+	// proven sector.
+	if md.State.SectorStartEpoch <= 0 {
+		return false, nil
+	}
+	// The signed proposal cid isn't available on-chain, so the
+	// on-chain proposal cid identifies the record.
+	prop := market0.DealProposal(md.Proposal)
+	propCid, err := prop.Cid()
+	if err != nil {
+		return false, fmt.Errorf("calculating proposal cid: %s", err)
+	}
+	addr, err := s.walletAddr(si)
+	if err != nil {
+		return false, err
+	}
+	if addr == "" {
+		addr = md.Proposal.Client.String()
+	}
+	dr := deals.StorageDealRecord{
+		RootCid: si.Cid,
+		Addr:    addr,
+		Time:    time.Now().Unix(),
+		DealInfo: deals.StorageDealInfo{
+			ProposalCid:     propCid,
+			StateID:         sm.StorageDealActive,
+			StateName:       sm.DealStates[sm.StorageDealActive],
+			Miner:           md.Proposal.Provider.String(),
+			PieceCID:        md.Proposal.PieceCID,
+			Size:            uint64(md.Proposal.PieceSize),
+			PricePerEpoch:   md.Proposal.StoragePricePerEpoch.Uint64(),
+			StartEpoch:      uint64(md.Proposal.StartEpoch),
+			Duration:        uint64(md.Proposal.EndEpoch - md.Proposal.StartEpoch + 1),
+			DealID:          dealID,
+			ActivationEpoch: int64(md.State.SectorStartEpoch),
+		},
+	}
+	if err := s.di.ImportStorageDealRecord(dr); err != nil {
+		return false, fmt.Errorf("importing deal record: %s", err)
+	}
+	return true, nil
+}
+
+// madeDeal returns true if the deal of the record was made by the
+// instance of the StorageInfo, and isn't already tracked by it.
+func (s *Scheduler) madeDeal(si *ffs.StorageInfo, r deals.StorageDealRecord) (bool, error) {
+	for _, p := range si.Cold.Filecoin.Proposals {
+		if p.DealID == r.DealInfo.DealID {
+			return false, nil
+		}
+	}
+	addr, err := s.walletAddr(*si)
+	if err != nil {
+		return false, err
+	}
+	return addr != "" && addr == r.Addr, nil
+}
+
+// walletAddr returns the wallet address used by the last Job of the
+// StorageInfo. If the StorageInfo wasn't created by a Job, an empty
+// address is returned.
+func (s *Scheduler) walletAddr(si ffs.StorageInfo) (string, error) {
+	if si.JobID == ffs.EmptyJobID {
+		return "", nil
+	}
+	a, err := s.as.GetStorageAction(si.JobID)
+	if err == astore.ErrNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("getting storage action: %s", err)
+	}
+	return a.Cfg.Cold.Filecoin.Addr, nil
+}
@@ -0,0 +1,201 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/actors/builtin/market"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/joblogger"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
+	"github.com/textileio/powergate/v2/tests"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+)
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	pieceCid, err := cid.Decode("baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq")
+	require.NoError(t, err)
+	cs := &onChainDeals{deals: map[uint64]api.MarketDeal{
+		100: marketDeal(t, pieceCid, 20),
+		200: marketDeal(t, pieceCid, 20),
+		// Deal 500 isn't in a proven sector yet.
+		500: marketDeal(t, pieceCid, -1),
+	}}
+	di := &dealIndex{}
+	s, c := createReconcileScheduler(t, cs, di)
+
+	// The instance made deal 100, tracked only in its StorageInfo,
+	// and deal 200, recorded only in the deals index.
+	iid := ffs.NewAPIID()
+	jid := ffs.NewJobID()
+	require.NoError(t, s.as.PutStorageAction(jid, astore.StorageAction{
+		APIID: iid,
+		Cid:   c,
+		Cfg:   ffs.StorageConfig{Cold: ffs.ColdConfig{Filecoin: ffs.FilConfig{Addr: "f3wallet"}}},
+	}))
+	require.NoError(t, s.cis.Put(ffs.StorageInfo{
+		APIID: iid,
+		JobID: jid,
+		Cid:   c,
+		Cold: ffs.ColdInfo{Filecoin: ffs.FilInfo{
+			DataCid:   c,
+			Proposals: []ffs.FilStorage{{DealID: 100, PieceCid: pieceCid}, {DealID: 500, PieceCid: pieceCid}},
+		}},
+	}))
+	di.records = append(di.records, deals.StorageDealRecord{
+		RootCid:  c,
+		Addr:     "f3wallet",
+		DealInfo: deals.StorageDealInfo{DealID: 200, PieceCID: pieceCid},
+	})
+	// Deals of other wallets and failed deals aren't tracked.
+	di.records = append(di.records, deals.StorageDealRecord{
+		RootCid:  c,
+		Addr:     "f3other",
+		DealInfo: deals.StorageDealInfo{DealID: 300, PieceCID: pieceCid},
+	}, deals.StorageDealRecord{
+		RootCid:  c,
+		Addr:     "f3wallet",
+		DealInfo: deals.StorageDealInfo{DealID: 400, PieceCID: pieceCid},
+		ErrMsg:   "deal failed",
+	})
+
+	report, err := s.Reconcile(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, report.Errors)
+	require.Equal(t, []ReconciledDeal{{APIID: iid, Cid: c, DealID: 100}}, report.Indexed)
+	require.Equal(t, []ReconciledDeal{{APIID: iid, Cid: c, DealID: 200}}, report.Tracked)
+
+	si, err := s.cis.Get(iid, c)
+	require.NoError(t, err)
+	require.Len(t, si.Cold.Filecoin.Proposals, 3)
+	require.Equal(t, uint64(200), si.Cold.Filecoin.Proposals[2].DealID)
+
+	recs, err := di.ListStorageDealRecords()
+	require.NoError(t, err)
+	require.Len(t, recs, 4)
+	require.Equal(t, uint64(100), recs[3].DealInfo.DealID)
+	require.Equal(t, "f3wallet", recs[3].Addr)
+	require.Equal(t, c, recs[3].RootCid)
+	require.True(t, recs[3].DealInfo.ProposalCid.Defined())
+	require.Equal(t, int64(20), recs[3].DealInfo.ActivationEpoch)
+
+	// Once reconciled, there's nothing left to fix.
+	report, err = s.Reconcile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, ReconcileReport{}, report)
+}
+
+func TestReconcileSkipsChangedStorageInfo(t *testing.T) {
+	t.Parallel()
+
+	pieceCid, err := cid.Decode("baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq")
+	require.NoError(t, err)
+	cs := &onChainDeals{deals: map[uint64]api.MarketDeal{200: marketDeal(t, pieceCid, 20)}}
+	s, c := createReconcileScheduler(t, cs, &dealIndex{})
+
+	iid := ffs.NewAPIID()
+	jid := ffs.NewJobID()
+	require.NoError(t, s.as.PutStorageAction(jid, astore.StorageAction{
+		APIID: iid,
+		Cid:   c,
+		Cfg:   ffs.StorageConfig{Cold: ffs.ColdConfig{Filecoin: ffs.FilConfig{Addr: "f3wallet"}}},
+	}))
+	old := ffs.StorageInfo{APIID: iid, JobID: jid, Cid: c, Cold: ffs.ColdInfo{Filecoin: ffs.FilInfo{DataCid: c}}}
+	require.NoError(t, s.cis.Put(old))
+
+	// A Job saves a new StorageInfo after the reconciliation listed it.
+	changed := old
+	changed.JobID = ffs.NewJobID()
+	require.NoError(t, s.cis.Put(changed))
+
+	fixed := old
+	require.NoError(t, s.augmentStorageInfo(&fixed.Cold.Filecoin, []uint64{200}))
+	saved, err := s.putIfUnchanged(old, fixed)
+	require.NoError(t, err)
+	require.False(t, saved)
+
+	si, err := s.cis.Get(iid, c)
+	require.NoError(t, err)
+	require.Equal(t, changed.JobID, si.JobID)
+	require.Empty(t, si.Cold.Filecoin.Proposals)
+}
+
+func TestReconcileWithoutDealIndex(t *testing.T) {
+	t.Parallel()
+	s, _ := createScheduler(t)
+	_, err := s.Reconcile(context.Background())
+	require.Error(t, err)
+}
+
+func createReconcileScheduler(t *testing.T, cs ffs.ColdStorage, di DealIndex) (*Scheduler, cid.Cid) {
+	ds := tests.NewTxMapDatastore()
+	l := joblogger.New(txndstr.Wrap(ds, "joblogger"))
+	s, err := New(ds, l, nil, cs, di, 0, time.Minute, nil, GCConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+
+	c, err := cid.Decode("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	require.NoError(t, err)
+	return s, c
+}
+
+func marketDeal(t *testing.T, pieceCid cid.Cid, sectorStart abi.ChainEpoch) api.MarketDeal {
+	client, err := address.NewIDAddress(1000)
+	require.NoError(t, err)
+	provider, err := address.NewIDAddress(2000)
+	require.NoError(t, err)
+	return api.MarketDeal{
+		Proposal: market.DealProposal{
+			PieceCID:             pieceCid,
+			PieceSize:            abi.PaddedPieceSize(1024),
+			Client:               client,
+			Provider:             provider,
+			StartEpoch:           10,
+			EndEpoch:             1000,
+			StoragePricePerEpoch: abi.NewTokenAmount(5),
+		},
+		State: market.DealState{SectorStartEpoch: sectorStart},
+	}
+}
+
+// onChainDeals is a ColdStorage which only knows about on-chain deals.
+type onChainDeals struct {
+	ffs.ColdStorage
+	deals map[uint64]api.MarketDeal
+}
+
+func (ocd *onChainDeals) GetDealInfo(_ context.Context, dealID uint64) (api.MarketDeal, error) {
+	md, ok := ocd.deals[dealID]
+	if !ok {
+		return api.MarketDeal{}, ffs.ErrOnChainDealNotFound
+	}
+	return md, nil
+}
+
+type dealIndex struct {
+	lock    sync.Mutex
+	records []deals.StorageDealRecord
+}
+
+func (di *dealIndex) ListStorageDealRecords(_ ...deals.DealRecordsOption) ([]deals.StorageDealRecord, error) {
+	di.lock.Lock()
+	defer di.lock.Unlock()
+	return append([]deals.StorageDealRecord(nil), di.records...), nil
+}
+
+func (di *dealIndex) ImportStorageDealRecord(dr deals.StorageDealRecord) error {
+	di.lock.Lock()
+	defer di.lock.Unlock()
+	di.records = append(di.records, dr)
+	return nil
+}
@@ -3,8 +3,11 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/deals"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/ristore"
@@ -41,6 +44,7 @@ func (s *Scheduler) StartRetrieval(iid ffs.APIID, rid ffs.RetrievalID, pyCid, pi
 
 	ctx := context.WithValue(context.Background(), ffs.CtxKeyJid, jid)
 	ctx = context.WithValue(ctx, ffs.CtxRetrievalID, rid)
+	ctx = context.WithValue(ctx, ffs.CtxAPIID, iid)
 	s.l.Log(ctx, "Scheduling new retrieval...")
 
 	ra := astore.RetrievalAction{
@@ -83,9 +87,7 @@ func (s *Scheduler) GetRetrievalInfo(rid ffs.RetrievalID) (ffs.RetrievalInfo, er
 }
 
 func (s *Scheduler) executeRetrieval(ctx context.Context, a astore.RetrievalAction, j ffs.RetrievalJob) (ffs.RetrievalInfo, error) {
-	// ToDo: WIP.
-	_ = j
-	fi, err := s.cs.Fetch(ctx, a.PayloadCid, &a.PieceCid, a.WalletAddress, a.Miners, a.MaxPrice, a.Selector)
+	fi, err := s.fetch(ctx, a, j)
 	if err != nil {
 		return ffs.RetrievalInfo{}, fmt.Errorf("fetching from cold storage: %s", err)
 	}
@@ -107,3 +109,104 @@ func (s *Scheduler) executeRetrieval(ctx context.Context, a astore.RetrievalActi
 
 	return ri, nil
 }
+
+// prefetchQueuedRetrievals prepares queued retrievals which weren't
+// prefetched yet, warming up connections with their miners and checking
+// which of them have unsealed copies of the data.
+func (s *Scheduler) prefetchQueuedRetrievals(ctx context.Context, pf ffs.RetrievalPrefetcher) {
+	js, err := s.rjs.Queued()
+	if err != nil {
+		log.Errorf("getting queued retrievals: %s", err)
+		return
+	}
+	for _, j := range js {
+		if ctx.Err() != nil {
+			return
+		}
+		s.rd.prefetchLock.Lock()
+		_, ok := s.rd.prefetched[j.ID]
+		s.rd.prefetchLock.Unlock()
+		if ok {
+			continue
+		}
+
+		a, err := s.as.GetRetrievalAction(j.ID)
+		if err != nil {
+			log.Errorf("getting retrieval action of job %s: %s", j.ID, err)
+			continue
+		}
+		jctx := context.WithValue(ctx, ffs.CtxKeyJid, j.ID)
+		jctx = context.WithValue(jctx, ffs.CtxRetrievalID, j.RetrievalID)
+		jctx = context.WithValue(jctx, ffs.CtxAPIID, j.APIID)
+		pctx, cancel := context.WithTimeout(jctx, time.Minute)
+		avs, err := pf.PrefetchRetrieval(pctx, a.PayloadCid, &a.PieceCid, a.Miners)
+		cancel()
+		if err != nil {
+			log.Warnf("prefetching retrieval of job %s: %s", j.ID, err)
+			continue
+		}
+		for _, av := range avs {
+			switch {
+			case av.Err != "":
+				s.l.Log(jctx, "Prefetch: miner %s can't serve the retrieval: %s", av.Miner, av.Err)
+			case av.Unsealed:
+				s.l.Log(jctx, "Prefetch: miner %s has an unsealed copy", av.Miner)
+			default:
+				s.l.Log(jctx, "Prefetch: miner %s needs to unseal the data", av.Miner)
+			}
+		}
+
+		// The retrieval may have started while prefetching, in which
+		// case nothing would remove the result.
+		s.rd.prefetchLock.Lock()
+		if cj, err := s.rjs.Get(j.ID); err == nil && cj.Status == ffs.Queued {
+			s.rd.prefetched[j.ID] = avs
+		}
+		s.rd.prefetchLock.Unlock()
+	}
+}
+
+// fetch fetches the data of a retrieval. If the retrieval was prefetched,
+// the prefetched offers are used, trying miners with unsealed copies
+// first. Otherwise, the miners are queried again.
+func (s *Scheduler) fetch(ctx context.Context, a astore.RetrievalAction, j ffs.RetrievalJob) (ffs.FetchInfo, error) {
+	pf, ok := s.cs.(ffs.RetrievalPrefetcher)
+	if ok {
+		if avs := s.prefetchedAvailability(j.ID); len(avs) > 0 {
+			return pf.FetchPrefetched(ctx, a.PayloadCid, a.WalletAddress, avs, a.MaxPrice, a.Selector)
+		}
+	}
+	return s.cs.Fetch(ctx, a.PayloadCid, &a.PieceCid, a.WalletAddress, a.Miners, a.MaxPrice, a.Selector)
+}
+
+// prefetchedAvailability returns the prefetched miners of a retrieval which
+// can serve it, sorted by availability: miners with unsealed copies first,
+// and then by price. If the retrieval wasn't prefetched, or none of its
+// miners can serve it, nil is returned.
+func (s *Scheduler) prefetchedAvailability(jid ffs.JobID) []deals.RetrievalAvailability {
+	s.rd.prefetchLock.Lock()
+	avs := s.rd.prefetched[jid]
+	delete(s.rd.prefetched, jid)
+	s.rd.prefetchLock.Unlock()
+
+	var ret []deals.RetrievalAvailability
+	for _, av := range avs {
+		if av.Err == "" && av.Offer != nil {
+			ret = append(ret, av)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Unsealed != ret[j].Unsealed {
+			return ret[i].Unsealed
+		}
+		return ret[i].MinPrice < ret[j].MinPrice
+	})
+	return ret
+}
+
+// forgetPrefetched removes the prefetched availability of a retrieval.
+func (s *Scheduler) forgetPrefetched(jid ffs.JobID) {
+	s.rd.prefetchLock.Lock()
+	delete(s.rd.prefetched, jid)
+	s.rd.prefetchLock.Unlock()
+}
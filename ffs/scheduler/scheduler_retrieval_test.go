@@ -0,0 +1,117 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/joblogger"
+	"github.com/textileio/powergate/v2/tests"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+)
+
+func TestPrefetchQueuedRetrievals(t *testing.T) {
+	t.Parallel()
+	pf := &prefetcher{avs: []deals.RetrievalAvailability{
+		{Miner: "f01", Err: "no data"},
+		{Miner: "f02", MinPrice: 10, Offer: &api.QueryOffer{}},
+		{Miner: "f03", MinPrice: 20, Unsealed: true, Offer: &api.QueryOffer{}},
+		{Miner: "f04", MinPrice: 5, Offer: &api.QueryOffer{}},
+	}}
+	ds := tests.NewTxMapDatastore()
+	l := joblogger.New(txndstr.Wrap(ds, "joblogger"))
+	// Without execution slots retrievals stay queued.
+	s, err := New(ds, l, nil, pf, nil, 0, time.Minute, nil, GCConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+
+	c, err := cid.Decode("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	require.NoError(t, err)
+	miners := []string{"f01", "f02", "f03", "f04"}
+	jid, err := s.StartRetrieval(ffs.NewAPIID(), ffs.NewRetrievalID(), c, c, "", miners, "f3wallet", 0)
+	require.NoError(t, err)
+
+	// The queued retrieval is prefetched in the background.
+	require.Eventually(t, func() bool {
+		s.rd.prefetchLock.Lock()
+		defer s.rd.prefetchLock.Unlock()
+		_, ok := s.rd.prefetched[jid]
+		return ok
+	}, time.Second*5, time.Millisecond*50)
+	require.Equal(t, 1, pf.count())
+	require.Equal(t, miners, pf.lastMiners())
+
+	// Prefetched retrievals aren't prefetched again.
+	s.prefetchQueuedRetrievals(context.Background(), pf)
+	require.Equal(t, 1, pf.count())
+
+	// Executing the retrieval uses the prefetched offers, trying unsealed
+	// copies first, and skipping miners which can't serve it.
+	j, err := s.rjs.Dequeue()
+	require.NoError(t, err)
+	require.Equal(t, jid, j.ID)
+	s.executeQueuedRetrievals(*j)
+	require.Equal(t, []string{"f03", "f04", "f02"}, pf.fetchedMiners())
+
+	// The prefetched offers are forgotten once the Job is final.
+	s.rd.prefetchLock.Lock()
+	require.Empty(t, s.rd.prefetched)
+	s.rd.prefetchLock.Unlock()
+	rj, err := s.rjs.Get(jid)
+	require.NoError(t, err)
+	require.Equal(t, ffs.Failed, rj.Status)
+}
+
+// prefetcher is a ColdStorage which only prefetches retrievals.
+type prefetcher struct {
+	ffs.ColdStorage
+	avs []deals.RetrievalAvailability
+
+	lock    sync.Mutex
+	calls   int
+	miners  []string
+	fetched []string
+}
+
+func (p *prefetcher) PrefetchRetrieval(_ context.Context, _ cid.Cid, _ *cid.Cid, miners []string) ([]deals.RetrievalAvailability, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.calls++
+	p.miners = miners
+	return p.avs, nil
+}
+
+func (p *prefetcher) FetchPrefetched(_ context.Context, _ cid.Cid, _ string, avs []deals.RetrievalAvailability, _ uint64, _ string) (ffs.FetchInfo, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.fetched = nil
+	for _, av := range avs {
+		p.fetched = append(p.fetched, av.Miner)
+	}
+	return ffs.FetchInfo{}, fmt.Errorf("retrieval failed")
+}
+
+func (p *prefetcher) fetchedMiners() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.fetched
+}
+
+func (p *prefetcher) count() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.calls
+}
+
+func (p *prefetcher) lastMiners() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.miners
+}
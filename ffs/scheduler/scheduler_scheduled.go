@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/spstore"
+)
+
+// ScheduledPushExecutor executes scheduled pushes once they're due. The
+// StorageConfig of a scheduled push is only applied to its instance when
+// it's executed, so the executor is the owner of instance configs.
+type ScheduledPushExecutor interface {
+	ExecuteScheduledPush(ffs.ScheduledPush) (ffs.JobID, error)
+}
+
+// SetScheduledPushExecutor sets the executor of due scheduled pushes.
+// Until it's set, due scheduled pushes are kept pending.
+func (s *Scheduler) SetScheduledPushExecutor(e ScheduledPushExecutor) {
+	s.speLock.Lock()
+	defer s.speLock.Unlock()
+	s.spe = e
+}
+
+// SchedulePush registers a StorageConfig to be pushed as a new Job at sp.ExecuteAt.
+// Scheduled pushes are persisted, so they survive restarts. If the execution time
+// is already due when evaluated, the push is executed in the next evaluation round.
+// The ID and creation time of sp are set by the Scheduler.
+func (s *Scheduler) SchedulePush(sp ffs.ScheduledPush) (ffs.ScheduledPushID, error) {
+	if !sp.Cid.Defined() {
+		return "", fmt.Errorf("cid can't be undefined")
+	}
+	if sp.APIID == ffs.EmptyInstanceID {
+		return "", fmt.Errorf("empty API ID")
+	}
+	if err := sp.Config.Validate(); err != nil {
+		return "", fmt.Errorf("validating storage config: %s", err)
+	}
+	sp.ID = ffs.NewScheduledPushID()
+	sp.CreatedAt = s.now()
+	if err := s.sps.Put(sp); err != nil {
+		return "", fmt.Errorf("saving scheduled push: %s", err)
+	}
+
+	ctx := context.WithValue(context.Background(), ffs.CtxStorageCid, sp.Cid)
+	ctx = context.WithValue(ctx, ffs.CtxAPIID, sp.APIID)
+	s.l.Log(ctx, "Push scheduled for %s.", sp.ExecuteAt.Format(time.RFC3339))
+
+	return sp.ID, nil
+}
+
+// ListScheduledPushes returns the pending scheduled pushes of an instance
+// sorted by execution time.
+func (s *Scheduler) ListScheduledPushes(iid ffs.APIID) ([]ffs.ScheduledPush, error) {
+	sps, err := s.sps.List(iid)
+	if err != nil {
+		return nil, fmt.Errorf("listing scheduled pushes: %s", err)
+	}
+	return sps, nil
+}
+
+// CancelScheduledPush removes a pending scheduled push of an instance.
+// If it doesn't exist or was already executed, returns ErrNotFound.
+func (s *Scheduler) CancelScheduledPush(iid ffs.APIID, id ffs.ScheduledPushID) error {
+	sp, err := s.sps.Get(id)
+	if err == spstore.ErrNotFound || (err == nil && sp.APIID != iid) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("getting scheduled push: %s", err)
+	}
+	if err := s.sps.Remove(id); err == spstore.ErrNotFound {
+		return ErrNotFound
+	} else if err != nil {
+		return fmt.Errorf("removing scheduled push: %s", err)
+	}
+	return nil
+}
+
+// execScheduledPushes executes every scheduled push which is due at time now,
+// and removes it from the pending list.
+func (s *Scheduler) execScheduledPushes(ctx context.Context, now time.Time) {
+	s.speLock.Lock()
+	spe := s.spe
+	s.speLock.Unlock()
+	if spe == nil {
+		log.Warnf("no executor of scheduled pushes is set")
+		return
+	}
+
+	due, err := s.sps.Due(now)
+	if err != nil {
+		log.Errorf("getting due scheduled pushes from store: %s", err)
+		return
+	}
+	for _, sp := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		lCtx := context.WithValue(ctx, ffs.CtxStorageCid, sp.Cid)
+		lCtx = context.WithValue(lCtx, ffs.CtxAPIID, sp.APIID)
+		jid, err := spe.ExecuteScheduledPush(sp)
+		if err != nil {
+			s.l.Log(lCtx, "Executing scheduled push errored: %s", err)
+		} else {
+			s.l.Log(lCtx, "Scheduled push executed as job %s.", jid)
+		}
+		if err := s.sps.Remove(sp.ID); err != nil && err != spstore.ErrNotFound {
+			log.Errorf("removing executed scheduled push %s: %s", sp.ID, err)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/joblogger"
+	"github.com/textileio/powergate/v2/tests"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestScheduledPushes(t *testing.T) {
+	t.Parallel()
+
+	ds := tests.NewTxMapDatastore()
+	l := joblogger.New(txndstr.Wrap(ds, "joblogger"))
+	s, err := New(ds, l, nil, nil, nil, 0, time.Minute, nil, GCConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+	clock := &fakeClock{now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+	s.now = clock.Now
+
+	c, err := cid.Decode("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	require.NoError(t, err)
+	iid := ffs.NewAPIID()
+	cfg := ffs.StorageConfig{
+		Hot: ffs.HotConfig{Ipfs: ffs.IpfsConfig{AddTimeout: 30}},
+		Cold: ffs.ColdConfig{
+			Enabled: true,
+			Filecoin: ffs.FilConfig{
+				RepFactor:       1,
+				DealMinDuration: util.MinDealDuration,
+				Addr:            "f01000",
+			},
+		},
+	}
+	ctx := context.Background()
+
+	spid, err := s.SchedulePush(ffs.ScheduledPush{APIID: iid, Cid: c, Config: cfg, Priority: 5, ExecuteAt: clock.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	canceled, err := s.SchedulePush(ffs.ScheduledPush{APIID: iid, Cid: c, Config: cfg, ExecuteAt: clock.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	require.NoError(t, s.CancelScheduledPush(iid, canceled))
+	require.Equal(t, ErrNotFound, s.CancelScheduledPush(iid, canceled))
+
+	// Without an executor, due pushes are kept pending.
+	clock.Add(time.Hour * 2)
+	s.execScheduledPushes(ctx, s.now())
+	sps, err := s.ListScheduledPushes(iid)
+	require.NoError(t, err)
+	require.Len(t, sps, 1)
+
+	e := &recordingExecutor{}
+	s.SetScheduledPushExecutor(e)
+	clock.Add(-time.Hour * 2)
+	s.execScheduledPushes(ctx, s.now())
+	require.Empty(t, e.executed)
+
+	// The push is executed once it's due, with its config and priority.
+	clock.Add(time.Hour)
+	s.execScheduledPushes(ctx, s.now())
+	require.Len(t, e.executed, 1)
+	require.Equal(t, spid, e.executed[0].ID)
+	require.Equal(t, cfg, e.executed[0].Config)
+	require.Equal(t, 5, e.executed[0].Priority)
+	sps, err = s.ListScheduledPushes(iid)
+	require.NoError(t, err)
+	require.Empty(t, sps)
+
+	s.execScheduledPushes(ctx, s.now())
+	require.Len(t, e.executed, 1)
+}
+
+type fakeClock struct {
+	lock sync.Mutex
+	now  time.Time
+}
+
+func (fc *fakeClock) Now() time.Time {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+	return fc.now
+}
+
+func (fc *fakeClock) Add(d time.Duration) {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+	fc.now = fc.now.Add(d)
+}
+
+type recordingExecutor struct {
+	executed []ffs.ScheduledPush
+}
+
+func (re *recordingExecutor) ExecuteScheduledPush(sp ffs.ScheduledPush) (ffs.JobID, error) {
+	re.executed = append(re.executed, sp)
+	return ffs.NewJobID(), nil
+}
@@ -18,7 +18,13 @@ import (
 // PushConfig queues the specified StorageConfig to be executed as a new Job. It returns
 // the created JobID for further tracking of its state.
 func (s *Scheduler) PushConfig(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig) (ffs.JobID, error) {
-	return s.push(iid, c, cfg, cid.Undef)
+	return s.push(iid, c, cfg, cid.Undef, 0)
+}
+
+// PushConfigWithPriority is like PushConfig, but the created Job is executed
+// ahead of other queued Jobs with lower priority.
+func (s *Scheduler) PushConfigWithPriority(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, priority int) (ffs.JobID, error) {
+	return s.push(iid, c, cfg, cid.Undef, priority)
 }
 
 // PushReplace queues a new StorageConfig to be executed as a new Job, replacing an oldCid that will be
@@ -27,10 +33,10 @@ func (s *Scheduler) PushReplace(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig,
 	if !oldCid.Defined() {
 		return ffs.EmptyJobID, fmt.Errorf("cid can't be undefined")
 	}
-	return s.push(iid, c, cfg, oldCid)
+	return s.push(iid, c, cfg, oldCid, 0)
 }
 
-func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid cid.Cid) (ffs.JobID, error) {
+func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid cid.Cid, priority int) (ffs.JobID, error) {
 	if !c.Defined() {
 		return ffs.EmptyJobID, fmt.Errorf("cid can't be undefined")
 	}
@@ -47,6 +53,7 @@ func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid
 		Cid:       c,
 		Status:    ffs.Queued,
 		CreatedAt: time.Now().Unix(),
+		Priority:  priority,
 	}
 
 	ctx := context.WithValue(context.Background(), ffs.CtxKeyJid, jid)
@@ -114,6 +121,45 @@ func (s *Scheduler) ListStorageInfo(iids []ffs.APIID, cids []cid.Cid) ([]ffs.Sto
 	return res, nil
 }
 
+// BillingReport returns the spend of the active deals of the provided
+// instances aggregated by cost center. If iids is empty, the deals of
+// every instance are included.
+func (s *Scheduler) BillingReport(iids []ffs.APIID) ([]ffs.CostCenterSpend, error) {
+	infos, err := s.cis.List(iids, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing storage info from cistore: %v", err)
+	}
+	return ffs.BillingReport(infos), nil
+}
+
+// FailedStorageCounts returns, for each instance, the number of Cids
+// whose latest finished StorageJob failed.
+func (s *Scheduler) FailedStorageCounts() map[ffs.APIID]int {
+	return s.sjs.GetFailedCounts()
+}
+
+// PrunedJobsSummary contains the number of pruned StorageJobs
+// of an instance by final status.
+type PrunedJobsSummary struct {
+	Success  int
+	Failed   int
+	Canceled int
+}
+
+// PrunedJobsSummary returns the accounting of StorageJobs of an instance
+// that were pruned from the Job history.
+func (s *Scheduler) PrunedJobsSummary(iid ffs.APIID) (PrunedJobsSummary, error) {
+	ps, err := s.sjs.GetPruneSummary(iid)
+	if err != nil {
+		return PrunedJobsSummary{}, fmt.Errorf("getting prune summary from store: %s", err)
+	}
+	return PrunedJobsSummary{
+		Success:  ps.Success,
+		Failed:   ps.Failed,
+		Canceled: ps.Canceled,
+	}, nil
+}
+
 // StorageJob the current storage state of a Job.
 func (s *Scheduler) StorageJob(jid ffs.JobID) (ffs.StorageJob, error) {
 	j, err := s.sjs.Get(jid)
@@ -147,6 +193,15 @@ func (s *Scheduler) GetLogsByCid(ctx context.Context, iid ffs.APIID, c cid.Cid)
 	return lgs, nil
 }
 
+// GetLogsByCidPage returns a page of history logs of a Cid.
+func (s *Scheduler) GetLogsByCidPage(ctx context.Context, iid ffs.APIID, c cid.Cid, offset, limit int) ([]ffs.LogEntry, error) {
+	lgs, err := s.l.GetByCidPage(ctx, iid, c, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("getting logs page: %s", err)
+	}
+	return lgs, nil
+}
+
 // Select specifies which StorageJobs to list.
 type Select int
 
@@ -220,7 +275,9 @@ func (s *Scheduler) executeStorage(ctx context.Context, a astore.StorageAction,
 	var hot ffs.HotInfo
 	if a.Cfg.Hot.Enabled {
 		s.l.Log(ctx, "Executing Hot-Storage configuration...")
-		hot, err = s.executeEnabledHotStorage(ctx, a.APIID, ci, a.Cfg.Hot, a.Cfg.Cold.Filecoin.Addr, a.ReplacedCid)
+		stageCtx, span := tracer.Start(ctx, "stage")
+		hot, err = s.executeEnabledHotStorage(stageCtx, a.APIID, ci, a.Cfg.Hot, a.Cfg.Cold.Filecoin.Addr, a.ReplacedCid)
+		endSpan(span, err)
 		if err != nil {
 			s.l.Log(ctx, "Enabled Hot-Storage excution failed.")
 			return ffs.StorageInfo{}, nil, fmt.Errorf("executing enabled hot-storage: %s", err)
@@ -235,13 +292,18 @@ func (s *Scheduler) executeStorage(ctx context.Context, a astore.StorageAction,
 		s.l.Log(ctx, "Automatically staging Cid from the IPFS network...")
 		stageCtx, cancel := context.WithTimeout(ctx, time.Duration(a.Cfg.Hot.Ipfs.AddTimeout)*time.Second)
 		defer cancel()
-		if err := s.hs.StageCid(stageCtx, a.APIID, a.Cid); err != nil {
+		stageCtx, span := tracer.Start(stageCtx, "stage")
+		err = s.hs.StageCid(stageCtx, a.APIID, a.Cid)
+		endSpan(span, err)
+		if err != nil {
 			return ffs.StorageInfo{}, nil, fmt.Errorf("automatically staging cid: %s", err)
 		}
 	}
 
 	s.l.Log(ctx, "Executing Cold-Storage configuration...")
-	cold, errors, err := s.executeColdStorage(ctx, ci, a.Cfg.Cold, dealUpdates)
+	coldCtx, span := tracer.Start(ctx, "cold storage")
+	cold, errors, err := s.executeColdStorage(coldCtx, ci, a.Cfg.Cold, dealUpdates)
+	endSpan(span, err)
 	if err != nil {
 		s.l.Log(ctx, "Cold-Storage execution failed.")
 		return ffs.StorageInfo{}, errors, fmt.Errorf("executing cold-storage config: %s", err)
@@ -409,7 +471,8 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	var allErrors []ffs.DealError
 	if len(sds) > 0 {
 		s.l.Log(ctx, "Resuming %d dettached executing deals...", len(sds))
-		okResumedDeals, failedResumedDeals := s.waitForDeals(ctx, curr.Cid, sds, dealUpdates)
+		okResumedDeals, failedResumedDeals := s.waitForDeals(ctx, curr.Cid, sds, cfg.Filecoin.DealAcceptanceTimeout, dealUpdates)
+		setCostCenter(okResumedDeals, cfg.Filecoin.CostCenter)
 		s.l.Log(ctx, "A total of %d resumed deals finished successfully", len(okResumedDeals))
 		allErrors = append(allErrors, failedResumedDeals...)
 		// Append the resumed and confirmed deals to the current active proposals
@@ -423,7 +486,9 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 
 	// 2. If this Storage Config is renewable, then let's check if any of the existing deals
 	// should be renewed, and do it.
-	if cfg.Filecoin.Renew.Enabled {
+	if cfg.Filecoin.Renew.Enabled && s.renewalsPaused(curr.APIID) {
+		s.l.Log(ctx, "Deal renewals are paused for this instance, skipping renewals check.")
+	} else if cfg.Filecoin.Renew.Enabled {
 		if curr.Hot.Enabled {
 			s.l.Log(ctx, "Checking deal renewals...")
 			newFilInfo, errors, err := s.cs.EnsureRenewals(ctx, curr.Cid, curr.Cold.Filecoin, cfg.Filecoin, s.dealFinalityTimeout, dealUpdates)
@@ -484,7 +549,7 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	// The answer is yes, calculate how many extra deals we need and create them.
 	deltaFilConfig := createDeltaFilConfig(cfg, curr.Cold.Filecoin)
 	s.l.Log(ctx, "Current replication factor is lower than desired, making %d new deals...", deltaFilConfig.RepFactor)
-	startedProposals, rejectedProposals, size, err := s.cs.Store(ctx, curr.Cid, deltaFilConfig)
+	startedProposals, rejectedProposals, pinf, err := s.cs.Store(ctx, curr.Cid, curr.Cold.Filecoin, deltaFilConfig)
 	if err != nil {
 		s.l.Log(ctx, "Starting deals failed, with cause: %s", err)
 		return ffs.ColdInfo{}, rejectedProposals, err
@@ -503,7 +568,8 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	}
 
 	// Wait for started deals.
-	okDeals, failedDeals := s.waitForDeals(ctx, curr.Cid, startedProposals, dealUpdates)
+	okDeals, failedDeals := s.waitForDeals(ctx, curr.Cid, startedProposals, cfg.Filecoin.DealAcceptanceTimeout, dealUpdates)
+	setCostCenter(okDeals, cfg.Filecoin.CostCenter)
 	allErrors = append(allErrors, failedDeals...)
 	if err := s.sjs.RemoveStartedDeals(curr.APIID, curr.Cid); err != nil {
 		return ffs.ColdInfo{}, allErrors, fmt.Errorf("removing temporal started deals storage: %s", err)
@@ -519,14 +585,15 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	return ffs.ColdInfo{
 		Enabled: true,
 		Filecoin: ffs.FilInfo{
-			DataCid:   curr.Cid,
-			Size:      uint64(size),
-			Proposals: append(okDeals, curr.Cold.Filecoin.Proposals...), // Append to any existing other proposals
+			DataCid:     curr.Cid,
+			Size:        pinf.Size,
+			PayloadSize: pinf.PayloadSize,
+			Proposals:   append(okDeals, curr.Cold.Filecoin.Proposals...), // Append to any existing other proposals
 		},
 	}, allErrors, nil
 }
 
-func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposals []cid.Cid, dealUpdates chan deals.StorageDealInfo) ([]ffs.FilStorage, []ffs.DealError) {
+func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposals []cid.Cid, acceptanceTimeout int64, dealUpdates chan deals.StorageDealInfo) ([]ffs.FilStorage, []ffs.DealError) {
 	s.l.Log(ctx, "Watching deals unfold...")
 
 	var failedDeals []ffs.DealError
@@ -539,7 +606,7 @@ func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposal
 		go func() {
 			defer wg.Done()
 
-			res, err := s.cs.WaitForDeal(ctx, c, pc, s.dealFinalityTimeout, dealUpdates)
+			res, err := s.cs.WaitForDeal(ctx, c, pc, s.dealFinalityTimeout, time.Duration(acceptanceTimeout)*time.Second, dealUpdates)
 			var dealError ffs.DealError
 			if err != nil {
 				if !errors.As(err, &dealError) {
@@ -562,11 +629,22 @@ func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposal
 	return okDeals, failedDeals
 }
 
+// setCostCenter records the cost center of the config creating deals.
+func setCostCenter(fss []ffs.FilStorage, costCenter string) {
+	for i := range fss {
+		fss[i].CostCenter = costCenter
+	}
+}
+
 func createDeltaFilConfig(cfg ffs.ColdConfig, curr ffs.FilInfo) ffs.FilConfig {
 	res := cfg.Filecoin
 	res.RepFactor = cfg.Filecoin.RepFactor - len(curr.Proposals)
 	for _, p := range curr.Proposals {
 		res.ExcludedMiners = append(res.ExcludedMiners, p.Miner)
+		// Existing verified deals count towards the minimum.
+		if p.Verified && res.MinVerifiedReplicas > 0 {
+			res.MinVerifiedReplicas--
+		}
 	}
 	return res
 }
@@ -0,0 +1,184 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/joblogger"
+	"github.com/textileio/powergate/v2/tests"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+	"github.com/textileio/powergate/v2/util"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/oteltest"
+)
+
+func TestStorageJobSpans(t *testing.T) {
+	t.Parallel()
+	sr := tests.RecordSpans()
+
+	ds := tests.NewTxMapDatastore()
+	l := joblogger.New(txndstr.Wrap(ds, "joblogger"))
+	s, err := New(ds, l, &stagingHotStorage{}, &dealingColdStorage{}, nil, 1, time.Minute, nil, GCConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+
+	c, err := cid.Decode("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	require.NoError(t, err)
+	cfg := ffs.StorageConfig{
+		Hot: ffs.HotConfig{Ipfs: ffs.IpfsConfig{AddTimeout: 30}},
+		Cold: ffs.ColdConfig{
+			Enabled: true,
+			Filecoin: ffs.FilConfig{
+				RepFactor:       1,
+				DealMinDuration: util.MinDealDuration,
+				Addr:            "f01000",
+			},
+		},
+	}
+	jid, err := s.PushConfig(ffs.NewAPIID(), c, cfg)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		j, err := s.StorageJob(jid)
+		return err == nil && j.Status == ffs.Success
+	}, time.Second*10, time.Millisecond*50)
+
+	var job *oteltest.Span
+	require.Eventually(t, func() bool {
+		job = jobSpan(sr, jid)
+		return job != nil
+	}, time.Second*5, time.Millisecond*50)
+
+	// Every phase is traced as a child of the Job span.
+	spans := tests.TraceSpans(sr, job.SpanContext().TraceID())
+	tests.RequireSpan(t, spans, "stage", job.SpanContext().SpanID())
+	tests.RequireSpan(t, spans, "cold storage", job.SpanContext().SpanID())
+}
+
+func TestBillingReport(t *testing.T) {
+	t.Parallel()
+
+	ds := tests.NewTxMapDatastore()
+	l := joblogger.New(txndstr.Wrap(ds, "joblogger"))
+	s, err := New(ds, l, &stagingHotStorage{}, &dealingColdStorage{}, nil, 1, time.Minute, nil, GCConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+
+	iid1, iid2 := ffs.NewAPIID(), ffs.NewAPIID()
+	pushes := []struct {
+		iid        ffs.APIID
+		cid        string
+		costCenter string
+		repFactor  int
+	}{
+		{iid1, "QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU", "research", 2},
+		{iid1, "QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn", "marketing", 1},
+		{iid2, "QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU", "research", 1},
+		{iid2, "QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn", "", 1},
+	}
+	for _, p := range pushes {
+		c, err := cid.Decode(p.cid)
+		require.NoError(t, err)
+		cfg := ffs.StorageConfig{
+			Hot: ffs.HotConfig{Ipfs: ffs.IpfsConfig{AddTimeout: 30}},
+			Cold: ffs.ColdConfig{
+				Enabled: true,
+				Filecoin: ffs.FilConfig{
+					RepFactor:       p.repFactor,
+					DealMinDuration: util.MinDealDuration,
+					Addr:            "f01000",
+					CostCenter:      p.costCenter,
+				},
+			},
+		}
+		jid, err := s.PushConfig(p.iid, c, cfg)
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			j, err := s.StorageJob(jid)
+			return err == nil && j.Status == ffs.Success
+		}, time.Second*10, time.Millisecond*50)
+	}
+
+	// Every deal costs 1000 * 100 attoFIL.
+	report, err := s.BillingReport(nil)
+	require.NoError(t, err)
+	require.Len(t, report, 3)
+	require.Equal(t, "", report[0].CostCenter)
+	require.Equal(t, 1, report[0].Deals)
+	require.Equal(t, "marketing", report[1].CostCenter)
+	require.Equal(t, 1, report[1].Deals)
+	require.Equal(t, "100000", report[1].Spend.String())
+	require.Equal(t, "research", report[2].CostCenter)
+	require.Equal(t, 3, report[2].Deals)
+	require.Equal(t, "300000", report[2].Spend.String())
+
+	report, err = s.BillingReport([]ffs.APIID{iid1})
+	require.NoError(t, err)
+	require.Len(t, report, 2)
+	require.Equal(t, 2, report[1].Deals)
+	require.Equal(t, "200000", report[1].Spend.String())
+}
+
+func TestDeltaFilConfigVerifiedReplicas(t *testing.T) {
+	t.Parallel()
+
+	cfg := ffs.ColdConfig{Filecoin: ffs.FilConfig{RepFactor: 4, MinVerifiedReplicas: 2}}
+	curr := ffs.FilInfo{Proposals: []ffs.FilStorage{
+		{Miner: "f01", Verified: true},
+		{Miner: "f02"},
+	}}
+
+	// The existing verified deal counts towards the minimum.
+	delta := createDeltaFilConfig(cfg, curr)
+	require.Equal(t, 2, delta.RepFactor)
+	require.Equal(t, 1, delta.MinVerifiedReplicas)
+	require.Equal(t, []string{"f01", "f02"}, delta.ExcludedMiners)
+
+	curr.Proposals[1].Verified = true
+	delta = createDeltaFilConfig(cfg, curr)
+	require.Equal(t, 0, delta.MinVerifiedReplicas)
+}
+
+func jobSpan(sr *oteltest.SpanRecorder, jid ffs.JobID) *oteltest.Span {
+	for _, s := range sr.Completed() {
+		if s.Name() == "storage job" && s.Attributes()[attribute.Key("job.id")].AsString() == jid.String() {
+			return s
+		}
+	}
+	return nil
+}
+
+// stagingHotStorage is a HotStorage which only stages data.
+type stagingHotStorage struct {
+	ffs.HotStorage
+}
+
+func (hs *stagingHotStorage) StageCid(context.Context, ffs.APIID, cid.Cid) error {
+	return nil
+}
+
+func (hs *stagingHotStorage) IsPinned(context.Context, ffs.APIID, cid.Cid) (bool, error) {
+	return false, nil
+}
+
+// dealingColdStorage is a ColdStorage whose deals are always active.
+// Deals of 1GiB cost 1000 attoFIL per epoch for 100 epochs.
+type dealingColdStorage struct {
+	ffs.ColdStorage
+}
+
+func (cs *dealingColdStorage) Store(_ context.Context, c cid.Cid, _ ffs.FilInfo, cfg ffs.FilConfig) ([]cid.Cid, []ffs.DealError, ffs.FilInfo, error) {
+	proposals := make([]cid.Cid, cfg.RepFactor)
+	for i := range proposals {
+		proposals[i] = c
+	}
+	return proposals, nil, ffs.FilInfo{DataCid: c, Size: 1 << 30, PayloadSize: 1 << 29}, nil
+}
+
+func (cs *dealingColdStorage) WaitForDeal(_ context.Context, _ cid.Cid, _ cid.Cid, _ time.Duration, _ time.Duration, _ chan deals.StorageDealInfo) (ffs.FilStorage, error) {
+	return ffs.FilStorage{DealID: 1, Miner: "f01", EpochPrice: 1000, Duration: 100}, nil
+}
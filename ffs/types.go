@@ -3,6 +3,8 @@ package ffs
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -119,6 +121,48 @@ type StorageJob struct {
 	DealInfo   []deals.StorageDealInfo
 	DealErrors []DealError
 	CreatedAt  int64
+	// Priority orders the execution of queued Jobs. Jobs with a higher
+	// priority are executed first.
+	Priority int
+}
+
+// ScheduledPushID is an identifier for a ScheduledPush.
+type ScheduledPushID string
+
+// NewScheduledPushID returns a new ScheduledPushID.
+func NewScheduledPushID() ScheduledPushID {
+	return ScheduledPushID(uuid.New().String())
+}
+
+// String returns a string representation of ScheduledPushID.
+func (spi ScheduledPushID) String() string {
+	return string(spi)
+}
+
+// ScheduledPush is a StorageConfig push that will be executed
+// as a new StorageJob at a future time.
+type ScheduledPush struct {
+	ID     ScheduledPushID
+	APIID  APIID
+	Cid    cid.Cid
+	Config StorageConfig
+	// Override indicates if Config can override an existing
+	// StorageConfig of Cid when the push is executed.
+	Override bool
+	// Priority is the priority of the Job created by the push.
+	Priority  int
+	ExecuteAt time.Time
+	CreatedAt time.Time
+}
+
+// MaintenancePause indicates which background maintenance tasks are
+// paused for an instance. Paused tasks skip the instance storage configs
+// until they're resumed.
+type MaintenancePause struct {
+	// Renewals pauses automatic deal renewals.
+	Renewals bool
+	// Repairs pauses automatic storage config repairs.
+	Repairs bool
 }
 
 // RetrievalJob is a retrieval task executed by the Scheduler.
@@ -260,22 +304,103 @@ func (s StorageConfig) WithUnfreezeMaxPrice(maxPrice uint64) StorageConfig {
 	return s
 }
 
-// Validate validates a StorageConfig.
+// Validate validates a StorageConfig. If the config is invalid, the
+// returned error is a FieldErrors with every invalid field.
 func (s StorageConfig) Validate() error {
-	if err := s.Hot.Validate(); err != nil {
-		return fmt.Errorf("hot-ipfs config is invalid: %s", err)
-	}
-	if err := s.Cold.Validate(); err != nil {
-		return fmt.Errorf("cold-filecoin config is invalid: %s", err)
+	if errs := s.FieldErrors(); len(errs) > 0 {
+		return errs
 	}
+	return nil
+}
+
+// FieldErrors returns all the field-level validation errors of
+// the StorageConfig. An empty result means the config is valid.
+func (s StorageConfig) FieldErrors() FieldErrors {
+	var errs FieldErrors
+	errs = append(errs, s.Hot.fieldErrors("Hot")...)
+	errs = append(errs, s.Cold.fieldErrors("Cold")...)
 	// We can't accept being renewable without hot storage enabled.
 	// See the (**) note in scheduler.go
 	if s.Cold.Enabled && s.Cold.Filecoin.Renew.Enabled && !s.Hot.Enabled {
-		return fmt.Errorf("hot storage should be enabled to enable renewals")
+		errs = append(errs, FieldError{Field: "Cold.Filecoin.Renew.Enabled", Reason: "hot storage should be enabled to enable renewals"})
+	}
+	return errs
+}
+
+// ApplyValid returns s with every invalid field reverted to its value
+// in base, and the errors of the reverted fields. Reverting a field may
+// invalidate others, e.g: the renew threshold is checked against the
+// deal duration, so fields are reverted until the result is valid. If
+// that isn't possible, an error is returned.
+func (s StorageConfig) ApplyValid(base StorageConfig) (StorageConfig, FieldErrors, error) {
+	var rejected FieldErrors
+	reverted := map[string]struct{}{}
+	for {
+		errs := s.FieldErrors()
+		if len(errs) == 0 {
+			return s, rejected, nil
+		}
+		pass := map[string]struct{}{}
+		for _, fe := range errs {
+			if _, ok := reverted[fe.Field]; ok {
+				if _, ok := pass[fe.Field]; !ok {
+					continue
+				}
+			} else {
+				if err := revertField(&s, &base, fe.Field); err != nil {
+					return StorageConfig{}, nil, err
+				}
+				reverted[fe.Field] = struct{}{}
+				pass[fe.Field] = struct{}{}
+			}
+			rejected = append(rejected, fe)
+		}
+		if len(pass) == 0 {
+			return StorageConfig{}, nil, fmt.Errorf("config is invalid with all rejected fields reverted: %w", errs)
+		}
 	}
+}
+
+// revertField sets the field in the dotted path to its value in base.
+func revertField(s, base *StorageConfig, path string) error {
+	dst := reflect.ValueOf(s).Elem()
+	src := reflect.ValueOf(base).Elem()
+	for _, name := range strings.Split(path, ".") {
+		dst = dst.FieldByName(name)
+		src = src.FieldByName(name)
+		if !dst.IsValid() {
+			return fmt.Errorf("unknown config field %s", path)
+		}
+	}
+	dst.Set(src)
 	return nil
 }
 
+// FieldError describes why a configuration field is invalid.
+type FieldError struct {
+	// Field is the dotted path of the field, e.g: Cold.Filecoin.RepFactor.
+	Field string
+	// Reason is a human readable explanation of the problem.
+	Reason string
+}
+
+// Error returns a string representation of the FieldError.
+func (fe FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", fe.Field, fe.Reason)
+}
+
+// FieldErrors is a list of FieldError.
+type FieldErrors []FieldError
+
+// Error returns a string representation of all the field errors.
+func (fe FieldErrors) Error() string {
+	msgs := make([]string, len(fe))
+	for i, e := range fe {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
 // HotConfig is the desired storage of a Cid in a Hot Storage.
 type HotConfig struct {
 	// Enable indicates if Cid data is stored. If true, it will consider
@@ -293,13 +418,17 @@ type HotConfig struct {
 
 // Validate validates a HotConfig.
 func (hc HotConfig) Validate() error {
+	if errs := hc.fieldErrors("Hot"); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (hc HotConfig) fieldErrors(prefix string) FieldErrors {
 	if !hc.Enabled {
 		return nil
 	}
-	if err := hc.Ipfs.Validate(); err != nil {
-		return fmt.Errorf("invalid ipfs config: %s", err)
-	}
-	return nil
+	return hc.Ipfs.fieldErrors(prefix + ".Ipfs")
 }
 
 // IpfsConfig is the desired storage of a Cid in IPFS.
@@ -311,8 +440,15 @@ type IpfsConfig struct {
 
 // Validate validates an IpfsConfig.
 func (ic *IpfsConfig) Validate() error {
+	if errs := ic.fieldErrors("Ipfs"); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (ic *IpfsConfig) fieldErrors(prefix string) FieldErrors {
 	if ic.AddTimeout <= 0 {
-		return fmt.Errorf("add timeout should be greater than 0 seconds, got %d", ic.AddTimeout)
+		return FieldErrors{{Field: prefix + ".AddTimeout", Reason: fmt.Sprintf("add timeout should be greater than 0 seconds, got %d", ic.AddTimeout)}}
 	}
 	return nil
 }
@@ -330,16 +466,21 @@ type ColdConfig struct {
 
 // Validate validates a ColdConfig.
 func (cc ColdConfig) Validate() error {
+	if errs := cc.fieldErrors("Cold"); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (cc ColdConfig) fieldErrors(prefix string) FieldErrors {
 	if !cc.Enabled {
 		return nil
 	}
-	if err := cc.Filecoin.Validate(); err != nil {
-		return fmt.Errorf("invalid Filecoin config: %s", err)
-	}
+	errs := cc.Filecoin.fieldErrors(prefix + ".Filecoin")
 	if cc.Filecoin.Addr == "" {
-		return fmt.Errorf("invalid wallet address")
+		errs = append(errs, FieldError{Field: prefix + ".Filecoin.Addr", Reason: "invalid wallet address"})
 	}
-	return nil
+	return errs
 }
 
 // FilConfig is the desired state of a Cid in the Filecoin network.
@@ -375,20 +516,72 @@ type FilConfig struct {
 	DealStartOffset int64
 	// VerifiedDeal indicates if new deals should be marked as verified.
 	VerifiedDeal bool
+	// MinVerifiedReplicas is the minimum number of verified deals when
+	// VerifiedDeal is disabled, so only some replicas use DataCap. Existing
+	// verified deals count towards it, and new deals are marked as verified
+	// only to make up for the missing ones. Zero disables it.
+	MinVerifiedReplicas int
+	// DealAcceptanceTimeout is the maximum number of seconds to wait for
+	// a miner to accept a proposed deal before considering it unresponsive.
+	// Zero means no acceptance deadline, only the deal finality timeout applies.
+	DealAcceptanceTimeout int64
+	// Acceptance is the policy the terms of new deals should satisfy.
+	// Miners whose terms don't satisfy it aren't selected.
+	Acceptance AcceptancePolicy
+	// CostCenter labels new deals to attribute their spend in billing
+	// reports. It's optional.
+	CostCenter string
 }
 
 // Validate returns a non-nil error if the configuration is invalid.
 func (fc *FilConfig) Validate() error {
+	if errs := fc.fieldErrors("Filecoin"); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (fc *FilConfig) fieldErrors(prefix string) FieldErrors {
+	var errs FieldErrors
 	if fc.RepFactor <= 0 {
-		return fmt.Errorf("replication factor should be greater than zero, got %d", fc.RepFactor)
+		errs = append(errs, FieldError{Field: prefix + ".RepFactor", Reason: fmt.Sprintf("replication factor should be greater than zero, got %d", fc.RepFactor)})
 	}
 	if fc.DealMinDuration < util.MinDealDuration {
-		return fmt.Errorf("deal duration should be greater than minimum, got %d", fc.DealMinDuration)
+		errs = append(errs, FieldError{Field: prefix + ".DealMinDuration", Reason: fmt.Sprintf("deal duration should be greater than minimum %d, got %d", util.MinDealDuration, fc.DealMinDuration)})
 	}
-	if err := fc.Renew.Validate(); err != nil {
-		return fmt.Errorf("invalid renew config: %s", err)
+	if fc.DealMinDuration > util.MaxDealDuration {
+		errs = append(errs, FieldError{Field: prefix + ".DealMinDuration", Reason: fmt.Sprintf("deal duration should be lower than maximum %d, got %d", util.MaxDealDuration, fc.DealMinDuration)})
 	}
-	return nil
+	if fc.DealStartOffset < 0 {
+		errs = append(errs, FieldError{Field: prefix + ".DealStartOffset", Reason: fmt.Sprintf("deal start offset can't be negative, got %d", fc.DealStartOffset)})
+	}
+	if fc.DealAcceptanceTimeout < 0 {
+		errs = append(errs, FieldError{Field: prefix + ".DealAcceptanceTimeout", Reason: fmt.Sprintf("deal acceptance timeout can't be negative, got %d", fc.DealAcceptanceTimeout)})
+	}
+	if fc.MinVerifiedReplicas < 0 || fc.MinVerifiedReplicas > fc.RepFactor {
+		errs = append(errs, FieldError{Field: prefix + ".MinVerifiedReplicas", Reason: fmt.Sprintf("minimum verified replicas should be between zero and the replication factor, got %d", fc.MinVerifiedReplicas)})
+	}
+	for _, em := range fc.ExcludedMiners {
+		for _, tm := range fc.TrustedMiners {
+			if em == tm {
+				errs = append(errs, FieldError{Field: prefix + ".TrustedMiners", Reason: fmt.Sprintf("miner %s is both trusted and excluded", em)})
+			}
+		}
+	}
+	if fc.Acceptance.RequireVerified && !fc.VerifiedDeal {
+		errs = append(errs, FieldError{Field: prefix + ".Acceptance.RequireVerified", Reason: "verified deals are required, but VerifiedDeal is disabled"})
+	}
+	if fc.Acceptance.RequireFastRetrieval && !fc.FastRetrieval {
+		errs = append(errs, FieldError{Field: prefix + ".Acceptance.RequireFastRetrieval", Reason: "fast retrieval is required, but FastRetrieval is disabled"})
+	}
+	if fc.Acceptance.MinDuration < 0 {
+		errs = append(errs, FieldError{Field: prefix + ".Acceptance.MinDuration", Reason: fmt.Sprintf("minimum duration can't be negative, got %d", fc.Acceptance.MinDuration)})
+	}
+	errs = append(errs, fc.Renew.fieldErrors(prefix+".Renew")...)
+	if fc.Renew.Enabled && int64(fc.Renew.Threshold) >= fc.DealMinDuration {
+		errs = append(errs, FieldError{Field: prefix + ".Renew.Threshold", Reason: fmt.Sprintf("renew threshold should be lower than deal duration %d, got %d", fc.DealMinDuration, fc.Renew.Threshold)})
+	}
+	return errs
 }
 
 // FilRenew contains renew configuration for a Cid Cold Storage deals.
@@ -402,8 +595,15 @@ type FilRenew struct {
 
 // Validate returns a non-nil error if the configuration is invalid.
 func (fr *FilRenew) Validate() error {
+	if errs := fr.fieldErrors("Renew"); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (fr *FilRenew) fieldErrors(prefix string) FieldErrors {
 	if fr.Enabled && fr.Threshold <= 0 {
-		return fmt.Errorf("renew threshold should be positive: %d", fr.Threshold)
+		return FieldErrors{{Field: prefix + ".Threshold", Reason: fmt.Sprintf("renew threshold should be positive: %d", fr.Threshold)}}
 	}
 	return nil
 }
@@ -469,6 +669,10 @@ type FilInfo struct {
 	// greater than real data size since data is padded in
 	// Filecoin.
 	Size uint64
+	// PayloadSize is the size of the data. With Size and the
+	// PieceCid of Proposals, it's the piece reused by further
+	// deals of the data. It's zero if it wasn't recorded.
+	PayloadSize int64
 	// Proposals contains known deals for the data.
 	Proposals []FilStorage
 }
@@ -493,9 +697,15 @@ type FilStorage struct {
 	// Miner is the miner address which is storing
 	// deals data.
 	Miner string
-	// EpochPrice is the price of attoFil per GiB
-	// per epoch paid in this deal.
+	// EpochPrice is the price of attoFil per epoch
+	// paid in this deal.
 	EpochPrice uint64
+	// CostCenter is the cost center of the storage
+	// config which created the deal.
+	CostCenter string
+	// Verified indicates if the deal is a verified
+	// deal, which is renewed as verified too.
+	Verified bool
 }
 
 // JobLoggerCtxKey is a type to use in ctx values for CidLogger.
@@ -520,6 +730,7 @@ type JobLogger interface {
 	Log(context.Context, string, ...interface{})
 	Watch(context.Context, chan<- LogEntry) error
 	GetByCid(context.Context, APIID, cid.Cid) ([]LogEntry, error)
+	GetByCidPage(ctx context.Context, iid APIID, c cid.Cid, offset, limit int) ([]LogEntry, error)
 }
 
 // LogEntry is a log entry from a Cid execution.
@@ -0,0 +1,159 @@
+package ffs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func validStorageConfig() StorageConfig {
+	return StorageConfig{
+		Hot: HotConfig{
+			Enabled: true,
+			Ipfs:    IpfsConfig{AddTimeout: 30},
+		},
+		Cold: ColdConfig{
+			Enabled: true,
+			Filecoin: FilConfig{
+				RepFactor:       1,
+				DealMinDuration: util.MinDealDuration,
+				Addr:            "t3abc",
+			},
+		},
+	}
+}
+
+func TestStorageConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, validStorageConfig().Validate())
+
+	tests := []struct {
+		name   string
+		mutate func(c *StorageConfig)
+		field  string
+	}{
+		{"zero add timeout", func(c *StorageConfig) { c.Hot.Ipfs.AddTimeout = 0 }, "Hot.Ipfs.AddTimeout"},
+		{"zero replication", func(c *StorageConfig) { c.Cold.Filecoin.RepFactor = 0 }, "Cold.Filecoin.RepFactor"},
+		{"short duration", func(c *StorageConfig) { c.Cold.Filecoin.DealMinDuration = 100 }, "Cold.Filecoin.DealMinDuration"},
+		{"long duration", func(c *StorageConfig) { c.Cold.Filecoin.DealMinDuration = util.MaxDealDuration + 1 }, "Cold.Filecoin.DealMinDuration"},
+		{"negative start offset", func(c *StorageConfig) { c.Cold.Filecoin.DealStartOffset = -1 }, "Cold.Filecoin.DealStartOffset"},
+		{"negative acceptance timeout", func(c *StorageConfig) { c.Cold.Filecoin.DealAcceptanceTimeout = -1 }, "Cold.Filecoin.DealAcceptanceTimeout"},
+		{"verified replicas above replication", func(c *StorageConfig) {
+			c.Cold.Filecoin.MinVerifiedReplicas = c.Cold.Filecoin.RepFactor + 1
+		}, "Cold.Filecoin.MinVerifiedReplicas"},
+		{"empty wallet address", func(c *StorageConfig) { c.Cold.Filecoin.Addr = "" }, "Cold.Filecoin.Addr"},
+		{"zero renew threshold", func(c *StorageConfig) { c.Cold.Filecoin.Renew = FilRenew{Enabled: true} }, "Cold.Filecoin.Renew.Threshold"},
+		{"renew threshold above duration", func(c *StorageConfig) {
+			c.Cold.Filecoin.Renew = FilRenew{Enabled: true, Threshold: util.MinDealDuration}
+		}, "Cold.Filecoin.Renew.Threshold"},
+		{"renew without hot storage", func(c *StorageConfig) {
+			c.Hot.Enabled = false
+			c.Cold.Filecoin.Renew = FilRenew{Enabled: true, Threshold: 100}
+		}, "Cold.Filecoin.Renew.Enabled"},
+		{"verified deals required", func(c *StorageConfig) {
+			c.Cold.Filecoin.Acceptance.RequireVerified = true
+		}, "Cold.Filecoin.Acceptance.RequireVerified"},
+		{"fast retrieval required", func(c *StorageConfig) {
+			c.Cold.Filecoin.Acceptance.RequireFastRetrieval = true
+		}, "Cold.Filecoin.Acceptance.RequireFastRetrieval"},
+		{"trusted and excluded miner", func(c *StorageConfig) {
+			c.Cold.Filecoin.TrustedMiners = []string{"f01"}
+			c.Cold.Filecoin.ExcludedMiners = []string{"f01"}
+		}, "Cold.Filecoin.TrustedMiners"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := validStorageConfig()
+			tt.mutate(&cfg)
+			err := cfg.Validate()
+			require.Error(t, err)
+
+			var fes FieldErrors
+			require.True(t, errors.As(err, &fes))
+			require.Len(t, fes, 1)
+			require.Equal(t, tt.field, fes[0].Field)
+		})
+	}
+}
+
+func TestStorageConfigValidateDisabled(t *testing.T) {
+	t.Parallel()
+
+	// Disabled storages don't validate their inner configuration.
+	cfg := StorageConfig{}
+	require.NoError(t, cfg.Validate())
+}
+
+func TestStorageConfigValidateMultiple(t *testing.T) {
+	t.Parallel()
+
+	cfg := validStorageConfig()
+	cfg.Hot.Ipfs.AddTimeout = 0
+	cfg.Cold.Filecoin.RepFactor = 0
+	require.Len(t, cfg.FieldErrors(), 2)
+}
+
+func TestStorageConfigApplyValid(t *testing.T) {
+	t.Parallel()
+
+	base := validStorageConfig()
+	cfg := validStorageConfig()
+	cfg.Repairable = true
+	cfg.Hot.Ipfs.AddTimeout = 0
+	cfg.Cold.Filecoin.RepFactor = 3
+	cfg.Cold.Filecoin.DealStartOffset = -1
+
+	applied, rejected, err := cfg.ApplyValid(base)
+	require.NoError(t, err)
+	require.NoError(t, applied.Validate())
+
+	// Valid fields are applied, and invalid ones keep the base value.
+	require.True(t, applied.Repairable)
+	require.Equal(t, 3, applied.Cold.Filecoin.RepFactor)
+	require.Equal(t, base.Hot.Ipfs.AddTimeout, applied.Hot.Ipfs.AddTimeout)
+	require.Equal(t, base.Cold.Filecoin.DealStartOffset, applied.Cold.Filecoin.DealStartOffset)
+
+	require.Len(t, rejected, 2)
+	require.Equal(t, "Hot.Ipfs.AddTimeout", rejected[0].Field)
+	require.Equal(t, "Cold.Filecoin.DealStartOffset", rejected[1].Field)
+}
+
+func TestStorageConfigApplyValidCascade(t *testing.T) {
+	t.Parallel()
+
+	// Reverting the invalid duration makes the renew threshold
+	// invalid, so it's reverted too.
+	base := validStorageConfig()
+	base.Cold.Filecoin.Renew = FilRenew{Enabled: true, Threshold: 100}
+	cfg := validStorageConfig()
+	cfg.Cold.Filecoin.DealMinDuration = util.MaxDealDuration + 1
+	cfg.Cold.Filecoin.Renew = FilRenew{Enabled: true, Threshold: util.MinDealDuration + 1}
+
+	applied, rejected, err := cfg.ApplyValid(base)
+	require.NoError(t, err)
+	require.NoError(t, applied.Validate())
+	require.Equal(t, base.Cold.Filecoin.DealMinDuration, applied.Cold.Filecoin.DealMinDuration)
+	require.Equal(t, base.Cold.Filecoin.Renew.Threshold, applied.Cold.Filecoin.Renew.Threshold)
+	require.True(t, applied.Cold.Filecoin.Renew.Enabled)
+	require.Len(t, rejected, 2)
+}
+
+func TestStorageConfigApplyValidInvalidBase(t *testing.T) {
+	t.Parallel()
+
+	base := validStorageConfig()
+	base.Cold.Filecoin.Addr = ""
+	cfg := validStorageConfig()
+	cfg.Cold.Filecoin.Addr = ""
+
+	_, _, err := cfg.ApplyValid(base)
+	require.Error(t, err)
+	var fes FieldErrors
+	require.True(t, errors.As(err, &fes))
+	require.Equal(t, "Cold.Filecoin.Addr", fes[0].Field)
+}
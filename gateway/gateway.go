@@ -22,6 +22,7 @@ import (
 	faultsModule "github.com/textileio/powergate/v2/index/faults/module"
 	minerModule "github.com/textileio/powergate/v2/index/miner/lotusidx"
 	"github.com/textileio/powergate/v2/reputation"
+	"github.com/textileio/powergate/v2/util"
 )
 
 const numTopMiners = 100
@@ -307,8 +308,7 @@ func (g *Gateway) reputationHandler(c *gin.Context) {
 }
 
 func epochToTime(value int64) time.Time {
-	genesisEpochTime := int64(1598295600)
-	return time.Unix(genesisEpochTime+value*30, 0)
+	return util.MainnetClock.EpochToTime(value)
 }
 
 func timeToString(t time.Time) string {
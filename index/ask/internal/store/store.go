@@ -3,13 +3,19 @@ package store
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
 	"github.com/textileio/powergate/v2/index/ask"
 )
 
 var (
-	dsKey = datastore.NewKey("index")
+	log = logging.Logger("index-ask-store")
+
+	dsKey         = datastore.NewKey("index")
+	dsSnapshotKey = datastore.NewKey("snapshots")
 )
 
 // Store persists ask index into a datastore.
@@ -52,3 +58,94 @@ func (s *Store) Get() (ask.Index, error) {
 	}
 	return idx, nil
 }
+
+// SaveSnapshot persists the index as a historical snapshot keyed by
+// its LastUpdated time. Saving a snapshot with the same time
+// overwrites the previous one.
+func (s *Store) SaveSnapshot(idx ask.Index) error {
+	buf, err := json.Marshal(idx)
+	if err != nil {
+		return fmt.Errorf("marshaling snapshot: %s", err)
+	}
+	if err = s.ds.Put(makeSnapshotKey(idx.LastUpdated), buf); err != nil {
+		return fmt.Errorf("saving snapshot to datastore: %s", err)
+	}
+	return nil
+}
+
+// Snapshots returns the persisted snapshots taken between from and to
+// (both inclusive), sorted by time. A zero from or to leaves that side
+// of the range unbounded.
+func (s *Store) Snapshots(from, to time.Time) ([]ask.Index, error) {
+	q := query.Query{
+		Prefix: dsSnapshotKey.String(),
+		Orders: []query.Order{query.OrderByKey{}},
+	}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("querying snapshots: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	var ret []ask.Index
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		var idx ask.Index
+		if err := json.Unmarshal(r.Value, &idx); err != nil {
+			return nil, fmt.Errorf("unmarshaling snapshot: %s", err)
+		}
+		if !from.IsZero() && idx.LastUpdated.Before(from) {
+			continue
+		}
+		if !to.IsZero() && idx.LastUpdated.After(to) {
+			break
+		}
+		ret = append(ret, idx)
+	}
+	return ret, nil
+}
+
+// PruneSnapshots deletes the persisted snapshots taken before t, and
+// returns how many were deleted.
+func (s *Store) PruneSnapshots(t time.Time) (int, error) {
+	q := query.Query{
+		Prefix:   dsSnapshotKey.String(),
+		Orders:   []query.Order{query.OrderByKey{}},
+		KeysOnly: true,
+	}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return 0, fmt.Errorf("querying snapshots: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	limit := makeSnapshotKey(t).String()
+	var pruned int
+	for r := range res.Next() {
+		if r.Error != nil {
+			return pruned, fmt.Errorf("iter next: %s", r.Error)
+		}
+		if r.Key >= limit {
+			break
+		}
+		if err := s.ds.Delete(datastore.NewKey(r.Key)); err != nil {
+			return pruned, fmt.Errorf("deleting snapshot: %s", err)
+		}
+		pruned++
+	}
+	return pruned, nil
+}
+
+// makeSnapshotKey returns a key which sorts lexicographically
+// in the same order as t.
+func makeSnapshotKey(t time.Time) datastore.Key {
+	return dsSnapshotKey.ChildString(fmt.Sprintf("%020d", t.UnixNano()))
+}
@@ -0,0 +1,64 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/index/ask"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestSnapshots(t *testing.T) {
+	t.Parallel()
+	s := New(tests.NewTxMapDatastore())
+
+	base := time.Unix(1600000000, 0)
+	for _, i := range []int{2, 0, 1} {
+		idx := ask.Index{
+			LastUpdated: base.Add(time.Duration(i) * time.Hour),
+			Storage:     map[string]ask.StorageAsk{"f01": {Miner: "f01", Price: uint64(i)}},
+		}
+		require.NoError(t, s.SaveSnapshot(idx))
+	}
+	// Snapshots don't replace the current index.
+	idx, err := s.Get()
+	require.NoError(t, err)
+	require.Empty(t, idx.Storage)
+
+	snaps, err := s.Snapshots(time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, snaps, 3)
+	for i, snap := range snaps {
+		require.True(t, base.Add(time.Duration(i)*time.Hour).Equal(snap.LastUpdated))
+		require.Equal(t, uint64(i), snap.Storage["f01"].Price)
+	}
+
+	snaps, err = s.Snapshots(base.Add(time.Hour), base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, snaps, 1)
+	require.Equal(t, uint64(1), snaps[0].Storage["f01"].Price)
+}
+
+func TestPruneSnapshots(t *testing.T) {
+	t.Parallel()
+	s := New(tests.NewTxMapDatastore())
+
+	base := time.Unix(1600000000, 0)
+	for i := 0; i < 4; i++ {
+		idx := ask.Index{LastUpdated: base.Add(time.Duration(i) * time.Hour)}
+		require.NoError(t, s.SaveSnapshot(idx))
+	}
+
+	pruned, err := s.PruneSnapshots(base.Add(2 * time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 2, pruned)
+	snaps, err := s.Snapshots(time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, snaps, 2)
+	require.True(t, base.Add(2*time.Hour).Equal(snaps[0].LastUpdated))
+
+	pruned, err = s.PruneSnapshots(base)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+}
@@ -53,6 +53,12 @@ type Config struct {
 	MaxParallel     int
 	RefreshInterval time.Duration
 	RefreshOnStart  bool
+	// PersistSnapshots keeps every refreshed index as a historical
+	// snapshot, which can be replayed with Snapshots.
+	PersistSnapshots bool
+	// SnapshotRetention is how long persisted snapshots are kept. Older
+	// snapshots are pruned after each refresh. Zero keeps them forever.
+	SnapshotRetention time.Duration
 }
 
 // New returns a new ask index runner. It load a persisted ask index, and immediately starts building a new fresh one.
@@ -127,6 +133,18 @@ func (ai *Runner) Query(q ask.Query) ([]ask.StorageAsk, error) {
 	return res, nil
 }
 
+// Snapshots returns the persisted historical snapshots of the index
+// taken between from and to (both inclusive), sorted by time. A zero
+// from or to leaves that side of the range unbounded. Snapshots are
+// only persisted if enabled in Config.
+func (ai *Runner) Snapshots(from, to time.Time) ([]ask.Index, error) {
+	snaps, err := ai.store.Snapshots(from, to)
+	if err != nil {
+		return nil, fmt.Errorf("getting snapshots from store: %s", err)
+	}
+	return snaps, nil
+}
+
 // Listen returns a new channel signaler that notifies when the index gets
 // updated.
 func (ai *Runner) Listen() <-chan struct{} {
@@ -209,6 +227,20 @@ func (ai *Runner) update() error {
 	if err := ai.store.Save(newIndex); err != nil {
 		return fmt.Errorf("persisting ask index: %s", err)
 	}
+	if ai.config.PersistSnapshots {
+		if err := ai.store.SaveSnapshot(newIndex); err != nil {
+			return fmt.Errorf("persisting ask index snapshot: %s", err)
+		}
+	}
+	if ai.config.SnapshotRetention > 0 {
+		pruned, err := ai.store.PruneSnapshots(newIndex.LastUpdated.Add(-ai.config.SnapshotRetention))
+		if err != nil {
+			return fmt.Errorf("pruning ask index snapshots: %s", err)
+		}
+		if pruned > 0 {
+			log.Infof("pruned %d ask index snapshots", pruned)
+		}
+	}
 	ai.signaler.Signal()
 
 	ai.refreshDuration.Record(context.Background(), time.Since(start).Milliseconds())
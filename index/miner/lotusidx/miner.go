@@ -2,6 +2,7 @@ package lotusidx
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -21,6 +22,10 @@ import (
 
 var (
 	log = logging.Logger("index-miner")
+
+	// ErrIndexBehind indicates the on-chain index didn't reach the
+	// required chain height in time.
+	ErrIndexBehind = errors.New("miner index is behind the required chain height")
 )
 
 // P2PHost provides a client to connect to a libp2p peer.
@@ -41,6 +46,9 @@ type Index struct {
 
 	lock  sync.Mutex
 	index miner.IndexSnapshot
+	// onChainUpdated is closed and replaced when the on-chain index
+	// is updated.
+	onChainUpdated chan struct{}
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -85,6 +93,8 @@ func New(ds datastore.Datastore, clientBuilder lotus.ClientBuilder, h P2PHost, l
 		index:    savedIndex,
 		conf:     conf,
 
+		onChainUpdated: make(chan struct{}),
+
 		ctx:    ctx,
 		cancel: cancel,
 	}
@@ -120,6 +130,28 @@ func (mi *Index) Get() miner.IndexSnapshot {
 	return ii
 }
 
+// GetAtLeast returns a copy of the current index information, once the
+// on-chain index reflects at least minHeight. The chain height reflected
+// by the on-chain index is OnChain.LastUpdated. If the index doesn't reach
+// minHeight before ctx is done, it returns ErrIndexBehind.
+func (mi *Index) GetAtLeast(ctx context.Context, minHeight int64) (miner.IndexSnapshot, error) {
+	for {
+		mi.lock.Lock()
+		height := mi.index.OnChain.LastUpdated
+		updated := mi.onChainUpdated
+		mi.lock.Unlock()
+		if height >= minHeight {
+			return mi.Get(), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return miner.IndexSnapshot{}, fmt.Errorf("%w: index height %d, required %d: %s", ErrIndexBehind, height, minHeight, ctx.Err())
+		case <-updated:
+		}
+	}
+}
+
 // Listen returns a channel signaler to notify when new index information is
 // available.
 func (mi *Index) Listen() <-chan struct{} {
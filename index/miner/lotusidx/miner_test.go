@@ -2,6 +2,7 @@ package lotusidx
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/index/miner"
 	"github.com/textileio/powergate/v2/iplocation"
 	"github.com/textileio/powergate/v2/lotus"
 	"github.com/textileio/powergate/v2/tests"
@@ -63,6 +65,46 @@ func TestFullRefresh(t *testing.T) {
 	}
 }
 
+func TestGetAtLeast(t *testing.T) {
+	t.Parallel()
+	mi, err := New(tests.NewTxMapDatastore(), nil, &p2pHostMock{}, &lrMock{}, Config{Disable: true})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, mi.Close()) }()
+	mi.setOnChain(miner.ChainIndex{LastUpdated: 100})
+
+	// Reads of a reached height don't block.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	index, err := mi.GetAtLeast(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), index.OnChain.LastUpdated)
+
+	// Reads of a future height block until the index advances.
+	res := make(chan int64)
+	go func() {
+		index, err := mi.GetAtLeast(ctx, 110)
+		if err != nil {
+			res <- -1
+			return
+		}
+		res <- index.OnChain.LastUpdated
+	}()
+	mi.setOnChain(miner.ChainIndex{LastUpdated: 105})
+	select {
+	case <-res:
+		t.Fatal("read returned before the index reached the required height")
+	case <-time.After(time.Millisecond * 100):
+	}
+	mi.setOnChain(miner.ChainIndex{LastUpdated: 112})
+	require.Equal(t, int64(112), <-res)
+
+	// Reads time out if the index doesn't advance.
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	_, err = mi.GetAtLeast(ctx, 200)
+	require.True(t, errors.Is(err, ErrIndexBehind))
+}
+
 func TestIntegration(t *testing.T) {
 	t.SkipNow()
 	metaRefreshInterval = time.Hour
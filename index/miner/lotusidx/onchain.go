@@ -42,9 +42,7 @@ func (mi *Index) updateOnChainIndex(ctx context.Context) error {
 	}
 	newIndex.LastUpdated = int64(chainHead.Height())
 
-	mi.lock.Lock()
-	mi.index.OnChain = newIndex
-	mi.lock.Unlock()
+	mi.setOnChain(newIndex)
 
 	if err := mi.store.SaveOnChain(ctx, newIndex); err != nil {
 		return fmt.Errorf("saving on-chain index to store: %s", err)
@@ -55,6 +53,16 @@ func (mi *Index) updateOnChainIndex(ctx context.Context) error {
 	return nil
 }
 
+// setOnChain sets the on-chain index, and wakes up reads waiting for the
+// index to reach a chain height.
+func (mi *Index) setOnChain(ci miner.ChainIndex) {
+	mi.lock.Lock()
+	defer mi.lock.Unlock()
+	mi.index.OnChain = ci
+	close(mi.onChainUpdated)
+	mi.onChainUpdated = make(chan struct{})
+}
+
 // updateForAddrs updates chainIndex information for a particular set of addrs.
 func (mi *Index) updateForAddrs(ctx context.Context, api *api.FullNodeStruct, chainIndex *miner.ChainIndex, addrs []address.Address) error {
 	var l sync.Mutex
@@ -12,6 +12,7 @@ type IndexSnapshot struct {
 
 // ChainIndex contains on-chain information about miners.
 type ChainIndex struct {
+	// LastUpdated is the chain height reflected by the index.
 	LastUpdated int64
 	Miners      map[string]OnChainMinerData
 }
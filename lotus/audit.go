@@ -0,0 +1,33 @@
+package lotus
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/filecoin-project/lotus/api"
+)
+
+// warnNoDeadline is called for every client call made with a context
+// without a deadline.
+var warnNoDeadline = func(method string) {
+	log.Debugf("lotus call %s made with a context without deadline", method)
+}
+
+// applyDeadlineAudit wraps every method of the client to warn when it's
+// called with a context lacking a deadline. It helps to find code paths
+// which don't propagate the caller deadline, so it must be applied after
+// any other wrapper that bounds the context.
+func applyDeadlineAudit(c *api.FullNodeStruct) {
+	wrapMethods(c, func(method string, f reflect.Value) reflect.Value {
+		orig := reflect.ValueOf(f.Interface())
+		return reflect.MakeFunc(orig.Type(), func(args []reflect.Value) []reflect.Value {
+			if _, ok := args[0].Interface().(context.Context).Deadline(); !ok {
+				warnNoDeadline(method)
+			}
+			if orig.Type().IsVariadic() {
+				return orig.CallSlice(args)
+			}
+			return orig.Call(args)
+		})
+	})
+}
@@ -0,0 +1,46 @@
+package lotus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadlineAudit(t *testing.T) {
+	var warned []string
+	orig := warnNoDeadline
+	warnNoDeadline = func(method string) { warned = append(warned, method) }
+	defer func() { warnNoDeadline = orig }()
+
+	var c api.FullNodeStruct
+	c.CommonStruct.Internal.Version = func(ctx context.Context) (api.APIVersion, error) {
+		return api.APIVersion{}, nil
+	}
+	c.Internal.ChainHead = func(ctx context.Context) (*types.TipSet, error) {
+		return nil, nil
+	}
+	applyCallTimeouts(&c, CallTimeouts{"ChainHead": time.Minute})
+	applyDeadlineAudit(&c)
+
+	_, err := c.Version(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"Version"}, warned)
+
+	// The audit sees the caller context, not the one bounded by
+	// a configured call timeout.
+	_, err = c.ChainHead(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"Version", "ChainHead"}, warned)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err = c.Version(ctx)
+	require.NoError(t, err)
+	_, err = c.ChainHead(ctx)
+	require.NoError(t, err)
+	require.Len(t, warned, 2)
+}
@@ -21,8 +21,34 @@ var (
 // ClientBuilder creates a new Lotus client.
 type ClientBuilder func(ctx context.Context) (*api.FullNodeStruct, func(), error)
 
-// NewBuilder creates a new ClientBuilder.
-func NewBuilder(maddr ma.Multiaddr, authToken string, connRetries int) (ClientBuilder, error) {
+// Option configures clients created by a ClientBuilder.
+type Option func(*config)
+
+type config struct {
+	timeouts    CallTimeouts
+	readRetries RetryConfig
+}
+
+// WithCallTimeouts bounds the duration of calls of created clients by
+// method name.
+func WithCallTimeouts(timeouts CallTimeouts) Option {
+	return func(c *config) {
+		c.timeouts = timeouts
+	}
+}
+
+// WithReadRetries configures the retries of idempotent read calls of
+// created clients. If the method has a call timeout, it bounds all the
+// attempts.
+func WithReadRetries(rc RetryConfig) Option {
+	return func(c *config) {
+		c.readRetries = rc
+	}
+}
+
+// NewBuilder creates a new ClientBuilder. By default, calls of created clients
+// are only bounded by the caller context and aren't retried.
+func NewBuilder(maddr ma.Multiaddr, authToken string, connRetries int, opts ...Option) (ClientBuilder, error) {
 	addr, err := util.TCPAddrFromMultiAddr(maddr)
 	if err != nil {
 		return nil, err
@@ -30,6 +56,10 @@ func NewBuilder(maddr ma.Multiaddr, authToken string, connRetries int) (ClientBu
 	headers := http.Header{
 		"Authorization": []string{"Bearer " + authToken},
 	}
+	var conf config
+	for _, opt := range opts {
+		opt(&conf)
+	}
 
 	return func(ctx context.Context) (*api.FullNodeStruct, func(), error) {
 		var api api.FullNodeStruct
@@ -53,6 +83,9 @@ func NewBuilder(maddr ma.Multiaddr, authToken string, connRetries int) (ClientBu
 		if err != nil {
 			return nil, nil, fmt.Errorf("couldn't connect to Lotus API: %s", err)
 		}
+		applyReadRetries(&api, conf.readRetries)
+		applyCallTimeouts(&api, conf.timeouts)
+		applyDeadlineAudit(&api)
 
 		return &api, closer, nil
 	}, nil
@@ -0,0 +1,257 @@
+package lotus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+)
+
+var (
+	// DefaultPoolIdleTimeout is the default time after which idle
+	// pooled connections are closed.
+	DefaultPoolIdleTimeout = time.Minute * 5
+
+	// DefaultPoolMaxIdle is the default maximum number of idle
+	// pooled connections.
+	DefaultPoolMaxIdle = 10
+
+	// poolValidateTimeout bounds the revalidation of idle connections
+	// on checkout.
+	poolValidateTimeout = time.Second * 5
+)
+
+// PoolOption configures a Pool.
+type PoolOption func(*poolConfig)
+
+type poolConfig struct {
+	idleTimeout time.Duration
+	maxIdle     int
+	validate    func(context.Context, *api.FullNodeStruct) error
+}
+
+// WithIdleTimeout sets the time after which idle connections are closed.
+// A zero or negative value keeps DefaultPoolIdleTimeout.
+func WithIdleTimeout(d time.Duration) PoolOption {
+	return func(c *poolConfig) {
+		if d > 0 {
+			c.idleTimeout = d
+		}
+	}
+}
+
+// WithMaxIdle sets the maximum number of idle connections kept in the pool.
+// Connections released when the pool is full are closed. A zero or negative
+// value keeps DefaultPoolMaxIdle.
+func WithMaxIdle(n int) PoolOption {
+	return func(c *poolConfig) {
+		if n > 0 {
+			c.maxIdle = n
+		}
+	}
+}
+
+// WithValidation sets the check run on idle connections before they're
+// checked out. Connections failing it are closed. By default, the node
+// Version is queried.
+func WithValidation(f func(context.Context, *api.FullNodeStruct) error) PoolOption {
+	return func(c *poolConfig) {
+		if f != nil {
+			c.validate = f
+		}
+	}
+}
+
+// Pool reuses clients created by a ClientBuilder. Clients are released
+// back to the pool by calling their closer, and idle clients are closed
+// after an idle timeout.
+type Pool struct {
+	cb   ClientBuilder
+	conf poolConfig
+
+	lock   sync.Mutex
+	idle   []*pooledClient
+	closed bool
+
+	closeCtx      context.Context
+	closeCancel   context.CancelFunc
+	closeFinished chan struct{}
+}
+
+type pooledClient struct {
+	client   *api.FullNodeStruct
+	closer   func()
+	lastUsed time.Time
+}
+
+// NewPool returns a new Pool of clients created by cb.
+func NewPool(cb ClientBuilder, opts ...PoolOption) *Pool {
+	conf := poolConfig{
+		idleTimeout: DefaultPoolIdleTimeout,
+		maxIdle:     DefaultPoolMaxIdle,
+		validate: func(ctx context.Context, c *api.FullNodeStruct) error {
+			_, err := c.Version(ctx)
+			return err
+		},
+	}
+	for _, opt := range opts {
+		opt(&conf)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &Pool{
+		cb:            cb,
+		conf:          conf,
+		closeCtx:      ctx,
+		closeCancel:   cancel,
+		closeFinished: make(chan struct{}),
+	}
+	go p.evictIdle()
+	return p
+}
+
+// Builder returns a ClientBuilder which checks out clients from the pool.
+// Calling the returned closer releases the client back to the pool.
+func (p *Pool) Builder() ClientBuilder {
+	return p.get
+}
+
+// Idle returns the number of idle clients in the pool.
+func (p *Pool) Idle() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return len(p.idle)
+}
+
+// Close closes all idle clients. Clients released after closing the
+// pool are closed instead of pooled.
+func (p *Pool) Close() error {
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return nil
+	}
+	p.closed = true
+	idle := p.idle
+	p.idle = nil
+	p.lock.Unlock()
+
+	p.closeCancel()
+	<-p.closeFinished
+	for _, pc := range idle {
+		pc.closer()
+	}
+	return nil
+}
+
+func (p *Pool) get(ctx context.Context) (*api.FullNodeStruct, func(), error) {
+	for {
+		pc := p.checkout()
+		if pc == nil {
+			break
+		}
+		if err := p.revalidate(ctx, pc); err != nil {
+			log.Warnf("closing invalid pooled lotus client: %s", err)
+			pc.closer()
+			continue
+		}
+		return pc.client, p.releaser(pc), nil
+	}
+
+	c, cls, err := p.cb(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, p.releaser(&pooledClient{client: c, closer: cls}), nil
+}
+
+// checkout pops the most recently used idle client. Clients idle for
+// longer than the idle timeout are closed.
+func (p *Pool) checkout() *pooledClient {
+	var expired []*pooledClient
+	defer func() {
+		for _, pc := range expired {
+			pc.closer()
+		}
+	}()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for len(p.idle) > 0 {
+		pc := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		if time.Since(pc.lastUsed) < p.conf.idleTimeout {
+			return pc
+		}
+		expired = append(expired, pc)
+	}
+	return nil
+}
+
+func (p *Pool) revalidate(ctx context.Context, pc *pooledClient) error {
+	ctx, cancel := context.WithTimeout(ctx, poolValidateTimeout)
+	defer cancel()
+	if err := p.conf.validate(ctx, pc.client); err != nil {
+		return fmt.Errorf("validating client: %s", err)
+	}
+	return nil
+}
+
+// releaser returns a closer which releases pc back to the pool.
+// Only the first call has effect.
+func (p *Pool) releaser(pc *pooledClient) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() { p.release(pc) })
+	}
+}
+
+func (p *Pool) release(pc *pooledClient) {
+	p.lock.Lock()
+	if p.closed || len(p.idle) >= p.conf.maxIdle {
+		p.lock.Unlock()
+		pc.closer()
+		return
+	}
+	pc.lastUsed = time.Now()
+	p.idle = append(p.idle, pc)
+	p.lock.Unlock()
+}
+
+// evictIdle periodically closes clients idle for longer than
+// the idle timeout.
+func (p *Pool) evictIdle() {
+	defer close(p.closeFinished)
+	ticker := time.NewTicker(p.conf.idleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.closeCtx.Done():
+			return
+		case <-ticker.C:
+			p.evict(time.Now())
+		}
+	}
+}
+
+func (p *Pool) evict(now time.Time) {
+	p.lock.Lock()
+	// Idle clients are sorted by lastUsed, so the expired ones are a prefix.
+	i := 0
+	for ; i < len(p.idle); i++ {
+		if now.Sub(p.idle[i].lastUsed) < p.conf.idleTimeout {
+			break
+		}
+	}
+	evicted := append([]*pooledClient(nil), p.idle[:i]...)
+	p.idle = append(p.idle[:0], p.idle[i:]...)
+	p.lock.Unlock()
+
+	for _, pc := range evicted {
+		pc.closer()
+	}
+	if len(evicted) > 0 {
+		log.Debugf("evicted %d idle lotus clients", len(evicted))
+	}
+}
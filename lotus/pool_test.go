@@ -0,0 +1,144 @@
+package lotus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolReuse(t *testing.T) {
+	t.Parallel()
+	cb, cc := countingBuilder()
+	p := NewPool(cb, WithValidation(func(context.Context, *api.FullNodeStruct) error { return nil }))
+	defer func() { require.NoError(t, p.Close()) }()
+
+	c1, cls1, err := p.Builder()(context.Background())
+	require.NoError(t, err)
+	cls1()
+	// Releasing twice has no effect.
+	cls1()
+	require.Equal(t, 1, p.Idle())
+
+	c2, cls2, err := p.Builder()(context.Background())
+	require.NoError(t, err)
+	require.Same(t, c1, c2)
+	require.Equal(t, 1, cc.created())
+	cls2()
+	require.Equal(t, 0, cc.closed())
+}
+
+func TestPoolIdleEviction(t *testing.T) {
+	t.Parallel()
+	cb, cc := countingBuilder()
+	idleTimeout := time.Millisecond * 100
+	p := NewPool(cb, WithIdleTimeout(idleTimeout), WithValidation(func(context.Context, *api.FullNodeStruct) error { return nil }))
+	defer func() { require.NoError(t, p.Close()) }()
+
+	_, cls1, err := p.Builder()(context.Background())
+	require.NoError(t, err)
+	_, cls2, err := p.Builder()(context.Background())
+	require.NoError(t, err)
+	cls1()
+	cls2()
+	require.Equal(t, 2, p.Idle())
+
+	// Idle clients are kept before the timeout.
+	time.Sleep(idleTimeout / 4)
+	require.Equal(t, 2, p.Idle())
+	require.Equal(t, 0, cc.closed())
+
+	// And closed after it.
+	require.Eventually(t, func() bool { return p.Idle() == 0 }, time.Second*5, time.Millisecond*10)
+	require.Equal(t, 2, cc.closed())
+
+	// A new client is created after eviction.
+	_, cls3, err := p.Builder()(context.Background())
+	require.NoError(t, err)
+	defer cls3()
+	require.Equal(t, 3, cc.created())
+}
+
+func TestPoolCheckoutExpired(t *testing.T) {
+	t.Parallel()
+	cb, cc := countingBuilder()
+	// A long idle timeout avoids the background eviction.
+	p := NewPool(cb, WithIdleTimeout(time.Hour), WithValidation(func(context.Context, *api.FullNodeStruct) error { return nil }))
+	defer func() { require.NoError(t, p.Close()) }()
+
+	_, cls, err := p.Builder()(context.Background())
+	require.NoError(t, err)
+	cls()
+	p.lock.Lock()
+	p.idle[0].lastUsed = time.Now().Add(-time.Hour)
+	p.lock.Unlock()
+
+	_, cls, err = p.Builder()(context.Background())
+	require.NoError(t, err)
+	defer cls()
+	require.Equal(t, 2, cc.created())
+	require.Equal(t, 1, cc.closed())
+}
+
+func TestPoolRevalidation(t *testing.T) {
+	t.Parallel()
+	cb, cc := countingBuilder()
+	var lock sync.Mutex
+	var validateErr error
+	p := NewPool(cb, WithValidation(func(context.Context, *api.FullNodeStruct) error {
+		lock.Lock()
+		defer lock.Unlock()
+		return validateErr
+	}))
+	defer func() { require.NoError(t, p.Close()) }()
+
+	c1, cls, err := p.Builder()(context.Background())
+	require.NoError(t, err)
+	cls()
+
+	lock.Lock()
+	validateErr = errors.New("connection lost")
+	lock.Unlock()
+	c2, cls, err := p.Builder()(context.Background())
+	require.NoError(t, err)
+	defer cls()
+	require.NotSame(t, c1, c2)
+	require.Equal(t, 2, cc.created())
+	require.Equal(t, 1, cc.closed())
+}
+
+type clientCounter struct {
+	lock     sync.Mutex
+	nCreated int
+	nClosed  int
+}
+
+func (cc *clientCounter) created() int {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	return cc.nCreated
+}
+
+func (cc *clientCounter) closed() int {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	return cc.nClosed
+}
+
+func countingBuilder() (ClientBuilder, *clientCounter) {
+	cc := &clientCounter{}
+	return func(context.Context) (*api.FullNodeStruct, func(), error) {
+		cc.lock.Lock()
+		defer cc.lock.Unlock()
+		cc.nCreated++
+		return &api.FullNodeStruct{}, func() {
+			cc.lock.Lock()
+			defer cc.lock.Unlock()
+			cc.nClosed++
+		}, nil
+	}, cc
+}
@@ -0,0 +1,79 @@
+package lotus
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+)
+
+// RetryConfig configures retries of idempotent read calls.
+type RetryConfig struct {
+	// MaxAttempts is the max number of times a call is made. Values
+	// lower than two disable retries.
+	MaxAttempts int
+	// Backoff is the wait before the first retry, which is doubled
+	// after each failed attempt.
+	Backoff time.Duration
+}
+
+// nonIdempotentReads are State* and Chain* methods which mutate the node
+// state, so they're never retried.
+var nonIdempotentReads = map[string]struct{}{
+	"ChainDeleteObj":       {},
+	"ChainSetHead":         {},
+	"ChainCheckBlockstore": {},
+}
+
+// isIdempotentRead returns true if the method only reads chain or state
+// data, so it's safe to call it again after a failure.
+func isIdempotentRead(method string) bool {
+	if _, ok := nonIdempotentReads[method]; ok {
+		return false
+	}
+	return strings.HasPrefix(method, "State") || strings.HasPrefix(method, "Chain")
+}
+
+// applyReadRetries wraps idempotent read methods of the client so failed
+// calls are retried with exponential backoff. Calls stop being retried
+// when the caller context is done.
+func applyReadRetries(c *api.FullNodeStruct, rc RetryConfig) {
+	if rc.MaxAttempts < 2 {
+		return
+	}
+	wrapMethods(c, func(method string, f reflect.Value) reflect.Value {
+		if !isIdempotentRead(method) {
+			return f
+		}
+		return withRetries(method, f, rc)
+	})
+}
+
+func withRetries(method string, f reflect.Value, rc RetryConfig) reflect.Value {
+	orig := reflect.ValueOf(f.Interface())
+	return reflect.MakeFunc(orig.Type(), func(args []reflect.Value) []reflect.Value {
+		ctx := args[0].Interface().(context.Context)
+		backoff := rc.Backoff
+		var res []reflect.Value
+		for i := 1; ; i++ {
+			if orig.Type().IsVariadic() {
+				res = orig.CallSlice(args)
+			} else {
+				res = orig.Call(args)
+			}
+			err, _ := res[len(res)-1].Interface().(error)
+			if err == nil || i == rc.MaxAttempts || ctx.Err() != nil {
+				return res
+			}
+			log.Warnf("calling %s (attempt %d/%d): %s", method, i, rc.MaxAttempts, err)
+			select {
+			case <-ctx.Done():
+				return res
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+	})
+}
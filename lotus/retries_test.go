@@ -0,0 +1,91 @@
+package lotus
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRetries(t *testing.T) {
+	t.Parallel()
+
+	errFlaky := fmt.Errorf("connection reset")
+	var actorCalls, headCalls, pushCalls int
+	var c api.FullNodeStruct
+	c.Internal.StateGetActor = func(ctx context.Context, _ address.Address, _ types.TipSetKey) (*types.Actor, error) {
+		actorCalls++
+		if actorCalls < 3 {
+			return nil, errFlaky
+		}
+		return &types.Actor{}, nil
+	}
+	c.Internal.ChainHead = func(ctx context.Context) (*types.TipSet, error) {
+		headCalls++
+		return nil, errFlaky
+	}
+	c.Internal.MpoolPushMessage = func(ctx context.Context, _ *types.Message, _ *api.MessageSendSpec) (*types.SignedMessage, error) {
+		pushCalls++
+		return nil, errFlaky
+	}
+	applyReadRetries(&c, RetryConfig{MaxAttempts: 4, Backoff: time.Millisecond})
+
+	ctx := context.Background()
+	actor, err := c.StateGetActor(ctx, address.Undef, types.EmptyTSK)
+	require.NoError(t, err)
+	require.NotNil(t, actor)
+	require.Equal(t, 3, actorCalls)
+
+	_, err = c.ChainHead(ctx)
+	require.Equal(t, errFlaky, err)
+	require.Equal(t, 4, headCalls)
+
+	// Message sending isn't idempotent, so it's never retried.
+	_, err = c.MpoolPushMessage(ctx, &types.Message{}, nil)
+	require.Equal(t, errFlaky, err)
+	require.Equal(t, 1, pushCalls)
+}
+
+func TestReadRetriesCanceled(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	var c api.FullNodeStruct
+	ctx, cancel := context.WithCancel(context.Background())
+	c.Internal.ChainHead = func(ctx context.Context) (*types.TipSet, error) {
+		calls++
+		cancel()
+		return nil, ctx.Err()
+	}
+	applyReadRetries(&c, RetryConfig{MaxAttempts: 4, Backoff: time.Millisecond})
+
+	_, err := c.ChainHead(ctx)
+	require.Equal(t, context.Canceled, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestReadRetriesBoundedByTimeout(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	var c api.FullNodeStruct
+	c.Internal.ChainHead = func(ctx context.Context) (*types.TipSet, error) {
+		calls++
+		return nil, fmt.Errorf("connection reset")
+	}
+	applyReadRetries(&c, RetryConfig{MaxAttempts: 4, Backoff: time.Hour})
+	applyCallTimeouts(&c, CallTimeouts{"ChainHead": time.Millisecond * 50})
+
+	// The timeout bounds all the attempts, including the backoff
+	// between them.
+	start := time.Now()
+	_, err := c.ChainHead(context.Background())
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+	require.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,95 @@
+package lotus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+)
+
+// CallTimeouts configures the max duration of Lotus RPC calls by method
+// name, e.g: "StateWaitMsg". Methods without a timeout, or with a zero one,
+// are only bounded by the context provided by the caller.
+type CallTimeouts map[string]time.Duration
+
+// ParseCallTimeouts parses a comma-separated list of method=duration
+// pairs, e.g: "Version=5s,StateWaitMsg=1h".
+func ParseCallTimeouts(s string) (CallTimeouts, error) {
+	ct := CallTimeouts{}
+	if strings.TrimSpace(s) == "" {
+		return ct, nil
+	}
+	for _, p := range strings.Split(s, ",") {
+		parts := strings.SplitN(strings.TrimSpace(p), "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid call timeout %q", p)
+		}
+		d, err := time.ParseDuration(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("parsing duration of %s: %s", parts[0], err)
+		}
+		if d < 0 {
+			return nil, fmt.Errorf("timeout of %s can't be negative", parts[0])
+		}
+		ct[parts[0]] = d
+	}
+	return ct, nil
+}
+
+// applyCallTimeouts wraps the methods of the client which have a
+// configured timeout, so each call runs with a context bounded by it.
+func applyCallTimeouts(c *api.FullNodeStruct, timeouts CallTimeouts) {
+	wrapMethods(c, func(method string, f reflect.Value) reflect.Value {
+		timeout := timeouts[method]
+		if timeout <= 0 {
+			return f
+		}
+		return withTimeout(f, timeout)
+	})
+}
+
+// wrapMethods replaces every context-aware method of the client with the
+// result of wrap. Methods returning channels are subscriptions that live as
+// long as the caller context, so they're never wrapped.
+func wrapMethods(c *api.FullNodeStruct, wrap func(method string, f reflect.Value) reflect.Value) {
+	for _, internal := range []interface{}{&c.Internal, &c.CommonStruct.Internal} {
+		v := reflect.ValueOf(internal).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Field(i)
+			if f.Kind() != reflect.Func || f.IsNil() || !isWrappable(f.Type()) {
+				continue
+			}
+			f.Set(wrap(v.Type().Field(i).Name, f))
+		}
+	}
+}
+
+var ctxType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+func isWrappable(t reflect.Type) bool {
+	if t.NumIn() == 0 || t.In(0) != ctxType {
+		return false
+	}
+	for i := 0; i < t.NumOut(); i++ {
+		if t.Out(i).Kind() == reflect.Chan {
+			return false
+		}
+	}
+	return true
+}
+
+func withTimeout(f reflect.Value, timeout time.Duration) reflect.Value {
+	orig := reflect.ValueOf(f.Interface())
+	return reflect.MakeFunc(f.Type(), func(args []reflect.Value) []reflect.Value {
+		ctx, cancel := context.WithTimeout(args[0].Interface().(context.Context), timeout)
+		defer cancel()
+		args[0] = reflect.ValueOf(ctx)
+		if orig.Type().IsVariadic() {
+			return orig.CallSlice(args)
+		}
+		return orig.Call(args)
+	})
+}
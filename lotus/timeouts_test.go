@@ -0,0 +1,87 @@
+package lotus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyCallTimeouts(t *testing.T) {
+	t.Parallel()
+
+	deadlines := map[string]time.Duration{}
+	record := func(method string, ctx context.Context) {
+		d, ok := ctx.Deadline()
+		if !ok {
+			deadlines[method] = 0
+			return
+		}
+		deadlines[method] = time.Until(d)
+	}
+
+	var c api.FullNodeStruct
+	c.CommonStruct.Internal.Version = func(ctx context.Context) (api.APIVersion, error) {
+		record("Version", ctx)
+		return api.APIVersion{}, nil
+	}
+	c.Internal.StateWaitMsg = func(ctx context.Context, _ cid.Cid, _ uint64, _ abi.ChainEpoch, _ bool) (*api.MsgLookup, error) {
+		record("StateWaitMsg", ctx)
+		return nil, nil
+	}
+	c.Internal.ChainHead = func(ctx context.Context) (*types.TipSet, error) {
+		record("ChainHead", ctx)
+		return nil, nil
+	}
+	c.Internal.ChainNotify = func(ctx context.Context) (<-chan []*api.HeadChange, error) {
+		record("ChainNotify", ctx)
+		return nil, nil
+	}
+
+	timeouts := CallTimeouts{
+		"Version":      time.Second,
+		"StateWaitMsg": time.Hour,
+		"ChainHead":    0,
+		"ChainNotify":  time.Minute,
+	}
+	applyCallTimeouts(&c, timeouts)
+
+	ctx := context.Background()
+	_, err := c.Version(ctx)
+	require.NoError(t, err)
+	_, err = c.StateWaitMsg(ctx, cid.Undef, 5, abi.ChainEpoch(10), true)
+	require.NoError(t, err)
+	_, err = c.ChainHead(ctx)
+	require.NoError(t, err)
+	_, err = c.ChainNotify(ctx)
+	require.NoError(t, err)
+
+	require.InDelta(t, time.Second, deadlines["Version"], float64(time.Millisecond*100))
+	require.InDelta(t, time.Hour, deadlines["StateWaitMsg"], float64(time.Millisecond*100))
+	// A zero timeout doesn't bound the call.
+	require.Zero(t, deadlines["ChainHead"])
+	// Subscriptions are never bounded.
+	require.Zero(t, deadlines["ChainNotify"])
+}
+
+func TestParseCallTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ct, err := ParseCallTimeouts("Version=5s, StateWaitMsg=1h,ChainHead=0s")
+	require.NoError(t, err)
+	require.Equal(t, CallTimeouts{"Version": time.Second * 5, "StateWaitMsg": time.Hour, "ChainHead": 0}, ct)
+
+	ct, err = ParseCallTimeouts("")
+	require.NoError(t, err)
+	require.Empty(t, ct)
+
+	_, err = ParseCallTimeouts("Version")
+	require.Error(t, err)
+	_, err = ParseCallTimeouts("Version=-1s")
+	require.Error(t, err)
+}
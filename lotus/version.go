@@ -0,0 +1,50 @@
+package lotus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/filecoin-project/lotus/api"
+)
+
+var (
+	// ErrIncompatibleVersion indicates the Lotus node API version is
+	// outside the range of supported versions.
+	ErrIncompatibleVersion = errors.New("incompatible lotus api version")
+
+	// SupportedAPIVersions is the range of Lotus full node API versions,
+	// as reported by the /rpc/v0 endpoint, known to be compatible.
+	SupportedAPIVersions = VersionRange{
+		Min: newVersion(1, 3, 0),
+		Max: newVersion(1, 255, 255),
+	}
+)
+
+// VersionRange is a range of Lotus API versions, including both ends.
+type VersionRange struct {
+	Min api.Version
+	Max api.Version
+}
+
+// Contains returns true if v is within the range.
+func (vr VersionRange) Contains(v api.Version) bool {
+	return v >= vr.Min && v <= vr.Max
+}
+
+// CheckAPIVersion returns the version reported by the Lotus node, and an
+// error wrapping ErrIncompatibleVersion if it isn't in the supported range.
+func CheckAPIVersion(ctx context.Context, c *api.FullNodeStruct, supported VersionRange) (api.APIVersion, error) {
+	v, err := c.Version(ctx)
+	if err != nil {
+		return api.APIVersion{}, fmt.Errorf("getting lotus version: %s", err)
+	}
+	if !supported.Contains(v.APIVersion) {
+		return v, fmt.Errorf("%w: node %s reports api version %s, supported versions are %s to %s", ErrIncompatibleVersion, v.Version, v.APIVersion, supported.Min, supported.Max)
+	}
+	return v, nil
+}
+
+func newVersion(major, minor, patch uint8) api.Version {
+	return api.Version(uint32(major)<<16 | uint32(minor)<<8 | uint32(patch))
+}
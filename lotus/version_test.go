@@ -0,0 +1,44 @@
+package lotus
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		version    api.Version
+		compatible bool
+	}{
+		{"min", SupportedAPIVersions.Min, true},
+		{"newer minor", newVersion(1, 4, 2), true},
+		{"max", SupportedAPIVersions.Max, true},
+		{"older minor", newVersion(1, 2, 9), false},
+		{"newer major", newVersion(2, 1, 0), false},
+		{"older major", newVersion(0, 17, 0), false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var c api.FullNodeStruct
+			c.CommonStruct.Internal.Version = func(context.Context) (api.APIVersion, error) {
+				return api.APIVersion{Version: "lotus-test", APIVersion: tt.version}, nil
+			}
+			v, err := CheckAPIVersion(context.Background(), &c, SupportedAPIVersions)
+			require.Equal(t, tt.version, v.APIVersion)
+			if tt.compatible {
+				require.NoError(t, err)
+			} else {
+				require.True(t, errors.Is(err, ErrIncompatibleVersion))
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package manifest
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// Version is the version of the manifest format.
+	Version = 1
+	// Suffix is appended to the path of an export to name its manifest.
+	Suffix = ".signed-manifest.json"
+)
+
+var (
+	// ErrInvalidSignature is returned when the manifest signature doesn't
+	// match its content or the expected signer.
+	ErrInvalidSignature = errors.New("invalid manifest signature")
+	// ErrPartMismatch is returned when an exported part doesn't match the
+	// hash or size in the manifest.
+	ErrPartMismatch = errors.New("exported part doesn't match manifest")
+)
+
+// Part describes an exported part, such as a CAR file, a config or
+// deal records.
+type Part struct {
+	Name   string
+	Size   int64
+	SHA256 string
+}
+
+// Manifest accompanies an export, with the hashes of every part and a
+// signature of the exporter, so recipients can verify its integrity and
+// origin.
+type Manifest struct {
+	Version int
+	Created time.Time
+	Parts   []Part
+	// Signer is the ed25519 public key which signed the manifest.
+	Signer []byte
+	// Signature signs the manifest without the signature.
+	Signature []byte
+}
+
+// Builder hashes the parts of an export to build its manifest.
+type Builder struct {
+	parts map[string]*partHash
+}
+
+type partHash struct {
+	h    hash.Hash
+	size int64
+}
+
+// NewBuilder returns a new Builder.
+func NewBuilder() *Builder {
+	return &Builder{parts: make(map[string]*partHash)}
+}
+
+// Writer returns a writer which hashes the part with the provided name
+// while it's written to w.
+func (b *Builder) Writer(name string, w io.Writer) (io.Writer, error) {
+	ph, err := b.add(name)
+	if err != nil {
+		return nil, err
+	}
+	return io.MultiWriter(w, ph), nil
+}
+
+// AddPart hashes the part with the provided name read from r.
+func (b *Builder) AddPart(name string, r io.Reader) error {
+	ph, err := b.add(name)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(ph, r); err != nil {
+		return fmt.Errorf("hashing part %s: %s", name, err)
+	}
+	return nil
+}
+
+func (b *Builder) add(name string) (*partHash, error) {
+	if name == "" {
+		return nil, fmt.Errorf("part name is empty")
+	}
+	if _, ok := b.parts[name]; ok {
+		return nil, fmt.Errorf("part %s is duplicated", name)
+	}
+	ph := &partHash{h: sha256.New()}
+	b.parts[name] = ph
+	return ph, nil
+}
+
+func (ph *partHash) Write(p []byte) (int, error) {
+	n, err := ph.h.Write(p)
+	ph.size += int64(n)
+	return n, err
+}
+
+// Sign returns the manifest of the added parts, sorted by name, signed
+// with key.
+func (b *Builder) Sign(key ed25519.PrivateKey) (Manifest, error) {
+	if len(key) != ed25519.PrivateKeySize {
+		return Manifest{}, fmt.Errorf("signing key is invalid")
+	}
+	m := Manifest{
+		Version: Version,
+		Created: time.Now().UTC(),
+		Signer:  key.Public().(ed25519.PublicKey),
+	}
+	for name, ph := range b.parts {
+		m.Parts = append(m.Parts, Part{Name: name, Size: ph.size, SHA256: hex.EncodeToString(ph.h.Sum(nil))})
+	}
+	sort.Slice(m.Parts, func(i, j int) bool { return m.Parts[i].Name < m.Parts[j].Name })
+	payload, err := m.payload()
+	if err != nil {
+		return Manifest{}, err
+	}
+	m.Signature = ed25519.Sign(key, payload)
+	return m, nil
+}
+
+// Verify checks the manifest is signed by signer, and that every part
+// opened by open matches its hash and size. Opened parts are closed
+// after being hashed. Errors wrap ErrInvalidSignature or ErrPartMismatch.
+func (m Manifest) Verify(signer ed25519.PublicKey, open func(name string) (io.ReadCloser, error)) error {
+	if m.Version != Version {
+		return fmt.Errorf("unsupported manifest version %d", m.Version)
+	}
+	if len(m.Signer) != ed25519.PublicKeySize || !ed25519.PublicKey(m.Signer).Equal(signer) {
+		return fmt.Errorf("%w: not signed by the expected signer", ErrInvalidSignature)
+	}
+	payload, err := m.payload()
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(signer, payload, m.Signature) {
+		return ErrInvalidSignature
+	}
+	for _, p := range m.Parts {
+		if err := verifyPart(p, open); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func verifyPart(p Part, open func(name string) (io.ReadCloser, error)) error {
+	r, err := open(p.Name)
+	if err != nil {
+		return fmt.Errorf("opening part %s: %s", p.Name, err)
+	}
+	defer func() { _ = r.Close() }()
+	ph := &partHash{h: sha256.New()}
+	if _, err := io.Copy(ph, r); err != nil {
+		return fmt.Errorf("hashing part %s: %s", p.Name, err)
+	}
+	if ph.size != p.Size || hex.EncodeToString(ph.h.Sum(nil)) != p.SHA256 {
+		return fmt.Errorf("%w: %s", ErrPartMismatch, p.Name)
+	}
+	return nil
+}
+
+// WriteFile writes the manifest as JSON to path.
+func (m Manifest) WriteFile(path string) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling manifest: %s", err)
+	}
+	if err := ioutil.WriteFile(path, b, 0644); err != nil {
+		return fmt.Errorf("writing manifest file: %s", err)
+	}
+	return nil
+}
+
+// ReadFile reads a manifest written by WriteFile.
+func ReadFile(path string) (Manifest, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("reading manifest file: %s", err)
+	}
+	var m Manifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return Manifest{}, fmt.Errorf("unmarshaling manifest: %s", err)
+	}
+	return m, nil
+}
+
+// DirOpener returns an open func for Verify which opens the parts as
+// files in dir. Part names can't refer to files outside dir.
+func DirOpener(dir string) func(name string) (io.ReadCloser, error) {
+	return func(name string) (io.ReadCloser, error) {
+		if name != filepath.Base(name) || name == ".." {
+			return nil, fmt.Errorf("part name %s isn't a file name", name)
+		}
+		return os.Open(filepath.Join(dir, name))
+	}
+}
+
+// payload returns the signed encoding of the manifest.
+func (m Manifest) payload() ([]byte, error) {
+	m.Signature = nil
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling manifest: %s", err)
+	}
+	return b, nil
+}
+
+// GenerateKey returns a new signing key.
+func GenerateKey() (ed25519.PrivateKey, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generating key: %s", err)
+	}
+	return key, nil
+}
+
+// LoadKey reads a signing key from a file with its hex-encoded seed, as
+// written by SaveKey.
+func LoadKey(path string) (ed25519.PrivateKey, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading key file: %s", err)
+	}
+	seed, err := hex.DecodeString(strings.TrimSpace(string(b)))
+	if err != nil {
+		return nil, fmt.Errorf("decoding key: %s", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("key seed should have %d bytes", ed25519.SeedSize)
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// SaveKey writes a signing key to a file readable only by the owner.
+func SaveKey(path string, key ed25519.PrivateKey) error {
+	if err := ioutil.WriteFile(path, []byte(hex.EncodeToString(key.Seed())), 0600); err != nil {
+		return fmt.Errorf("writing key file: %s", err)
+	}
+	return nil
+}
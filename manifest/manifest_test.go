@@ -0,0 +1,172 @@
+package manifest
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	key, err := GenerateKey()
+	require.NoError(t, err)
+
+	parts := map[string][]byte{
+		"data.car":    bytes.Repeat([]byte{1, 2, 3}, 1000),
+		"config.json": []byte(`{"RepFactor":2}`),
+		"deals.json":  []byte(`[{"DealID":1}]`),
+	}
+	b := NewBuilder()
+	var car bytes.Buffer
+	w, err := b.Writer("data.car", &car)
+	require.NoError(t, err)
+	_, err = w.Write(parts["data.car"])
+	require.NoError(t, err)
+	require.Equal(t, parts["data.car"], car.Bytes())
+	require.NoError(t, b.AddPart("config.json", bytes.NewReader(parts["config.json"])))
+	require.NoError(t, b.AddPart("deals.json", bytes.NewReader(parts["deals.json"])))
+	require.Error(t, b.AddPart("deals.json", bytes.NewReader(nil)))
+
+	m, err := b.Sign(key)
+	require.NoError(t, err)
+	require.Len(t, m.Parts, 3)
+	require.Equal(t, "config.json", m.Parts[0].Name)
+
+	// The manifest is verified after being transferred as JSON.
+	j, err := json.Marshal(m)
+	require.NoError(t, err)
+	var received Manifest
+	require.NoError(t, json.Unmarshal(j, &received))
+
+	pub := key.Public().(ed25519.PublicKey)
+	require.NoError(t, received.Verify(pub, open(parts)))
+
+	t.Run("TamperedPart", func(t *testing.T) {
+		t.Parallel()
+		tampered := map[string][]byte{}
+		for k, v := range parts {
+			tampered[k] = v
+		}
+		tampered["config.json"] = []byte(`{"RepFactor":1}`)
+		require.ErrorIs(t, received.Verify(pub, open(tampered)), ErrPartMismatch)
+	})
+
+	t.Run("TamperedManifest", func(t *testing.T) {
+		t.Parallel()
+		tampered := received
+		tampered.Parts = append([]Part(nil), received.Parts...)
+		tampered.Parts[0].Size++
+		require.ErrorIs(t, tampered.Verify(pub, open(parts)), ErrInvalidSignature)
+	})
+
+	t.Run("OtherSigner", func(t *testing.T) {
+		t.Parallel()
+		other, err := GenerateKey()
+		require.NoError(t, err)
+		require.ErrorIs(t, received.Verify(other.Public().(ed25519.PublicKey), open(parts)), ErrInvalidSignature)
+	})
+
+	t.Run("MissingPart", func(t *testing.T) {
+		t.Parallel()
+		require.Error(t, received.Verify(pub, open(map[string][]byte{})))
+	})
+}
+
+func TestKeyFile(t *testing.T) {
+	t.Parallel()
+
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, SaveKey(path, key))
+	loaded, err := LoadKey(path)
+	require.NoError(t, err)
+	require.Equal(t, key, loaded)
+
+	_, err = LoadKey(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
+
+func TestFiles(t *testing.T) {
+	t.Parallel()
+
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "deals.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`[{"DealID":1}]`), 0644))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	b := NewBuilder()
+	require.NoError(t, b.AddPart("deals.json", f))
+	require.NoError(t, f.Close())
+	m, err := b.Sign(key)
+	require.NoError(t, err)
+	require.NoError(t, m.WriteFile(path+Suffix))
+
+	read, err := ReadFile(path + Suffix)
+	require.NoError(t, err)
+	pub := key.Public().(ed25519.PublicKey)
+	require.NoError(t, read.Verify(pub, DirOpener(dir)))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`[{"DealID":2}]`), 0644))
+	require.ErrorIs(t, read.Verify(pub, DirOpener(dir)), ErrPartMismatch)
+
+	// Parts can't refer to files outside the directory.
+	read.Parts[0].Name = "../deals.json"
+	_, err = DirOpener(dir)(read.Parts[0].Name)
+	require.Error(t, err)
+}
+
+func TestVerifyClosesParts(t *testing.T) {
+	t.Parallel()
+
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	parts := map[string][]byte{"a": []byte("a"), "b": []byte("b")}
+	b := NewBuilder()
+	for name, p := range parts {
+		require.NoError(t, b.AddPart(name, bytes.NewReader(p)))
+	}
+	m, err := b.Sign(key)
+	require.NoError(t, err)
+
+	var opened, closed int
+	openCounting := func(name string) (io.ReadCloser, error) {
+		opened++
+		return &closeCounter{Reader: bytes.NewReader(parts[name]), closed: &closed}, nil
+	}
+	require.NoError(t, m.Verify(key.Public().(ed25519.PublicKey), openCounting))
+	require.Equal(t, 2, opened)
+	require.Equal(t, opened, closed)
+}
+
+type closeCounter struct {
+	io.Reader
+	closed *int
+}
+
+func (cc *closeCounter) Close() error {
+	*cc.closed++
+	return nil
+}
+
+func open(parts map[string][]byte) func(string) (io.ReadCloser, error) {
+	return func(name string) (io.ReadCloser, error) {
+		p, ok := parts[name]
+		if !ok {
+			return nil, fmt.Errorf("part %s not found", name)
+		}
+		return ioutil.NopCloser(bytes.NewReader(p)), nil
+	}
+}
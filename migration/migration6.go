@@ -0,0 +1,84 @@
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+const v6SourcesPrefix = "/reputation/reputation/store"
+
+// V6NormalizeReputationSources contains the logic to upgrade a datastore from
+// version 5 to version 6. Reputation source ids are case-insensitive, so
+// sources saved with mixed-case ids are re-keyed with their normalized id. If
+// the normalized id already exists, the mixed-case duplicate is removed.
+var V6NormalizeReputationSources = Migration{
+	UseTxn: true,
+	Run: func(ds datastoreReaderWriter) error {
+		q := query.Query{Prefix: v6SourcesPrefix}
+		res, err := ds.Query(q)
+		if err != nil {
+			return fmt.Errorf("querying sources: %s", err)
+		}
+		defer func() { _ = res.Close() }()
+
+		type source struct {
+			key   datastore.Key
+			value map[string]json.RawMessage
+		}
+		var sources []source
+		for r := range res.Next() {
+			if r.Error != nil {
+				return fmt.Errorf("iterating results: %s", r.Error)
+			}
+			var value map[string]json.RawMessage
+			if err := json.Unmarshal(r.Value, &value); err != nil {
+				return fmt.Errorf("unmarshaling source %s: %s", r.Key, err)
+			}
+			sources = append(sources, source{key: datastore.NewKey(r.Key), value: value})
+		}
+
+		var normalized, removed int
+		for _, s := range sources {
+			var id string
+			if err := json.Unmarshal(s.value["ID"], &id); err != nil {
+				return fmt.Errorf("unmarshaling id of source %s: %s", s.key, err)
+			}
+			normID := strings.ToLower(strings.TrimSpace(id))
+			newKey := datastore.NewKey(v6SourcesPrefix).ChildString(normID)
+			if normID == id && newKey.Equal(s.key) {
+				continue
+			}
+			if err := ds.Delete(s.key); err != nil {
+				return fmt.Errorf("deleting source %s: %s", s.key, err)
+			}
+			exists, err := ds.Has(newKey)
+			if err != nil {
+				return fmt.Errorf("checking normalized source %s: %s", newKey, err)
+			}
+			if exists {
+				log.Warnf("removing source %q duplicating %q", id, normID)
+				removed++
+				continue
+			}
+			s.value["ID"], err = json.Marshal(normID)
+			if err != nil {
+				return fmt.Errorf("marshaling normalized id: %s", err)
+			}
+			buf, err := json.Marshal(s.value)
+			if err != nil {
+				return fmt.Errorf("marshaling normalized source: %s", err)
+			}
+			if err := ds.Put(newKey, buf); err != nil {
+				return fmt.Errorf("saving normalized source %s: %s", newKey, err)
+			}
+			normalized++
+		}
+		log.Infof("normalized %d reputation sources, removed %d duplicates", normalized, removed)
+
+		return nil
+	},
+}
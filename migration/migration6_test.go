@@ -0,0 +1,23 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestV6(t *testing.T) {
+	t.Parallel()
+
+	ds := tests.NewTxMapDatastore()
+
+	pre(t, ds, "testdata/v6_ReputationSources.pre")
+	txn, _ := ds.NewTransaction(false)
+
+	err := V6NormalizeReputationSources.Run(txn)
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit())
+
+	post(t, ds, "testdata/v6_ReputationSources.post")
+}
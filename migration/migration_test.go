@@ -223,6 +223,7 @@ func TestRealDataBadgerFromV3(t *testing.T) {
 		3: V3StorageJobsIndexMigration,
 		4: V4RecordsMigration,
 		5: V5DeleteOldMinerIndex,
+		6: V6NormalizeReputationSources,
 	}
 	m := New(ds, migrations)
 	err = m.Ensure()
@@ -0,0 +1,45 @@
+package random
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Source is a source of randomness for selection and scheduling decisions.
+// Components take a Source so tests can make them deterministic with a
+// fixed seed.
+type Source interface {
+	// Int63n returns a random number in [0, n). It panics if n <= 0.
+	Int63n(n int64) int64
+	// Shuffle randomizes the order of n elements swapped by swap.
+	Shuffle(n int, swap func(i, j int))
+}
+
+// Default is the Source used by components unless another is configured.
+// It's seeded with the start time.
+var Default Source = New(time.Now().UnixNano())
+
+// lockedSource is a Source safe for concurrent use.
+type lockedSource struct {
+	lock sync.Mutex
+	r    *rand.Rand
+}
+
+// New returns a Source, safe for concurrent use, which produces the same
+// sequence for the same seed.
+func New(seed int64) Source {
+	return &lockedSource{r: rand.New(rand.NewSource(seed))}
+}
+
+func (s *lockedSource) Int63n(n int64) int64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.r.Int63n(n)
+}
+
+func (s *lockedSource) Shuffle(n int, swap func(i, j int)) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.r.Shuffle(n, swap)
+}
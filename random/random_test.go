@@ -0,0 +1,38 @@
+package random
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeededSource(t *testing.T) {
+	t.Parallel()
+
+	sequence := func(src Source) []int64 {
+		var res []int64
+		for i := 0; i < 10; i++ {
+			res = append(res, src.Int63n(1000))
+		}
+		perm := []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		src.Shuffle(len(perm), func(i, j int) { perm[i], perm[j] = perm[j], perm[i] })
+		return append(res, perm...)
+	}
+	require.Equal(t, sequence(New(42)), sequence(New(42)))
+	require.NotEqual(t, sequence(New(42)), sequence(New(43)))
+
+	// Sources are safe for concurrent use.
+	src := New(42)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				require.Less(t, src.Int63n(10), int64(10))
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,138 @@
+package reputation
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/textileio/powergate/v2/reputation/internal/source"
+)
+
+const (
+	// MaxExternalScore is the upper bound of the scale that external
+	// feed scores are normalized to.
+	MaxExternalScore = 100
+)
+
+// FeedEntry is a miner score reported by an external reputation feed.
+// Scores can be in any scale, since they're normalized on import.
+type FeedEntry struct {
+	Miner string
+	Score float64
+}
+
+// FeedParser decodes an external reputation feed in a particular format.
+type FeedParser interface {
+	Parse(io.Reader) ([]FeedEntry, error)
+}
+
+// JSONFeed parses feeds encoded as a JSON array of objects with
+// "miner" and "score" fields.
+type JSONFeed struct{}
+
+var _ FeedParser = JSONFeed{}
+
+// Parse implements FeedParser.
+func (JSONFeed) Parse(r io.Reader) ([]FeedEntry, error) {
+	var raw []struct {
+		Miner string  `json:"miner"`
+		Score float64 `json:"score"`
+	}
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("decoding json feed: %s", err)
+	}
+	entries := make([]FeedEntry, len(raw))
+	for i, e := range raw {
+		entries[i] = FeedEntry{Miner: e.Miner, Score: e.Score}
+	}
+	return entries, nil
+}
+
+// CSVFeed parses feeds encoded as CSV rows of miner,score. Rows with a
+// non-numeric score, such as a header, are skipped.
+type CSVFeed struct{}
+
+var _ FeedParser = CSVFeed{}
+
+// Parse implements FeedParser.
+func (CSVFeed) Parse(r io.Reader) ([]FeedEntry, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 2
+	cr.TrimLeadingSpace = true
+	var entries []FeedEntry
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading csv feed: %s", err)
+		}
+		score, err := strconv.ParseFloat(strings.TrimSpace(rec[1]), 64)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, FeedEntry{Miner: strings.TrimSpace(rec[0]), Score: score})
+	}
+	return entries, nil
+}
+
+// ImportFeed ingests an external reputation feed as a Source with the
+// provided id and weight. Feed scores are min-max normalized to
+// [0, MaxExternalScore]. If the Source already exists, its scores are
+// replaced with the imported ones.
+func (rm *Module) ImportFeed(id string, weight float64, r io.Reader, p FeedParser) error {
+	entries, err := p.Parse(r)
+	if err != nil {
+		return fmt.Errorf("parsing feed: %s", err)
+	}
+	now := time.Now()
+	s := source.Source{
+		ID:          id,
+		Type:        source.TypeFeed,
+		Weight:      weight,
+		Scores:      normalizeFeed(entries),
+		LastFetched: &now,
+	}
+	err = rm.sources.Add(s)
+	if errors.Is(err, source.ErrAlreadyExists) {
+		err = rm.sources.Update(s)
+	}
+	if err != nil {
+		return fmt.Errorf("saving imported source: %s", err)
+	}
+	select {
+	case rm.rebuild <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func normalizeFeed(entries []FeedEntry) map[string]int {
+	scores := make(map[string]int, len(entries))
+	if len(entries) == 0 {
+		return scores
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, e := range entries {
+		lo = math.Min(lo, e.Score)
+		hi = math.Max(hi, e.Score)
+	}
+	for _, e := range entries {
+		if e.Miner == "" {
+			continue
+		}
+		if hi == lo {
+			scores[e.Miner] = MaxExternalScore
+			continue
+		}
+		scores[e.Miner] = int(math.Round((e.Score - lo) / (hi - lo) * MaxExternalScore))
+	}
+	return scores
+}
@@ -0,0 +1,67 @@
+package source
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ExportVersion is the version of the format written by ExportSources.
+const ExportVersion = 1
+
+type exportHeader struct {
+	Version int `json:"version"`
+}
+
+// ExportSources writes all Sources as JSON lines. The first line is a
+// header with the format version, followed by one Source per line.
+func (ss *Store) ExportSources(w io.Writer) error {
+	srcs, err := ss.GetAll()
+	if err != nil {
+		return fmt.Errorf("getting all sources: %s", err)
+	}
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	if err := enc.Encode(exportHeader{Version: ExportVersion}); err != nil {
+		return fmt.Errorf("writing header: %s", err)
+	}
+	for _, s := range srcs {
+		if err := enc.Encode(s); err != nil {
+			return fmt.Errorf("writing source %s: %s", s.ID, err)
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("flushing export: %s", err)
+	}
+	return nil
+}
+
+// ImportSources adds the Sources written by ExportSources. The import is
+// atomic: if any Source fails to decode or already exists, none are added.
+func (ss *Store) ImportSources(r io.Reader) error {
+	dec := json.NewDecoder(bufio.NewReader(r))
+	var h exportHeader
+	if err := dec.Decode(&h); err != nil {
+		return fmt.Errorf("reading header: %s", err)
+	}
+	if h.Version != ExportVersion {
+		return fmt.Errorf("unsupported export version %d", h.Version)
+	}
+	var srcs []Source
+	for {
+		var s Source
+		err := dec.Decode(&s)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("decoding source %d: %s", len(srcs), err)
+		}
+		srcs = append(srcs, s)
+	}
+	if err := ss.AddMany(srcs); err != nil {
+		return fmt.Errorf("adding sources: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package source
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestExportImportSources(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{0, 1, 5000} {
+		n := n
+		t.Run(fmt.Sprintf("%d", n), func(t *testing.T) {
+			t.Parallel()
+
+			src := NewStore(tests.NewTxMapDatastore(), false)
+			maddr, err := ma.NewMultiaddr("/dns4/reputation.example.com/tcp/443/https")
+			require.NoError(t, err)
+			now := time.Unix(time.Now().Unix(), 0)
+			srcs := make([]Source, n)
+			for i := range srcs {
+				srcs[i] = Source{
+					ID:          fmt.Sprintf("source-%05d", i),
+					Weight:      float64(i%10) / 10,
+					Scores:      map[string]int{fmt.Sprintf("f0%d", i): i % 100, "f01000": 50},
+					LastFetched: &now,
+				}
+				if i%2 == 0 {
+					srcs[i].Maddr = maddr
+				}
+			}
+			require.NoError(t, src.AddMany(srcs))
+
+			var buf bytes.Buffer
+			require.NoError(t, src.ExportSources(&buf))
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			require.Len(t, lines, n+1)
+			require.Equal(t, `{"version":1}`, lines[0])
+
+			dst := NewStore(tests.NewTxMapDatastore(), false)
+			require.NoError(t, dst.ImportSources(&buf))
+			all, err := dst.GetAll()
+			require.NoError(t, err)
+			sort.Slice(all, func(i, j int) bool { return all[i].ID < all[j].ID })
+			require.Len(t, all, n)
+			for i := range all {
+				require.Equal(t, srcs[i].ID, all[i].ID)
+				require.Equal(t, srcs[i].Weight, all[i].Weight)
+				require.Equal(t, srcs[i].Scores, all[i].Scores)
+				require.True(t, srcs[i].LastFetched.Equal(*all[i].LastFetched))
+				require.Equal(t, srcs[i].Maddr, all[i].Maddr)
+			}
+		})
+	}
+}
+
+func TestImportSourcesErrors(t *testing.T) {
+	t.Parallel()
+
+	ss := NewStore(tests.NewTxMapDatastore(), false)
+	require.Error(t, ss.ImportSources(strings.NewReader(`{"version":2}`+"\n")))
+	require.Error(t, ss.ImportSources(strings.NewReader(`{"version":1}`+"\n{bad")))
+	require.Error(t, ss.ImportSources(strings.NewReader(`{"version":1}`+"\n"+`{"ID":"a","Maddr":"bad"}`+"\n")))
+
+	// Importing an existing source is atomic.
+	require.NoError(t, ss.Add(Source{ID: "b"}))
+	in := `{"version":1}` + "\n" + `{"ID":"a"}` + "\n" + `{"ID":"b"}` + "\n"
+	require.Error(t, ss.ImportSources(strings.NewReader(in)))
+	all, err := ss.GetAll()
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+}
@@ -2,20 +2,108 @@ package source
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 	"time"
 
 	ma "github.com/multiformats/go-multiaddr"
 )
 
+const (
+	// TypeOnChain is the type of Sources derived from on-chain data.
+	TypeOnChain = "on-chain"
+	// TypeFeed is the type of Sources imported from external feeds.
+	TypeFeed = "feed"
+)
+
+// ErrInvalidSource is wrapped by the errors of invalid Sources.
+var ErrInvalidSource = errors.New("invalid source")
+
 // Source is an external source of reputation information.
 type Source struct {
 	ID          string
+	Type        string
 	Weight      float64
 	Scores      map[string]int
 	Maddr       ma.Multiaddr
 	LastFetched *time.Time
 }
 
+// sourceJSON is the JSON representation of a Source. The multiaddress is
+// a string, since a ma.Multiaddr interface can't be decoded.
+type sourceJSON struct {
+	ID          string
+	Type        string
+	Weight      float64
+	Scores      map[string]int
+	Maddr       string
+	LastFetched *time.Time
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s Source) MarshalJSON() ([]byte, error) {
+	sj := sourceJSON{
+		ID:          s.ID,
+		Type:        s.Type,
+		Weight:      s.Weight,
+		Scores:      s.Scores,
+		LastFetched: s.LastFetched,
+	}
+	if s.Maddr != nil {
+		sj.Maddr = s.Maddr.String()
+	}
+	return json.Marshal(sj)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Source) UnmarshalJSON(b []byte) error {
+	var sj sourceJSON
+	if err := json.Unmarshal(b, &sj); err != nil {
+		return err
+	}
+	var maddr ma.Multiaddr
+	if sj.Maddr != "" {
+		var err error
+		maddr, err = ma.NewMultiaddr(sj.Maddr)
+		if err != nil {
+			return fmt.Errorf("parsing multiaddress: %s", err)
+		}
+	}
+	*s = Source{
+		ID:          sj.ID,
+		Type:        sj.Type,
+		Weight:      sj.Weight,
+		Scores:      sj.Scores,
+		Maddr:       maddr,
+		LastFetched: sj.LastFetched,
+	}
+	return nil
+}
+
+// Validate returns an error wrapping ErrInvalidSource if the Source is
+// malformed.
+func (s Source) Validate() error {
+	if NormalizeID(s.ID) == "" {
+		return ErrInvalidID
+	}
+	switch s.Type {
+	case "", TypeOnChain, TypeFeed:
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidSource, s.Type)
+	}
+	if math.IsNaN(s.Weight) || math.IsInf(s.Weight, 0) || s.Weight < 0 {
+		return fmt.Errorf("%w: weight %v isn't a non-negative number", ErrInvalidSource, s.Weight)
+	}
+	if s.Maddr != nil {
+		if _, err := ma.NewMultiaddrBytes(s.Maddr.Bytes()); err != nil {
+			return fmt.Errorf("%w: multiaddress is invalid: %s", ErrInvalidSource, err)
+		}
+	}
+	return nil
+}
+
 // Refresh pulls fresh information from source.
 func (s *Source) Refresh(ctx context.Context) error {
 	// ToDo: pull from Maddr fresh reputation information
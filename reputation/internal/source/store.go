@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/query"
@@ -17,6 +19,9 @@ var (
 	ErrAlreadyExists = errors.New("source already exists")
 	// ErrDoesntExists returns when the source isn't in the Store.
 	ErrDoesntExists = errors.New("source doesn't exist")
+	// ErrInvalidID returns when the source id is empty. It wraps
+	// ErrInvalidSource.
+	ErrInvalidID = fmt.Errorf("%w: id is empty", ErrInvalidSource)
 
 	baseKey = datastore.NewKey("/reputation/store")
 )
@@ -24,42 +29,113 @@ var (
 // Store contains Sources information.
 type Store struct {
 	ds datastore.TxnDatastore
+
+	// cacheLock guards the cached GetAll result, which is invalidated
+	// on every write. cacheGen is increased on invalidation, so a
+	// GetAll racing with a write doesn't cache stale Sources.
+	cacheEnabled bool
+	cacheLock    sync.Mutex
+	cacheGen     uint64
+	cached       bool
+	cache        []Source
 }
 
-// NewStore returns a new SourceStore.
-func NewStore(ds datastore.TxnDatastore) *Store {
+// NewStore returns a new SourceStore. If cache is true, GetAll results
+// are served from memory until the Sources change.
+func NewStore(ds datastore.TxnDatastore, cache bool) *Store {
 	return &Store{
-		ds: ds,
+		ds:           ds,
+		cacheEnabled: cache,
 	}
 }
 
-// Add adds a new Source to the store.
+// NormalizeID returns the canonical form of a Source id. Ids are
+// case-insensitive and surrounding whitespace is ignored, so ids which only
+// differ in those refer to the same Source.
+func NormalizeID(id string) string {
+	return strings.ToLower(strings.TrimSpace(id))
+}
+
+// Add adds a new Source to the store. The Source id is stored normalized,
+// and adding an id that normalizes to an existing one fails with
+// ErrAlreadyExists.
 func (ss *Store) Add(s Source) error {
+	defer ss.invalidate()
+
+	if err := s.Validate(); err != nil {
+		return fmt.Errorf("validating source: %w", err)
+	}
 	txn, err := ss.ds.NewTransaction(false)
 	if err != nil {
 		return err
 	}
 	defer txn.Discard()
 
-	k := genKey(s.ID)
-	ok, err := txn.Has(k)
-	if err != nil {
+	if err := normalize(&s); err != nil {
 		return err
 	}
-	if ok {
-		return ErrAlreadyExists
+	if err := checkCollision(txn, s.ID); err != nil {
+		return err
 	}
 	return ss.put(txn, s)
 }
 
+// AddMany adds new Sources to the store in a single transaction. If any
+// of them already exists, none are added.
+func (ss *Store) AddMany(srcs []Source) error {
+	defer ss.invalidate()
+
+	for _, s := range srcs {
+		if err := s.Validate(); err != nil {
+			return fmt.Errorf("validating source %s: %w", s.ID, err)
+		}
+	}
+	txn, err := ss.ds.NewTransaction(false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard()
+
+	seen := make(map[string]struct{}, len(srcs))
+	for _, s := range srcs {
+		if err := normalize(&s); err != nil {
+			return err
+		}
+		if _, ok := seen[s.ID]; ok {
+			return fmt.Errorf("%w: %s is duplicated", ErrAlreadyExists, s.ID)
+		}
+		seen[s.ID] = struct{}{}
+		if err := checkCollision(txn, s.ID); err != nil {
+			return err
+		}
+		b, err := json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		if err := txn.Put(genKey(s.ID), b); err != nil {
+			return err
+		}
+	}
+	return txn.Commit()
+}
+
 // Update updates a Source.
 func (ss *Store) Update(s Source) error {
+	defer ss.invalidate()
+
+	if err := s.Validate(); err != nil {
+		return fmt.Errorf("validating source: %w", err)
+	}
 	txn, err := ss.ds.NewTransaction(false)
 	if err != nil {
 		return err
 	}
-	k := genKey(s.ID)
-	ok, err := txn.Has(k)
+	defer txn.Discard()
+
+	if err := normalize(&s); err != nil {
+		return err
+	}
+	ok, err := txn.Has(genKey(s.ID))
 	if err != nil {
 		return err
 	}
@@ -69,14 +145,114 @@ func (ss *Store) Update(s Source) error {
 	return ss.put(txn, s)
 }
 
+// Delete removes a Source. The id is normalized, so any equivalent id
+// removes it.
+func (ss *Store) Delete(id string) error {
+	defer ss.invalidate()
+
+	txn, err := ss.ds.NewTransaction(false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard()
+
+	id = NormalizeID(id)
+	if id == "" {
+		return ErrInvalidID
+	}
+	ok, err := txn.Has(genKey(id))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrDoesntExists
+	}
+	if err := txn.Delete(genKey(id)); err != nil {
+		return err
+	}
+	return txn.Commit()
+}
+
+// Get returns the Source with the provided id. The id is normalized, so
+// any equivalent id returns it.
+func (ss *Store) Get(id string) (Source, error) {
+	id = NormalizeID(id)
+	if id == "" {
+		return Source{}, ErrInvalidID
+	}
+	b, err := ss.ds.Get(genKey(id))
+	if err == datastore.ErrNotFound {
+		return Source{}, ErrDoesntExists
+	}
+	if err != nil {
+		return Source{}, err
+	}
+	var s Source
+	if err := json.Unmarshal(b, &s); err != nil {
+		return Source{}, err
+	}
+	return s, nil
+}
+
 // GetAll returns all Sources.
 func (ss *Store) GetAll() ([]Source, error) {
+	q := query.Query{Prefix: baseKey.String()}
+	if !ss.cacheEnabled {
+		return ss.query(q, func(Source) bool { return true })
+	}
+
+	ss.cacheLock.Lock()
+	if ss.cached {
+		defer ss.cacheLock.Unlock()
+		return copySources(ss.cache), nil
+	}
+	gen := ss.cacheGen
+	ss.cacheLock.Unlock()
+
+	srcs, err := ss.query(q, func(Source) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+
+	ss.cacheLock.Lock()
+	defer ss.cacheLock.Unlock()
+	if gen == ss.cacheGen {
+		ss.cache = copySources(srcs)
+		ss.cached = true
+	}
+	return srcs, nil
+}
+
+// GetPage returns at most limit Sources sorted by id, skipping the first
+// offset ones.
+func (ss *Store) GetPage(offset, limit int) ([]Source, error) {
+	if offset < 0 {
+		return nil, fmt.Errorf("offset can't be negative")
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit should be greater than zero")
+	}
+	q := query.Query{
+		Prefix: baseKey.String(),
+		Orders: []query.Order{query.OrderByKey{}},
+		Offset: offset,
+		Limit:  limit,
+	}
+	return ss.query(q, func(Source) bool { return true })
+}
+
+// GetByType returns all Sources of the provided type.
+func (ss *Store) GetByType(t string) ([]Source, error) {
+	q := query.Query{Prefix: baseKey.String()}
+	return ss.query(q, func(s Source) bool { return s.Type == t })
+}
+
+func (ss *Store) query(q query.Query, filter func(Source) bool) ([]Source, error) {
 	txn, err := ss.ds.NewTransaction(true)
 	if err != nil {
 		return nil, err
 	}
 	defer txn.Discard()
-	q := query.Query{Prefix: baseKey.String()}
 	res, err := txn.Query(q)
 	if err != nil {
 		return nil, err
@@ -95,11 +271,25 @@ func (ss *Store) GetAll() ([]Source, error) {
 		if err := json.Unmarshal(r.Value, &s); err != nil {
 			return nil, err
 		}
-		ret = append(ret, s)
+		if filter(s) {
+			ret = append(ret, s)
+		}
 	}
 	return ret, nil
 }
 
+// invalidate discards the cached GetAll result.
+func (ss *Store) invalidate() {
+	if !ss.cacheEnabled {
+		return
+	}
+	ss.cacheLock.Lock()
+	defer ss.cacheLock.Unlock()
+	ss.cacheGen++
+	ss.cached = false
+	ss.cache = nil
+}
+
 func (ss *Store) put(txn datastore.Txn, s Source) error {
 	b, err := json.Marshal(s)
 	if err != nil {
@@ -111,6 +301,45 @@ func (ss *Store) put(txn datastore.Txn, s Source) error {
 	return txn.Commit()
 }
 
+// copySources returns a copy of srcs which doesn't share Scores with it.
+func copySources(srcs []Source) []Source {
+	if srcs == nil {
+		return nil
+	}
+	res := make([]Source, len(srcs))
+	for i, s := range srcs {
+		if s.Scores != nil {
+			scores := make(map[string]int, len(s.Scores))
+			for k, v := range s.Scores {
+				scores[k] = v
+			}
+			s.Scores = scores
+		}
+		res[i] = s
+	}
+	return res
+}
+
+func normalize(s *Source) error {
+	s.ID = NormalizeID(s.ID)
+	if s.ID == "" {
+		return ErrInvalidID
+	}
+	return nil
+}
+
+func checkCollision(txn datastore.Txn, id string) error {
+	ok, err := txn.Has(genKey(id))
+	if err != nil {
+		return err
+	}
+	if ok {
+		return fmt.Errorf("%w: %s", ErrAlreadyExists, id)
+	}
+	return nil
+}
+
+// genKey returns the key of a Source with an already normalized id.
 func genKey(id string) datastore.Key {
 	return baseKey.ChildString(id)
 }
@@ -0,0 +1,301 @@
+package source
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestGetByType(t *testing.T) {
+	t.Parallel()
+
+	ss := NewStore(tests.NewTxMapDatastore(), false)
+	require.NoError(t, ss.AddMany([]Source{
+		{ID: "chain-a", Type: TypeOnChain},
+		{ID: "feed-a", Type: TypeFeed},
+		{ID: "chain-b", Type: TypeOnChain},
+		{ID: "untyped"},
+	}))
+
+	ids := func(srcs []Source) []string {
+		var res []string
+		for _, s := range srcs {
+			res = append(res, s.ID)
+		}
+		sort.Strings(res)
+		return res
+	}
+
+	srcs, err := ss.GetByType(TypeOnChain)
+	require.NoError(t, err)
+	require.Equal(t, []string{"chain-a", "chain-b"}, ids(srcs))
+
+	srcs, err = ss.GetByType(TypeFeed)
+	require.NoError(t, err)
+	require.Equal(t, []string{"feed-a"}, ids(srcs))
+
+	srcs, err = ss.GetByType("")
+	require.NoError(t, err)
+	require.Equal(t, []string{"untyped"}, ids(srcs))
+
+	srcs, err = ss.GetByType("unknown")
+	require.NoError(t, err)
+	require.Empty(t, srcs)
+
+	all, err := ss.GetAll()
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+}
+
+func TestNormalizedIDs(t *testing.T) {
+	t.Parallel()
+
+	ss := NewStore(tests.NewTxMapDatastore(), false)
+	require.NoError(t, ss.Add(Source{ID: "  Feed-A\t", Weight: 1}))
+
+	// Near-duplicates of an existing id collide.
+	for _, id := range []string{"feed-a", "FEED-A", " feed-a ", "Feed-A\n"} {
+		err := ss.Add(Source{ID: id})
+		require.ErrorIs(t, err, ErrAlreadyExists, id)
+	}
+	require.ErrorIs(t, ss.Add(Source{ID: " \t"}), ErrInvalidID)
+
+	all, err := ss.GetAll()
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, "feed-a", all[0].ID)
+	require.Equal(t, "feed-a", NormalizeID("\tFEED-a "))
+
+	// Updates find the Source by any equivalent id.
+	require.NoError(t, ss.Update(Source{ID: "FEED-A", Weight: 2}))
+	all, err = ss.GetAll()
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, "feed-a", all[0].ID)
+	require.Equal(t, 2.0, all[0].Weight)
+
+	// Ids differing in inner characters are different Sources.
+	require.NoError(t, ss.Add(Source{ID: "feed-a2"}))
+	require.NoError(t, ss.Add(Source{ID: "feed a"}))
+
+	// Batches with near-duplicates are rejected as a whole.
+	err = ss.AddMany([]Source{{ID: "x"}, {ID: "Y"}, {ID: " y"}})
+	require.ErrorIs(t, err, ErrAlreadyExists)
+	all, err = ss.GetAll()
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	ss := NewStore(tests.NewTxMapDatastore(), false)
+	require.NoError(t, ss.AddMany([]Source{{ID: "feed-a"}, {ID: "feed-b"}}))
+
+	require.NoError(t, ss.Delete(" FEED-A"))
+	all, err := ss.GetAll()
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, "feed-b", all[0].ID)
+
+	require.Equal(t, ErrDoesntExists, ss.Delete("feed-a"))
+	require.Equal(t, ErrInvalidID, ss.Delete(" "))
+
+	// Deleted ids can be added again.
+	require.NoError(t, ss.Add(Source{ID: "feed-a"}))
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	ds := &queryCountingDatastore{TxnDatastore: tests.NewTxMapDatastore()}
+	ss := NewStore(ds, false)
+	require.NoError(t, ss.AddMany([]Source{{ID: "feed-a", Weight: 1}, {ID: "feed-b", Weight: 2}}))
+
+	s, err := ss.Get("Feed-B ")
+	require.NoError(t, err)
+	require.Equal(t, "feed-b", s.ID)
+	require.Equal(t, 2.0, s.Weight)
+
+	_, err = ss.Get("feed-c")
+	require.Equal(t, ErrDoesntExists, err)
+	_, err = ss.Get("")
+	require.Equal(t, ErrInvalidID, err)
+
+	// Sources are fetched by key, without querying the prefix.
+	require.Zero(t, ds.queries)
+}
+
+func TestGetPage(t *testing.T) {
+	t.Parallel()
+
+	ss := NewStore(tests.NewTxMapDatastore(), false)
+	srcs := make([]Source, 50)
+	for i := range srcs {
+		// Added in reverse order to check pages are sorted by id.
+		srcs[i] = Source{ID: fmt.Sprintf("feed-%02d", len(srcs)-1-i)}
+	}
+	require.NoError(t, ss.AddMany(srcs))
+
+	var ids []string
+	for offset := 0; ; offset += 10 {
+		page, err := ss.GetPage(offset, 10)
+		require.NoError(t, err)
+		if len(page) == 0 {
+			break
+		}
+		require.Len(t, page, 10)
+		for _, s := range page {
+			ids = append(ids, s.ID)
+		}
+	}
+	require.Len(t, ids, 50)
+	for i, id := range ids {
+		require.Equal(t, fmt.Sprintf("feed-%02d", i), id)
+	}
+
+	_, err := ss.GetPage(-1, 10)
+	require.Error(t, err)
+	_, err = ss.GetPage(0, 0)
+	require.Error(t, err)
+}
+
+func TestGetAllCache(t *testing.T) {
+	t.Parallel()
+
+	ds := &queryCountingDatastore{TxnDatastore: tests.NewTxMapDatastore()}
+	ss := NewStore(ds, true)
+	require.NoError(t, ss.Add(Source{ID: "feed-a", Scores: map[string]int{"f01": 1}}))
+
+	all, err := ss.GetAll()
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, 1, ds.queries)
+
+	// A second GetAll is served from memory, and isn't affected by
+	// changes to the returned Sources.
+	all[0].Scores["f01"] = 2
+	all, err = ss.GetAll()
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, 1, all[0].Scores["f01"])
+	require.Equal(t, 1, ds.queries)
+
+	// Writes invalidate the cache.
+	require.NoError(t, ss.Add(Source{ID: "feed-b"}))
+	all, err = ss.GetAll()
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, 2, ds.queries)
+
+	require.NoError(t, ss.Update(Source{ID: "feed-b", Weight: 1}))
+	all, err = ss.GetAll()
+	require.NoError(t, err)
+	for _, s := range all {
+		require.Equal(t, s.ID == "feed-b", s.Weight == 1.0)
+	}
+	require.Equal(t, 3, ds.queries)
+
+	require.NoError(t, ss.Delete("feed-a"))
+	all, err = ss.GetAll()
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, 4, ds.queries)
+
+	// Without cache, every GetAll queries the datastore.
+	ds = &queryCountingDatastore{TxnDatastore: tests.NewTxMapDatastore()}
+	ss = NewStore(ds, false)
+	_, err = ss.GetAll()
+	require.NoError(t, err)
+	_, err = ss.GetAll()
+	require.NoError(t, err)
+	require.Equal(t, 2, ds.queries)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	maddr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/5001")
+	require.NoError(t, err)
+	require.NoError(t, Source{ID: "feed-a", Maddr: maddr}.Validate())
+	valid := Source{ID: "feed-a", Type: TypeFeed, Weight: 0.5}
+	require.NoError(t, valid.Validate())
+
+	cases := []struct {
+		name   string
+		modify func(*Source)
+	}{
+		{"EmptyID", func(s *Source) { s.ID = " " }},
+		{"UnknownType", func(s *Source) { s.Type = "oracle" }},
+		{"NegativeWeight", func(s *Source) { s.Weight = -1 }},
+		{"NaNWeight", func(s *Source) { s.Weight = math.NaN() }},
+		{"InvalidMaddr", func(s *Source) { s.Maddr = invalidMultiaddr{maddr} }},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := valid
+			tc.modify(&s)
+			require.ErrorIs(t, s.Validate(), ErrInvalidSource)
+
+			// Invalid sources aren't stored.
+			ss := NewStore(tests.NewTxMapDatastore(), false)
+			require.ErrorIs(t, ss.Add(s), ErrInvalidSource)
+			require.ErrorIs(t, ss.AddMany([]Source{s}), ErrInvalidSource)
+			all, err := ss.GetAll()
+			require.NoError(t, err)
+			require.Empty(t, all)
+
+			require.NoError(t, ss.Add(valid))
+			require.ErrorIs(t, ss.Update(s), ErrInvalidSource)
+			stored, err := ss.Get(valid.ID)
+			require.NoError(t, err)
+			require.Equal(t, valid.Weight, stored.Weight)
+		})
+	}
+}
+
+// invalidMultiaddr is a Multiaddr whose bytes don't parse.
+type invalidMultiaddr struct {
+	ma.Multiaddr
+}
+
+func (invalidMultiaddr) Bytes() []byte {
+	return []byte{0xff, 0xff, 0xff}
+}
+
+type queryCountingDatastore struct {
+	datastore.TxnDatastore
+	queries int
+}
+
+func (ds *queryCountingDatastore) Query(q query.Query) (query.Results, error) {
+	ds.queries++
+	return ds.TxnDatastore.Query(q)
+}
+
+func (ds *queryCountingDatastore) NewTransaction(readOnly bool) (datastore.Txn, error) {
+	txn, err := ds.TxnDatastore.NewTransaction(readOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &queryCountingTxn{Txn: txn, ds: ds}, nil
+}
+
+type queryCountingTxn struct {
+	datastore.Txn
+	ds *queryCountingDatastore
+}
+
+func (txn *queryCountingTxn) Query(q query.Query) (query.Results, error) {
+	txn.ds.queries++
+	return txn.Txn.Query(q)
+}
@@ -2,7 +2,9 @@ package reputation
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
+	"io"
 	"math"
 	"sort"
 	"sync"
@@ -14,6 +16,7 @@ import (
 	"github.com/textileio/powergate/v2/index/ask"
 	"github.com/textileio/powergate/v2/index/faults"
 	"github.com/textileio/powergate/v2/index/miner"
+	"github.com/textileio/powergate/v2/manifest"
 	"github.com/textileio/powergate/v2/reputation/internal/source"
 )
 
@@ -39,7 +42,12 @@ type Module struct {
 
 	lockScores sync.Mutex
 	rebuild    chan struct{}
+	// scores is the published score table read by queries, and
+	// backScores is the buffer where the next table is built. They're
+	// swapped when a rebuild finishes, so readers never observe a
+	// partially recomputed table.
 	scores     []MinerScore
+	backScores []MinerScore
 
 	ctx      context.Context
 	cancel   context.CancelFunc
@@ -68,7 +76,7 @@ func New(ds datastore.TxnDatastore, mi miner.Module, fi faults.Module, ai ask.Mo
 		rebuild:  make(chan struct{}, 1),
 		ctx:      ctx,
 		cancel:   cancel,
-		sources:  source.NewStore(ds),
+		sources:  source.NewStore(ds, true),
 		finished: make(chan struct{}),
 	}
 
@@ -84,6 +92,50 @@ func (rm *Module) AddSource(id string, maddr ma.Multiaddr) error {
 	return rm.sources.Add(source.Source{ID: id, Maddr: maddr})
 }
 
+// DeleteSource removes an external Source, so it isn't considered in
+// the next reputation generation.
+func (rm *Module) DeleteSource(id string) error {
+	return rm.sources.Delete(id)
+}
+
+// ExportSources writes all Sources in a portable versioned JSON lines
+// format, which can be loaded in another deployment with ImportSources.
+func (rm *Module) ExportSources(w io.Writer) error {
+	return rm.sources.ExportSources(w)
+}
+
+// SourcesExportPart is the name of the exported Sources in the manifest
+// returned by ExportSignedSources.
+const SourcesExportPart = "sources.jsonl"
+
+// ExportSignedSources writes all Sources like ExportSources, and returns
+// a manifest of the export signed with key, so recipients can verify it
+// before importing it.
+func (rm *Module) ExportSignedSources(w io.Writer, key ed25519.PrivateKey) (manifest.Manifest, error) {
+	b := manifest.NewBuilder()
+	mw, err := b.Writer(SourcesExportPart, w)
+	if err != nil {
+		return manifest.Manifest{}, err
+	}
+	if err := rm.sources.ExportSources(mw); err != nil {
+		return manifest.Manifest{}, err
+	}
+	return b.Sign(key)
+}
+
+// ImportSources adds the Sources written by ExportSources, and triggers
+// a rebuild of the scores. No Source is imported if any already exists.
+func (rm *Module) ImportSources(r io.Reader) error {
+	if err := rm.sources.ImportSources(r); err != nil {
+		return err
+	}
+	select {
+	case rm.rebuild <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
 // QueryMiners makes a filtered query on the scored-sorted miner list.
 // Empty filter slices represent no-filters applied.
 func (rm *Module) QueryMiners(excludedMiners []string, countryCodes []string, trustedMiners []string) ([]MinerScore, error) {
@@ -137,6 +189,16 @@ func (rm *Module) QueryMiners(excludedMiners []string, countryCodes []string, tr
 	return mr, nil
 }
 
+// GetScores returns a consistent snapshot of all miner scores sorted by
+// score. The result isn't affected by any recomputation in progress.
+func (rm *Module) GetScores() []MinerScore {
+	rm.lockScores.Lock()
+	defer rm.lockScores.Unlock()
+	ret := make([]MinerScore, len(rm.scores))
+	copy(ret, rm.scores)
+	return ret
+}
+
 // GetTopMiners gets the top n miners with best score.
 func (rm *Module) GetTopMiners(n int) ([]MinerScore, error) {
 	if n < 1 {
@@ -211,7 +273,7 @@ func (rm *Module) indexBuilder() {
 		askIndex := rm.aIndex
 		rm.lockIndex.Unlock()
 
-		scores := make([]MinerScore, 0, len(askIndex.Storage))
+		scores := rm.backScores[:0]
 		for addr := range askIndex.Storage {
 			score := calculateScore(addr, minerIndex, faultsIndex, askIndex, sources)
 			scores = append(scores, score)
@@ -221,7 +283,7 @@ func (rm *Module) indexBuilder() {
 		})
 
 		rm.lockScores.Lock()
-		rm.scores = scores
+		rm.scores, rm.backScores = scores, rm.scores
 		rm.lockScores.Unlock()
 
 		log.Infof("built scores for %d miners in %dms", len(scores), time.Since(start).Milliseconds())
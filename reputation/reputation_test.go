@@ -0,0 +1,184 @@
+package reputation
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/index/ask"
+	"github.com/textileio/powergate/v2/index/faults"
+	"github.com/textileio/powergate/v2/index/miner"
+	"github.com/textileio/powergate/v2/manifest"
+	"github.com/textileio/powergate/v2/reputation/internal/source"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestGetScoresSnapshot(t *testing.T) {
+	t.Parallel()
+
+	idxA := askIndex("a", 200)
+	idxB := askIndex("b", 200)
+	ai := &askModuleMock{idx: idxA, listen: make(chan struct{})}
+	rm := New(tests.NewTxMapDatastore(), &minerModuleMock{}, &faultsModuleMock{}, ai)
+	defer func() { require.NoError(t, rm.Close()) }()
+
+	require.Eventually(t, func() bool { return len(rm.GetScores()) == 200 }, time.Second*5, time.Millisecond*10)
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			if i%2 == 0 {
+				ai.set(idxB)
+			} else {
+				ai.set(idxA)
+			}
+			ai.listen <- struct{}{}
+			time.Sleep(time.Millisecond * 5)
+		}
+		close(stop)
+	}()
+
+	for {
+		select {
+		case <-stop:
+			wg.Wait()
+			return
+		default:
+		}
+		scores := rm.GetScores()
+		require.Len(t, scores, 200)
+		prefix := scores[0].Addr[:1]
+		for _, s := range scores {
+			require.True(t, strings.HasPrefix(s.Addr, prefix), "snapshot mixes scores from different rebuilds")
+		}
+	}
+}
+
+func askIndex(prefix string, n int) ask.Index {
+	idx := ask.Index{
+		StorageMedianPrice: 100,
+		Storage:            make(map[string]ask.StorageAsk, n),
+	}
+	for i := 0; i < n; i++ {
+		addr := fmt.Sprintf("%s%d", prefix, i)
+		idx.Storage[addr] = ask.StorageAsk{Miner: addr, Price: uint64(i)}
+	}
+	return idx
+}
+
+type askModuleMock struct {
+	lock   sync.Mutex
+	idx    ask.Index
+	listen chan struct{}
+}
+
+func (am *askModuleMock) set(idx ask.Index) {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+	am.idx = idx
+}
+
+func (am *askModuleMock) Get() ask.Index {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+	return am.idx
+}
+
+func (am *askModuleMock) Query(q ask.Query) ([]ask.StorageAsk, error) {
+	return nil, nil
+}
+
+func (am *askModuleMock) Listen() <-chan struct{} {
+	return am.listen
+}
+
+func (am *askModuleMock) Unregister(c chan struct{}) {}
+
+type minerModuleMock struct{}
+
+func (mm *minerModuleMock) Get() miner.IndexSnapshot {
+	return miner.IndexSnapshot{}
+}
+
+func (mm *minerModuleMock) Listen() <-chan struct{} {
+	return make(chan struct{})
+}
+
+func (mm *minerModuleMock) Unregister(c chan struct{}) {}
+
+type faultsModuleMock struct{}
+
+func (fm *faultsModuleMock) Get() faults.IndexSnapshot {
+	return faults.IndexSnapshot{}
+}
+
+func (fm *faultsModuleMock) Listen() <-chan struct{} {
+	return make(chan struct{})
+}
+
+func (fm *faultsModuleMock) Unregister(c chan struct{}) {}
+
+func TestImportFeed(t *testing.T) {
+	t.Parallel()
+
+	rm := New(tests.NewTxMapDatastore(), &minerModuleMock{}, &faultsModuleMock{}, &askModuleMock{listen: make(chan struct{})})
+	defer func() { require.NoError(t, rm.Close()) }()
+
+	feed := `[{"miner": "f01", "score": 2}, {"miner": "f02", "score": 4.5}, {"miner": "f03", "score": 7}]`
+	err := rm.ImportFeed("ext", 0.5, strings.NewReader(feed), JSONFeed{})
+	require.NoError(t, err)
+
+	ss, err := rm.sources.GetAll()
+	require.NoError(t, err)
+	require.Len(t, ss, 1)
+	require.Equal(t, "ext", ss[0].ID)
+	require.Equal(t, source.TypeFeed, ss[0].Type)
+	require.Equal(t, 0.5, ss[0].Weight)
+	require.NotNil(t, ss[0].LastFetched)
+	require.Equal(t, map[string]int{"f01": 0, "f02": 50, "f03": 100}, ss[0].Scores)
+
+	// Re-importing replaces the previous scores.
+	csvFeed := "miner,score\nf04,10\nf05,20\n"
+	err = rm.ImportFeed("ext", 1, strings.NewReader(csvFeed), CSVFeed{})
+	require.NoError(t, err)
+	ss, err = rm.sources.GetAll()
+	require.NoError(t, err)
+	require.Len(t, ss, 1)
+	require.Equal(t, map[string]int{"f04": 0, "f05": 100}, ss[0].Scores)
+}
+
+func TestExportSignedSources(t *testing.T) {
+	t.Parallel()
+
+	rm := New(tests.NewTxMapDatastore(), &minerModuleMock{}, &faultsModuleMock{}, &askModuleMock{listen: make(chan struct{})})
+	defer func() { require.NoError(t, rm.Close()) }()
+	feed := `[{"miner": "f01", "score": 2}, {"miner": "f02", "score": 4.5}]`
+	require.NoError(t, rm.ImportFeed("ext", 0.5, strings.NewReader(feed), JSONFeed{}))
+
+	key, err := manifest.GenerateKey()
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	m, err := rm.ExportSignedSources(&buf, key)
+	require.NoError(t, err)
+
+	pub := key.Public().(ed25519.PublicKey)
+	open := func(data []byte) func(string) (io.ReadCloser, error) {
+		return func(name string) (io.ReadCloser, error) {
+			require.Equal(t, SourcesExportPart, name)
+			return ioutil.NopCloser(bytes.NewReader(data)), nil
+		}
+	}
+	require.NoError(t, m.Verify(pub, open(buf.Bytes())))
+	tampered := bytes.Replace(buf.Bytes(), []byte(`"ext"`), []byte(`"oth"`), 1)
+	require.ErrorIs(t, m.Verify(pub, open(tampered)), manifest.ErrPartMismatch)
+}
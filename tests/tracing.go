@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"sync"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	spanRecorderOnce sync.Once
+	spanRecorder     *oteltest.SpanRecorder
+)
+
+// RecordSpans sets a global tracer provider which records the spans of
+// the test binary, and returns its recorder. Since tests may run in
+// parallel, spans should be filtered by trace with TraceSpans.
+func RecordSpans() *oteltest.SpanRecorder {
+	spanRecorderOnce.Do(func() {
+		spanRecorder = new(oteltest.SpanRecorder)
+		otel.SetTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(spanRecorder)))
+	})
+	return spanRecorder
+}
+
+// TraceSpans returns the completed spans of a trace.
+func TraceSpans(sr *oteltest.SpanRecorder, traceID trace.TraceID) []*oteltest.Span {
+	var res []*oteltest.Span
+	for _, s := range sr.Completed() {
+		if s.SpanContext().TraceID() == traceID {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// RequireSpan asserts a span with the provided name is a child of
+// parent, and returns it.
+func RequireSpan(t require.TestingT, spans []*oteltest.Span, name string, parent trace.SpanID) *oteltest.Span {
+	for _, s := range spans {
+		if s.Name() == name && s.ParentSpanID() == parent {
+			return s
+		}
+	}
+	require.FailNow(t, "span not found", "no span %q with parent %s", name, parent)
+	return nil
+}
@@ -1,6 +1,8 @@
 package tests
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -8,10 +10,26 @@ import (
 	"github.com/ipfs/go-datastore/query"
 )
 
-// TxMapDatastore is a in-memory datastore that satisfies TxnDatastore.
+var (
+	// ErrTransactionDiscarded is returned when committing a discarded transaction.
+	ErrTransactionDiscarded = errors.New("transaction was discarded")
+	// ErrConflict is returned when committing a transaction which read
+	// keys changed by other transactions after the reads.
+	ErrConflict = errors.New("transaction conflicts with a concurrent change")
+)
+
+var (
+	_ datastore.Datastore    = (*TxMapDatastore)(nil)
+	_ datastore.TxnDatastore = (*TxMapDatastore)(nil)
+	_ datastore.Batching     = (*TxMapDatastore)(nil)
+)
+
+// TxMapDatastore is a in-memory datastore that satisfies TxnDatastore
+// and Batching.
 type TxMapDatastore struct {
 	*datastore.MapDatastore
-	lock sync.RWMutex
+	lock       sync.RWMutex
+	commitLock sync.Mutex
 }
 
 // NewTxMapDatastore returns a new TxMapDatastore.
@@ -49,6 +67,14 @@ func (d *TxMapDatastore) Query(q query.Query) (query.Results, error) {
 	return d.MapDatastore.Query(q)
 }
 
+// Sync guarantees writes under prefix are durable. The datastore is
+// in-memory, so it only waits for in-flight writes to finish.
+func (d *TxMapDatastore) Sync(prefix datastore.Key) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return nil
+}
+
 // Clone returns a cloned datastore.
 func (d *TxMapDatastore) Clone() (*TxMapDatastore, error) {
 	d.lock.Lock()
@@ -75,12 +101,66 @@ func (d *TxMapDatastore) Clone() (*TxMapDatastore, error) {
 	return t2, nil
 }
 
+// Batch returns a Batch which buffers writes and applies them under
+// a single write lock on Commit.
+func (d *TxMapDatastore) Batch() (datastore.Batch, error) {
+	return &txMapBatch{
+		d:   d,
+		ops: make(map[datastore.Key]op),
+	}, nil
+}
+
+// txMapBatch is a Batch of a TxMapDatastore.
+type txMapBatch struct {
+	d    *TxMapDatastore
+	lock sync.Mutex
+	ops  map[datastore.Key]op
+}
+
+// Put buffers setting the value of a key.
+func (b *txMapBatch) Put(key datastore.Key, val []byte) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.ops[key] = op{value: val}
+	return nil
+}
+
+// Delete buffers deleting a key.
+func (b *txMapBatch) Delete(key datastore.Key) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.ops[key] = op{delete: true}
+	return nil
+}
+
+// Commit applies the buffered writes. Readers of the datastore see
+// either none or all of them.
+func (b *txMapBatch) Commit() error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.d.lock.Lock()
+	defer b.d.lock.Unlock()
+	for k, op := range b.ops {
+		var err error
+		if op.delete {
+			err = b.d.MapDatastore.Delete(k)
+		} else {
+			err = b.d.MapDatastore.Put(k, op.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	b.ops = make(map[datastore.Key]op)
+	return nil
+}
+
 // NewTransaction creates a transaction A read-only transaction should be
 // indicated with readOnly equal true.
 func (d *TxMapDatastore) NewTransaction(readOnly bool) (datastore.Txn, error) {
 	d.lock.RLock()
 	defer d.lock.RUnlock()
-	return NewSimpleTx(d), nil
+	return newSimpleTx(d, &d.commitLock), nil
 }
 
 type op struct {
@@ -88,48 +168,169 @@ type op struct {
 	value  []byte
 }
 
+// read is the value of a key in the target when it was first read.
+type read struct {
+	exists bool
+	value  []byte
+}
+
 // SimpleTx implements the transaction interface for datastores who do
-// not have any sort of underlying transactional support.
+// not have any sort of underlying transactional support. Keys read with
+// Get, Has, or GetSize are checked on Commit, which fails with ErrConflict
+// if any of them changed in the target since read.
 type SimpleTx struct {
-	ops    map[datastore.Key]op
-	lock   sync.RWMutex
-	target datastore.Datastore
+	ops        map[datastore.Key]op
+	reads      map[datastore.Key]read
+	discarded  bool
+	lock       sync.RWMutex
+	commitLock *sync.Mutex
+	target     datastore.Datastore
 }
 
-// NewSimpleTx creates a transaction.
+// NewSimpleTx creates a transaction. Conflict checks are atomic with
+// the commit only between transactions created by the same
+// TxMapDatastore, so concurrent commits of transactions created
+// directly on ds may still miss conflicts.
 func NewSimpleTx(ds datastore.Datastore) datastore.Txn {
+	return newSimpleTx(ds, &sync.Mutex{})
+}
+
+func newSimpleTx(ds datastore.Datastore, commitLock *sync.Mutex) *SimpleTx {
 	return &SimpleTx{
-		ops:    make(map[datastore.Key]op),
-		target: ds,
+		ops:        make(map[datastore.Key]op),
+		reads:      make(map[datastore.Key]read),
+		commitLock: commitLock,
+		target:     ds,
 	}
 }
 
-// Query executes a query within the transaction scope.
+// Query executes a query within the transaction scope. Values written
+// in the transaction are visible before committing it.
 func (bt *SimpleTx) Query(q query.Query) (query.Results, error) {
 	bt.lock.RLock()
 	defer bt.lock.RUnlock()
-	return bt.target.Query(q)
+	if len(bt.ops) == 0 {
+		return bt.target.Query(q)
+	}
+
+	// Merge buffered ops over every entry under the prefix, and apply
+	// the rest of the query to the merged entries.
+	res, err := bt.target.Query(query.Query{Prefix: q.Prefix})
+	if err != nil {
+		return nil, err
+	}
+	targetEntries, err := res.Rest()
+	if err != nil {
+		return nil, err
+	}
+	merged := make(map[string]query.Entry, len(targetEntries)+len(bt.ops))
+	for _, e := range targetEntries {
+		merged[e.Key] = e
+	}
+	for k, op := range bt.ops {
+		if op.delete {
+			delete(merged, k.String())
+			continue
+		}
+		merged[k.String()] = query.Entry{Key: k.String(), Value: op.value, Size: len(op.value)}
+	}
+	entries := make([]query.Entry, 0, len(merged))
+	for _, e := range merged {
+		entries = append(entries, e)
+	}
+	entries, err = query.NaiveQueryApply(q, query.ResultsWithEntries(q, entries)).Rest()
+	if err != nil {
+		return nil, err
+	}
+	if q.KeysOnly {
+		for i := range entries {
+			entries[i].Value = nil
+		}
+	}
+	return query.ResultsWithEntries(q, entries), nil
 }
 
-// Get returns a key value within the transaction.
+// Get returns a key value within the transaction. Values written
+// in the transaction are visible before committing it.
 func (bt *SimpleTx) Get(k datastore.Key) ([]byte, error) {
-	bt.lock.RLock()
-	defer bt.lock.RUnlock()
-	return bt.target.Get(k)
+	bt.lock.Lock()
+	defer bt.lock.Unlock()
+	if op, ok := bt.ops[k]; ok {
+		if op.delete {
+			return nil, datastore.ErrNotFound
+		}
+		return op.value, nil
+	}
+	r, err := bt.read(k)
+	if err != nil {
+		return nil, err
+	}
+	if !r.exists {
+		return nil, datastore.ErrNotFound
+	}
+	return r.value, nil
 }
 
-// Has returns true if the key exist, false otherwise.
+// Has returns true if the key exist, false otherwise. Values written
+// in the transaction are visible before committing it.
 func (bt *SimpleTx) Has(k datastore.Key) (bool, error) {
-	bt.lock.RLock()
-	defer bt.lock.RUnlock()
-	return bt.target.Has(k)
+	bt.lock.Lock()
+	defer bt.lock.Unlock()
+	if op, ok := bt.ops[k]; ok {
+		return !op.delete, nil
+	}
+	r, err := bt.read(k)
+	if err != nil {
+		return false, err
+	}
+	return r.exists, nil
 }
 
-// GetSize returns the size of the key value.
+// GetSize returns the size of the key value. Values written in the
+// transaction are visible before committing it.
 func (bt *SimpleTx) GetSize(k datastore.Key) (int, error) {
-	bt.lock.RLock()
-	defer bt.lock.RUnlock()
-	return bt.target.GetSize(k)
+	bt.lock.Lock()
+	defer bt.lock.Unlock()
+	if op, ok := bt.ops[k]; ok {
+		if op.delete {
+			return -1, datastore.ErrNotFound
+		}
+		return len(op.value), nil
+	}
+	r, err := bt.read(k)
+	if err != nil {
+		return -1, err
+	}
+	if !r.exists {
+		return -1, datastore.ErrNotFound
+	}
+	return len(r.value), nil
+}
+
+// read returns the value of a key in the target, and records it in the
+// read-set. Keys are read from the target only once, so the transaction
+// keeps seeing the values it'll check on Commit.
+func (bt *SimpleTx) read(k datastore.Key) (read, error) {
+	if r, ok := bt.reads[k]; ok {
+		return r, nil
+	}
+	r, err := readTarget(bt.target, k)
+	if err != nil {
+		return read{}, err
+	}
+	bt.reads[k] = r
+	return r, nil
+}
+
+func readTarget(ds datastore.Datastore, k datastore.Key) (read, error) {
+	v, err := ds.Get(k)
+	if err == datastore.ErrNotFound {
+		return read{}, nil
+	}
+	if err != nil {
+		return read{}, err
+	}
+	return read{exists: true, value: v}, nil
 }
 
 // Put sets the value for a key.
@@ -148,27 +349,80 @@ func (bt *SimpleTx) Delete(key datastore.Key) error {
 	return nil
 }
 
-// Discard cancels the changes done in the transaction.
+// Discard cancels the changes done in the transaction. Committing
+// it afterwards fails with ErrTransactionDiscarded.
 func (bt *SimpleTx) Discard() {
 	bt.lock.Lock()
 	defer bt.lock.Unlock()
+	bt.ops = make(map[datastore.Key]op)
+	bt.reads = make(map[datastore.Key]read)
+	bt.discarded = true
 }
 
-// Commit confirms changes done in the transaction.
+// Commit confirms changes done in the transaction. If any key read in
+// the transaction changed in the target since read, nothing is written
+// and ErrConflict is returned. If writing to the target fails, the
+// writes already done are rolled back.
 func (bt *SimpleTx) Commit() error {
 	bt.lock.Lock()
 	defer bt.lock.Unlock()
-	var err error
+	if bt.discarded {
+		return ErrTransactionDiscarded
+	}
+	bt.commitLock.Lock()
+	defer bt.commitLock.Unlock()
+	for k, r := range bt.reads {
+		curr, err := readTarget(bt.target, k)
+		if err != nil {
+			return fmt.Errorf("checking read of %s: %s", k, err)
+		}
+		if curr.exists != r.exists || !bytes.Equal(curr.value, r.value) {
+			return fmt.Errorf("%w: %s changed", ErrConflict, k)
+		}
+	}
+	// Snapshot the keys to be written, so a failed commit can restore
+	// the writes done before failing.
+	snapshot := make(map[datastore.Key]read, len(bt.ops))
+	for k := range bt.ops {
+		r, err := readTarget(bt.target, k)
+		if err != nil {
+			return fmt.Errorf("snapshotting %s: %s", k, err)
+		}
+		snapshot[k] = r
+	}
+	var applied []datastore.Key
 	for k, op := range bt.ops {
+		var err error
 		if op.delete {
 			err = bt.target.Delete(k)
 		} else {
 			err = bt.target.Put(k, op.value)
 		}
 		if err != nil {
-			break
+			if rerr := bt.rollback(applied, snapshot); rerr != nil {
+				return fmt.Errorf("applying %s: %s, rolling back: %s", k, err, rerr)
+			}
+			return err
 		}
+		applied = append(applied, k)
 	}
+	return nil
+}
 
-	return err
+// rollback restores the snapshotted values of the applied keys.
+func (bt *SimpleTx) rollback(applied []datastore.Key, snapshot map[datastore.Key]read) error {
+	for i := len(applied) - 1; i >= 0; i-- {
+		k := applied[i]
+		r := snapshot[k]
+		var err error
+		if r.exists {
+			err = bt.target.Put(k, r.value)
+		} else {
+			err = bt.target.Delete(k)
+		}
+		if err != nil {
+			return fmt.Errorf("restoring %s: %s", k, err)
+		}
+	}
+	return nil
 }
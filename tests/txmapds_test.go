@@ -0,0 +1,275 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimpleTxReadYourWrites(t *testing.T) {
+	t.Parallel()
+	ds := NewTxMapDatastore()
+	k1 := datastore.NewKey("k1")
+	k2 := datastore.NewKey("k2")
+	require.NoError(t, ds.Put(k1, []byte("old")))
+	require.NoError(t, ds.Put(k2, []byte("v2")))
+
+	txn, err := ds.NewTransaction(false)
+	require.NoError(t, err)
+	defer txn.Discard()
+
+	require.NoError(t, txn.Put(k1, []byte("new!")))
+	v, err := txn.Get(k1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("new!"), v)
+	size, err := txn.GetSize(k1)
+	require.NoError(t, err)
+	require.Equal(t, 4, size)
+
+	require.NoError(t, txn.Delete(k2))
+	_, err = txn.Get(k2)
+	require.Equal(t, datastore.ErrNotFound, err)
+	ok, err := txn.Has(k2)
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, err = txn.GetSize(k2)
+	require.Equal(t, datastore.ErrNotFound, err)
+
+	// Nothing is visible outside the transaction until committed.
+	v, err = ds.Get(k1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("old"), v)
+	ok, err = ds.Has(k2)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, txn.Commit())
+	v, err = ds.Get(k1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("new!"), v)
+	ok, err = ds.Has(k2)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestSimpleTxQuery(t *testing.T) {
+	t.Parallel()
+	ds := NewTxMapDatastore()
+	for _, k := range []string{"/src/a", "/src/b", "/src/c", "/other/a"} {
+		require.NoError(t, ds.Put(datastore.NewKey(k), []byte(k)))
+	}
+
+	txn, err := ds.NewTransaction(false)
+	require.NoError(t, err)
+	defer txn.Discard()
+	require.NoError(t, txn.Put(datastore.NewKey("/src/d"), []byte("new")))
+	require.NoError(t, txn.Put(datastore.NewKey("/src/a"), []byte("updated")))
+	require.NoError(t, txn.Delete(datastore.NewKey("/src/b")))
+	require.NoError(t, txn.Put(datastore.NewKey("/other/b"), []byte("other")))
+
+	all := queryAll(t, txn, query.Query{Prefix: "/src", Orders: []query.Order{query.OrderByKey{}}})
+	require.Equal(t, []query.Entry{
+		{Key: "/src/a", Value: []byte("updated"), Size: 7},
+		{Key: "/src/c", Value: []byte("/src/c"), Size: 6},
+		{Key: "/src/d", Value: []byte("new"), Size: 3},
+	}, all)
+
+	// Ordering and limit apply after merging.
+	page := queryAll(t, txn, query.Query{Prefix: "/src", Orders: []query.Order{query.OrderByKeyDescending{}}, Limit: 2, KeysOnly: true})
+	require.Len(t, page, 2)
+	require.Equal(t, "/src/d", page[0].Key)
+	require.Equal(t, "/src/c", page[1].Key)
+	require.Nil(t, page[0].Value)
+
+	// Filters apply to buffered values too.
+	filtered := queryAll(t, txn, query.Query{Filters: []query.Filter{query.FilterValueCompare{Op: query.Equal, Value: []byte("other")}}})
+	require.Len(t, filtered, 1)
+	require.Equal(t, "/other/b", filtered[0].Key)
+
+	// The target isn't modified until committing.
+	require.Len(t, queryAll(t, ds, query.Query{Prefix: "/src"}), 3)
+	require.NoError(t, txn.Commit())
+	require.Len(t, queryAll(t, ds, query.Query{Prefix: "/src"}), 3)
+	ok, err := ds.Has(datastore.NewKey("/src/d"))
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func queryAll(t *testing.T, r datastore.Read, q query.Query) []query.Entry {
+	t.Helper()
+	res, err := r.Query(q)
+	require.NoError(t, err)
+	entries, err := res.Rest()
+	require.NoError(t, err)
+	return entries
+}
+
+func TestSimpleTxDiscard(t *testing.T) {
+	t.Parallel()
+	ds := NewTxMapDatastore()
+	k := datastore.NewKey("k")
+
+	txn, err := ds.NewTransaction(false)
+	require.NoError(t, err)
+	require.NoError(t, txn.Put(k, []byte("v")))
+	txn.Discard()
+
+	// Discarded ops aren't visible in the transaction, and
+	// never reach the target datastore.
+	_, err = txn.Get(k)
+	require.Equal(t, datastore.ErrNotFound, err)
+	require.Equal(t, ErrTransactionDiscarded, txn.Commit())
+	ok, err := ds.Has(k)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Discarding after committing is harmless.
+	txn, err = ds.NewTransaction(false)
+	require.NoError(t, err)
+	require.NoError(t, txn.Put(k, []byte("v")))
+	require.NoError(t, txn.Commit())
+	txn.Discard()
+	ok, err = ds.Has(k)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestSimpleTxConflict(t *testing.T) {
+	t.Parallel()
+	ds := NewTxMapDatastore()
+	k := datastore.NewKey("k")
+	require.NoError(t, ds.Put(k, []byte("v0")))
+
+	// Both transactions read the key before any of them commits.
+	txn1, err := ds.NewTransaction(false)
+	require.NoError(t, err)
+	txn2, err := ds.NewTransaction(false)
+	require.NoError(t, err)
+	_, err = txn1.Get(k)
+	require.NoError(t, err)
+	_, err = txn2.Get(k)
+	require.NoError(t, err)
+
+	require.NoError(t, txn1.Put(k, []byte("v1")))
+	require.NoError(t, txn2.Put(k, []byte("v2")))
+	require.NoError(t, txn1.Commit())
+	require.True(t, errors.Is(txn2.Commit(), ErrConflict))
+
+	// The conflicting transaction didn't write anything.
+	v, err := ds.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), v)
+
+	// Keys created after a Has miss are also conflicts.
+	k2 := datastore.NewKey("k2")
+	txn3, err := ds.NewTransaction(false)
+	require.NoError(t, err)
+	ok, err := txn3.Has(k2)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.NoError(t, ds.Put(k2, []byte("v")))
+	require.NoError(t, txn3.Put(k2, []byte("v3")))
+	require.True(t, errors.Is(txn3.Commit(), ErrConflict))
+
+	// Writes to keys which weren't read never conflict.
+	txn4, err := ds.NewTransaction(false)
+	require.NoError(t, err)
+	require.NoError(t, ds.Put(k, []byte("v4")))
+	require.NoError(t, txn4.Put(k, []byte("v5")))
+	require.NoError(t, txn4.Commit())
+}
+
+func TestSimpleTxCommitRollback(t *testing.T) {
+	t.Parallel()
+	ds := &failingDeleteDatastore{
+		MapDatastore: datastore.NewMapDatastore(),
+		failKey:      datastore.NewKey("bad"),
+	}
+	require.NoError(t, ds.Put(datastore.NewKey("existing"), []byte("v0")))
+	require.NoError(t, ds.Put(datastore.NewKey("removed"), []byte("v0")))
+	require.NoError(t, ds.Put(ds.failKey, []byte("v0")))
+
+	txn := NewSimpleTx(ds)
+	require.NoError(t, txn.Put(datastore.NewKey("new1"), []byte("v1")))
+	require.NoError(t, txn.Put(datastore.NewKey("existing"), []byte("v1")))
+	require.NoError(t, txn.Delete(datastore.NewKey("removed")))
+	require.NoError(t, txn.Delete(ds.failKey))
+	require.NoError(t, txn.Put(datastore.NewKey("new2"), []byte("v1")))
+	require.Error(t, txn.Commit())
+
+	// None of the ops took effect.
+	entries := queryAll(t, ds, query.Query{})
+	require.Len(t, entries, 3)
+	for _, e := range entries {
+		require.Equal(t, []byte("v0"), e.Value)
+	}
+}
+
+type failingDeleteDatastore struct {
+	*datastore.MapDatastore
+	failKey datastore.Key
+}
+
+func (d *failingDeleteDatastore) Delete(k datastore.Key) error {
+	if k == d.failKey {
+		return errors.New("delete failed")
+	}
+	return d.MapDatastore.Delete(k)
+}
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+	ds := NewTxMapDatastore()
+	require.NoError(t, ds.Put(datastore.NewKey("removed"), []byte("v")))
+
+	b, err := ds.Batch()
+	require.NoError(t, err)
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, b.Put(datastore.NewKey(fmt.Sprintf("k%d", i)), []byte(fmt.Sprintf("v%d", i))))
+	}
+	require.NoError(t, b.Delete(datastore.NewKey("removed")))
+
+	// Nothing is visible before committing.
+	require.Len(t, queryAll(t, ds, query.Query{}), 1)
+
+	// Concurrent readers see either none or all the writes.
+	seen := make(chan int, 100)
+	go func() {
+		defer close(seen)
+		for i := 0; i < 100; i++ {
+			res, err := ds.Query(query.Query{KeysOnly: true})
+			if err != nil {
+				return
+			}
+			entries, _ := res.Rest()
+			seen <- len(entries)
+		}
+	}()
+	require.NoError(t, b.Commit())
+	for n := range seen {
+		require.Contains(t, []int{1, 1000}, n)
+	}
+
+	entries := queryAll(t, ds, query.Query{})
+	require.Len(t, entries, 1000)
+	v, err := ds.Get(datastore.NewKey("k999"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("v999"), v)
+}
+
+func TestSync(t *testing.T) {
+	t.Parallel()
+	ds := NewTxMapDatastore()
+	k := datastore.NewKey("/a/k")
+	require.NoError(t, ds.Put(k, []byte("v")))
+	require.NoError(t, ds.Sync(datastore.NewKey("/a")))
+	require.NoError(t, ds.Sync(datastore.NewKey("/")))
+
+	v, err := ds.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v"), v)
+}
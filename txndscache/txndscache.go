@@ -0,0 +1,156 @@
+package txndscache
+
+import (
+	"fmt"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+)
+
+// Datastore is a TxnDatastore which keeps an LRU cache of read values.
+// Writes go through to the child datastore and invalidate the cached
+// values. Writes made in transactions only invalidate the cache when
+// the transaction is committed.
+type Datastore struct {
+	child ds.TxnDatastore
+	cache *lru.Cache
+
+	// lock serializes writes with cache fills, so a value read before a
+	// write isn't cached after the write invalidated it.
+	lock sync.RWMutex
+}
+
+var _ ds.TxnDatastore = (*Datastore)(nil)
+
+// Wrap wraps a TxnDatastore with a cache of at most size values.
+func Wrap(child ds.TxnDatastore, size int) (*Datastore, error) {
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, fmt.Errorf("creating lru cache: %s", err)
+	}
+	return &Datastore{
+		child: child,
+		cache: cache,
+	}, nil
+}
+
+// Get returns the value for a key, from the cache if present.
+func (d *Datastore) Get(key ds.Key) ([]byte, error) {
+	if v, ok := d.cache.Get(key); ok {
+		return copyBytes(v.([]byte)), nil
+	}
+
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	v, err := d.child.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	d.cache.Add(key, copyBytes(v))
+	return v, nil
+}
+
+// Has returns whether the datastore has a value for a key.
+func (d *Datastore) Has(key ds.Key) (bool, error) {
+	if d.cache.Contains(key) {
+		return true, nil
+	}
+	return d.child.Has(key)
+}
+
+// GetSize returns the size of the value for a key.
+func (d *Datastore) GetSize(key ds.Key) (int, error) {
+	if v, ok := d.cache.Peek(key); ok {
+		return len(v.([]byte)), nil
+	}
+	return d.child.GetSize(key)
+}
+
+// Query queries the child datastore. Results aren't cached.
+func (d *Datastore) Query(q dsq.Query) (dsq.Results, error) {
+	return d.child.Query(q)
+}
+
+// Put stores a value in the child datastore and invalidates the cached one.
+func (d *Datastore) Put(key ds.Key, value []byte) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.cache.Remove(key)
+	return d.child.Put(key, value)
+}
+
+// Delete removes a value from the child datastore and the cache.
+func (d *Datastore) Delete(key ds.Key) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.cache.Remove(key)
+	return d.child.Delete(key)
+}
+
+// Sync syncs the child datastore.
+func (d *Datastore) Sync(prefix ds.Key) error {
+	return d.child.Sync(prefix)
+}
+
+// Close closes the child datastore.
+func (d *Datastore) Close() error {
+	d.cache.Purge()
+	return d.child.Close()
+}
+
+// NewTransaction returns a transaction of the child datastore which
+// invalidates the cached values of written keys when committed. Reads
+// in the transaction aren't served from the cache, so they see the
+// transaction writes.
+func (d *Datastore) NewTransaction(readOnly bool) (ds.Txn, error) {
+	t, err := d.child.NewTransaction(readOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &txn{Txn: t, ds: d, written: make(map[ds.Key]struct{})}, nil
+}
+
+type txn struct {
+	ds.Txn
+	ds *Datastore
+
+	lock    sync.Mutex
+	written map[ds.Key]struct{}
+}
+
+// Put stores a value in the transaction.
+func (t *txn) Put(key ds.Key, value []byte) error {
+	t.lock.Lock()
+	t.written[key] = struct{}{}
+	t.lock.Unlock()
+	return t.Txn.Put(key, value)
+}
+
+// Delete removes a value in the transaction.
+func (t *txn) Delete(key ds.Key) error {
+	t.lock.Lock()
+	t.written[key] = struct{}{}
+	t.lock.Unlock()
+	return t.Txn.Delete(key)
+}
+
+// Commit commits the transaction and invalidates the cached values of
+// every written key.
+func (t *txn) Commit() error {
+	t.ds.lock.Lock()
+	defer t.ds.lock.Unlock()
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for k := range t.written {
+		t.ds.cache.Remove(k)
+	}
+	return t.Txn.Commit()
+}
+
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
@@ -0,0 +1,126 @@
+package txndscache
+
+import (
+	"sync"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestReadCaching(t *testing.T) {
+	t.Parallel()
+
+	d, child := newCachedDatastore(t, 2)
+	k := ds.NewKey("k")
+	require.NoError(t, d.Put(k, []byte("v")))
+
+	for i := 0; i < 3; i++ {
+		v, err := d.Get(k)
+		require.NoError(t, err)
+		require.Equal(t, []byte("v"), v)
+	}
+	require.Equal(t, 1, child.gets())
+
+	// Misses aren't cached.
+	for i := 0; i < 2; i++ {
+		_, err := d.Get(ds.NewKey("missing"))
+		require.Equal(t, ds.ErrNotFound, err)
+	}
+	require.Equal(t, 3, child.gets())
+
+	// The least recently used value is evicted.
+	for _, key := range []string{"a", "b"} {
+		require.NoError(t, d.Put(ds.NewKey(key), []byte(key)))
+		_, err := d.Get(ds.NewKey(key))
+		require.NoError(t, err)
+	}
+	_, err := d.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, 6, child.gets())
+}
+
+func TestWriteInvalidation(t *testing.T) {
+	t.Parallel()
+
+	d, child := newCachedDatastore(t, 10)
+	k := ds.NewKey("k")
+	require.NoError(t, d.Put(k, []byte("v1")))
+	_, err := d.Get(k)
+	require.NoError(t, err)
+
+	require.NoError(t, d.Put(k, []byte("v2")))
+	v, err := d.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v2"), v)
+	require.Equal(t, 2, child.gets())
+
+	require.NoError(t, d.Delete(k))
+	_, err = d.Get(k)
+	require.Equal(t, ds.ErrNotFound, err)
+	ok, err := d.Has(k)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestTransactionWrites(t *testing.T) {
+	t.Parallel()
+
+	d, _ := newCachedDatastore(t, 10)
+	k := ds.NewKey("k")
+	require.NoError(t, d.Put(k, []byte("v1")))
+	_, err := d.Get(k)
+	require.NoError(t, err)
+
+	// Discarded writes don't change the cached value.
+	txn, err := d.NewTransaction(false)
+	require.NoError(t, err)
+	require.NoError(t, txn.Put(k, []byte("v2")))
+	v, err := d.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), v)
+	txn.Discard()
+	v, err = d.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), v)
+
+	// Committed writes invalidate the cached value.
+	txn, err = d.NewTransaction(false)
+	require.NoError(t, err)
+	require.NoError(t, txn.Put(k, []byte("v3")))
+	v, err = d.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), v)
+	require.NoError(t, txn.Commit())
+	v, err = d.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v3"), v)
+}
+
+func newCachedDatastore(t *testing.T, size int) (*Datastore, *countingDatastore) {
+	child := &countingDatastore{TxMapDatastore: tests.NewTxMapDatastore()}
+	d, err := Wrap(child, size)
+	require.NoError(t, err)
+	return d, child
+}
+
+type countingDatastore struct {
+	*tests.TxMapDatastore
+
+	lock  sync.Mutex
+	count int
+}
+
+func (cd *countingDatastore) Get(key ds.Key) ([]byte, error) {
+	cd.lock.Lock()
+	cd.count++
+	cd.lock.Unlock()
+	return cd.TxMapDatastore.Get(key)
+}
+
+func (cd *countingDatastore) gets() int {
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+	return cd.count
+}
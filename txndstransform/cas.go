@@ -0,0 +1,83 @@
+package txndstransform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	ds "github.com/ipfs/go-datastore"
+)
+
+// ErrConflict is returned when a conditional write isn't committed
+// because a condition doesn't hold.
+var ErrConflict = errors.New("conditional write conflict")
+
+// Condition requires the current value of Key to be equal to Value. A nil
+// Value requires Key to not exist.
+type Condition struct {
+	Key   ds.Key
+	Value []byte
+}
+
+// CAS performs conditional writes on a TxnDatastore. Conditional writes
+// made through the same CAS are atomic between each other.
+type CAS struct {
+	lock sync.Mutex
+	ds   ds.TxnDatastore
+}
+
+// NewCAS returns a new CAS for the provided datastore.
+func NewCAS(d ds.TxnDatastore) *CAS {
+	return &CAS{ds: d}
+}
+
+// CompareAndSwap sets the value of key to value only if its current value
+// is expected. A nil expected value requires key to not exist.
+func (c *CAS) CompareAndSwap(key ds.Key, expected, value []byte) error {
+	return c.Write([]Condition{{Key: key, Value: expected}}, map[ds.Key][]byte{key: value})
+}
+
+// Write puts all the provided values in a single transaction, only if all
+// conditions hold. If any doesn't, nothing is written and ErrConflict is
+// returned.
+func (c *CAS) Write(conds []Condition, puts map[ds.Key][]byte) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	txn, err := c.ds.NewTransaction(false)
+	if err != nil {
+		return fmt.Errorf("creating transaction: %s", err)
+	}
+	defer txn.Discard()
+
+	for _, cond := range conds {
+		curr, err := txn.Get(cond.Key)
+		if err == ds.ErrNotFound {
+			if cond.Value != nil {
+				return fmt.Errorf("%w: %s doesn't exist", ErrConflict, cond.Key)
+			}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("getting %s: %s", cond.Key, err)
+		}
+		if cond.Value == nil || !bytes.Equal(curr, cond.Value) {
+			return fmt.Errorf("%w: %s changed", ErrConflict, cond.Key)
+		}
+	}
+	for k, v := range puts {
+		if err := txn.Put(k, v); err != nil {
+			return fmt.Errorf("putting %s: %s", k, err)
+		}
+	}
+	if err := txn.Commit(); err != nil {
+		// A write made outside of the CAS can also make the
+		// conditions fail, which the datastore reports on commit.
+		if isConflict(err) {
+			return fmt.Errorf("%w: committing transaction: %s", ErrConflict, err)
+		}
+		return fmt.Errorf("committing transaction: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package txndstransform
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	badger "github.com/dgraph-io/badger/v2"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestCompareAndSwapConcurrent(t *testing.T) {
+	t.Parallel()
+
+	d := tests.NewTxMapDatastore()
+	key := ds.NewKey("counter")
+	require.NoError(t, d.Put(key, []byte("v0")))
+	cas := NewCAS(d)
+
+	const updaters = 50
+	var (
+		wg        sync.WaitGroup
+		lock      sync.Mutex
+		succeeded []string
+		conflicts int
+	)
+	start := make(chan struct{})
+	wg.Add(updaters)
+	for i := 0; i < updaters; i++ {
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			v := fmt.Sprintf("v%d", i+1)
+			err := cas.CompareAndSwap(key, []byte("v0"), []byte(v))
+			lock.Lock()
+			defer lock.Unlock()
+			if errors.Is(err, ErrConflict) {
+				conflicts++
+				return
+			}
+			if err == nil {
+				succeeded = append(succeeded, v)
+			}
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	require.Len(t, succeeded, 1)
+	require.Equal(t, updaters-1, conflicts)
+	v, err := d.Get(key)
+	require.NoError(t, err)
+	require.Equal(t, succeeded[0], string(v))
+}
+
+func TestConditionalWrite(t *testing.T) {
+	t.Parallel()
+
+	d := tests.NewTxMapDatastore()
+	cas := NewCAS(d)
+	a, b := ds.NewKey("a"), ds.NewKey("b")
+
+	// A nil condition value requires the key to not exist.
+	require.NoError(t, cas.CompareAndSwap(a, nil, []byte("1")))
+	require.ErrorIs(t, cas.CompareAndSwap(a, nil, []byte("2")), ErrConflict)
+
+	// Nothing is written if any condition doesn't hold.
+	err := cas.Write(
+		[]Condition{{Key: a, Value: []byte("1")}, {Key: b, Value: []byte("1")}},
+		map[ds.Key][]byte{a: []byte("2"), b: []byte("2")},
+	)
+	require.ErrorIs(t, err, ErrConflict)
+	v, err := d.Get(a)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), v)
+	_, err = d.Get(b)
+	require.Equal(t, ds.ErrNotFound, err)
+
+	err = cas.Write(
+		[]Condition{{Key: a, Value: []byte("1")}, {Key: b}},
+		map[ds.Key][]byte{a: []byte("2"), b: []byte("2")},
+	)
+	require.NoError(t, err)
+	for _, k := range []ds.Key{a, b} {
+		v, err := d.Get(k)
+		require.NoError(t, err)
+		require.Equal(t, []byte("2"), v)
+	}
+}
+
+func TestConditionalWriteCommitConflict(t *testing.T) {
+	t.Parallel()
+
+	d := &failingDatastore{TxnDatastore: tests.NewTxMapDatastore(), commitErr: badger.ErrConflict}
+	cas := NewCAS(d)
+	require.ErrorIs(t, cas.CompareAndSwap(ds.NewKey("a"), nil, []byte("1")), ErrConflict)
+}
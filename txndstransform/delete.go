@@ -0,0 +1,72 @@
+package txndstransform
+
+import (
+	"context"
+	"fmt"
+
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+)
+
+// DeletePrefixBatchSize is the maximum number of keys deleted in a single
+// transaction by DeletePrefix.
+const DeletePrefixBatchSize = 1000
+
+// DeletePrefix deletes every key under prefix, and returns the number of
+// deleted keys. Keys are deleted in transactions of at most
+// DeletePrefixBatchSize keys, so only a batch of keys is kept in memory at
+// a time. If it fails, keys of already committed batches remain deleted.
+func DeletePrefix(ctx context.Context, d ds.TxnDatastore, prefix string) (int, error) {
+	var total int
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		keys, err := keysBatch(d, prefix, DeletePrefixBatchSize)
+		if err != nil {
+			return total, err
+		}
+		if len(keys) == 0 {
+			return total, nil
+		}
+		if err := deleteKeys(d, keys); err != nil {
+			return total, err
+		}
+		total += len(keys)
+	}
+}
+
+func keysBatch(d ds.TxnDatastore, prefix string, limit int) ([]ds.Key, error) {
+	q := dsq.Query{Prefix: prefix, KeysOnly: true, Limit: limit}
+	res, err := d.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("querying keys: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+
+	keys := make([]ds.Key, 0, limit)
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		keys = append(keys, ds.NewKey(r.Key))
+	}
+	return keys, nil
+}
+
+func deleteKeys(d ds.TxnDatastore, keys []ds.Key) error {
+	txn, err := d.NewTransaction(false)
+	if err != nil {
+		return fmt.Errorf("creating transaction: %s", err)
+	}
+	defer txn.Discard()
+	for _, k := range keys {
+		if err := txn.Delete(k); err != nil {
+			return fmt.Errorf("deleting %s: %s", k, err)
+		}
+	}
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package txndstransform
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestDeletePrefix(t *testing.T) {
+	t.Parallel()
+
+	d := tests.NewTxMapDatastore()
+	const n = DeletePrefixBatchSize*2 + 10
+	for i := 0; i < n; i++ {
+		require.NoError(t, d.Put(ds.NewKey(fmt.Sprintf("/instances/a/%d", i)), []byte("v")))
+	}
+	unrelated := []ds.Key{ds.NewKey("/instances/b/1"), ds.NewKey("/instances/ab"), ds.NewKey("/other")}
+	for _, k := range unrelated {
+		require.NoError(t, d.Put(k, []byte("v")))
+	}
+
+	count, err := DeletePrefix(context.Background(), d, "/instances/a")
+	require.NoError(t, err)
+	require.Equal(t, n, count)
+
+	keys, err := keysBatch(d, "/instances/a", n)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	for _, k := range unrelated {
+		ok, err := d.Has(k)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	count, err = DeletePrefix(context.Background(), d, "/instances/a")
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
@@ -0,0 +1,67 @@
+package txndstransform
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	badger "github.com/dgraph-io/badger/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/unit"
+)
+
+var (
+	attrCommitSuccess  = attribute.Key("status").String("success")
+	attrCommitConflict = attribute.Key("status").String("conflict")
+	attrCommitFailed   = attribute.Key("status").String("failed")
+
+	metricsOnce sync.Once
+	metrics     *otelObserver
+)
+
+// commitObserver is notified of every transaction commit.
+type commitObserver interface {
+	observeCommit(namespace string, d time.Duration, err error)
+}
+
+type otelObserver struct {
+	metricCommitDuration metric.Int64ValueRecorder
+	metricCommits        metric.Int64Counter
+}
+
+func defaultObserver() commitObserver {
+	metricsOnce.Do(func() {
+		meter := global.Meter("powergate")
+		metrics = &otelObserver{
+			metricCommitDuration: metric.Must(meter).NewInt64ValueRecorder("powergate.txn.commit.duration", metric.WithDescription("Transaction commit duration"), metric.WithUnit(unit.Milliseconds)),
+			metricCommits:        metric.Must(meter).NewInt64Counter("powergate.txn.commit.total", metric.WithDescription("Transaction commits by status")),
+		}
+	})
+	return metrics
+}
+
+func (o *otelObserver) observeCommit(namespace string, d time.Duration, err error) {
+	attrs := []attribute.KeyValue{attribute.Key("namespace").String(namespace), commitStatus(err)}
+	o.metricCommitDuration.Record(context.Background(), d.Milliseconds(), attrs...)
+	o.metricCommits.Add(context.Background(), 1, attrs...)
+}
+
+func commitStatus(err error) attribute.KeyValue {
+	switch {
+	case err == nil:
+		return attrCommitSuccess
+	case isConflict(err):
+		return attrCommitConflict
+	default:
+		return attrCommitFailed
+	}
+}
+
+// isConflict returns true if err reports that a transaction wasn't
+// committed due to a concurrent write, so the caller may retry it.
+func isConflict(err error) bool {
+	return errors.Is(err, badger.ErrConflict) || errors.Is(err, ErrConflict)
+}
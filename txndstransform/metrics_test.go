@@ -0,0 +1,88 @@
+package txndstransform
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	badger "github.com/dgraph-io/badger/v2"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestCommitMetrics(t *testing.T) {
+	t.Parallel()
+
+	child := &failingDatastore{TxnDatastore: tests.NewTxMapDatastore()}
+	d := Wrap(child, "foo/bar")
+	obs := &fakeObserver{}
+	d.observer = obs
+
+	commit := func() error {
+		txn, err := d.NewTransaction(false)
+		require.NoError(t, err)
+		defer txn.Discard()
+		require.NoError(t, txn.Put(ds.NewKey("k"), []byte("v")))
+		return txn.Commit()
+	}
+
+	require.NoError(t, commit())
+	child.commitErr = badger.ErrConflict
+	require.ErrorIs(t, commit(), badger.ErrConflict)
+	child.commitErr = errors.New("disk full")
+	require.Error(t, commit())
+
+	require.Len(t, obs.commits, 3)
+	for _, c := range obs.commits {
+		require.Equal(t, "/foo/bar", c.namespace)
+		require.GreaterOrEqual(t, c.duration, time.Duration(0))
+	}
+	require.Equal(t, attrCommitSuccess, commitStatus(obs.commits[0].err))
+	require.Equal(t, attrCommitConflict, commitStatus(obs.commits[1].err))
+	require.Equal(t, attrCommitFailed, commitStatus(obs.commits[2].err))
+}
+
+type observedCommit struct {
+	namespace string
+	duration  time.Duration
+	err       error
+}
+
+type fakeObserver struct {
+	lock    sync.Mutex
+	commits []observedCommit
+}
+
+func (fo *fakeObserver) observeCommit(namespace string, d time.Duration, err error) {
+	fo.lock.Lock()
+	defer fo.lock.Unlock()
+	fo.commits = append(fo.commits, observedCommit{namespace: namespace, duration: d, err: err})
+}
+
+// failingDatastore simulates commit failures, such as conflicts.
+type failingDatastore struct {
+	ds.TxnDatastore
+	commitErr error
+}
+
+func (fd *failingDatastore) NewTransaction(readOnly bool) (ds.Txn, error) {
+	txn, err := fd.TxnDatastore.NewTransaction(readOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &failingTxn{Txn: txn, err: fd.commitErr}, nil
+}
+
+type failingTxn struct {
+	ds.Txn
+	err error
+}
+
+func (ft *failingTxn) Commit() error {
+	if ft.err != nil {
+		return ft.err
+	}
+	return ft.Txn.Commit()
+}
@@ -2,6 +2,7 @@ package txndstransform
 
 import (
 	"strings"
+	"time"
 
 	ds "github.com/ipfs/go-datastore"
 	kt "github.com/ipfs/go-datastore/keytransform"
@@ -18,15 +19,21 @@ func Wrap(child ds.TxnDatastore, prefix string) *Datastore {
 	t := kt.PrefixTransform{Prefix: prefixKey}
 	nds := &Datastore{
 		child:        child,
+		namespace:    prefixKey.String(),
+		observer:     defaultObserver(),
 		Datastore:    kt.Wrap(child, t),
 		KeyTransform: t,
 	}
 	return nds
 }
 
-// Datastore keeps a KeyTransform function.
+// Datastore keeps a KeyTransform function. Commits of transactions created
+// by it are recorded in the transaction commit metrics, labeled with the
+// namespace prefix.
 type Datastore struct {
-	child ds.TxnDatastore
+	child     ds.TxnDatastore
+	namespace string
+	observer  commitObserver
 	kt.KeyTransform
 	ds.Datastore
 }
@@ -47,7 +54,10 @@ func (d *Datastore) NewTransaction(readOnly bool) (ds.Txn, error) {
 }
 
 func (t *txn) Commit() error {
-	return t.Txn.Commit()
+	start := time.Now()
+	err := t.Txn.Commit()
+	t.ds.observer.observeCommit(t.ds.namespace, time.Since(start), err)
+	return err
 }
 
 func (t *txn) Discard() {
@@ -0,0 +1,35 @@
+package util
+
+import "time"
+
+// MainnetGenesisTime is the timestamp of the Filecoin mainnet genesis
+// block.
+var MainnetGenesisTime = time.Unix(1598306400, 0).UTC()
+
+// ChainClock converts chain epochs to approximate wall-clock times, and
+// vice versa, considering every epoch lasts the expected block time
+// since genesis. Null rounds don't shift epochs, so conversions are
+// exact up to clock drift of miners.
+type ChainClock struct {
+	Genesis   time.Time
+	BlockTime time.Duration
+}
+
+// MainnetClock is the ChainClock of the Filecoin mainnet.
+var MainnetClock = ChainClock{Genesis: MainnetGenesisTime, BlockTime: AvgBlockTime}
+
+// EpochToTime returns the time in which epoch starts.
+func (cc ChainClock) EpochToTime(epoch int64) time.Time {
+	return cc.Genesis.Add(time.Duration(epoch) * cc.BlockTime)
+}
+
+// TimeToEpoch returns the epoch in progress at t. Times before genesis
+// return negative epochs.
+func (cc ChainClock) TimeToEpoch(t time.Time) int64 {
+	d := t.Sub(cc.Genesis)
+	epoch := int64(d / cc.BlockTime)
+	if d < 0 && d%cc.BlockTime != 0 {
+		epoch--
+	}
+	return epoch
+}
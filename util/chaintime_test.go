@@ -0,0 +1,37 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainClock(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		epoch int64
+		time  string
+	}{
+		{epoch: 0, time: "2020-08-24T22:00:00Z"},
+		{epoch: 1, time: "2020-08-24T22:00:30Z"},
+		{epoch: 2880, time: "2020-08-25T22:00:00Z"},
+		{epoch: 148888, time: "2020-10-15T14:44:00Z"},
+		{epoch: 1000000, time: "2021-08-07T03:20:00Z"},
+		{epoch: -2, time: "2020-08-24T21:59:00Z"},
+	}
+	for _, c := range cases {
+		tm, err := time.Parse(time.RFC3339, c.time)
+		require.NoError(t, err)
+		require.True(t, tm.Equal(MainnetClock.EpochToTime(c.epoch)), "epoch %d", c.epoch)
+		require.Equal(t, c.epoch, MainnetClock.TimeToEpoch(tm))
+		// Times within an epoch belong to it.
+		require.Equal(t, c.epoch, MainnetClock.TimeToEpoch(tm.Add(29*time.Second)))
+		require.Equal(t, c.epoch-1, MainnetClock.TimeToEpoch(tm.Add(-time.Second)))
+	}
+
+	devnet := ChainClock{Genesis: time.Unix(1000, 0), BlockTime: 100 * time.Millisecond}
+	require.True(t, time.Unix(1001, 0).Equal(devnet.EpochToTime(10)))
+	require.Equal(t, int64(10), devnet.TimeToEpoch(time.Unix(1001, 50000000)))
+}
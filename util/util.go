@@ -27,6 +27,16 @@ const (
 	// Original calculation: 180 * EpochsInADay.
 	MinDealDuration = 180 * (24 * 60 * 60 / EpochDurationSeconds)
 
+	// MinDealStartOffset is the default minimum number of epochs between a
+	// proposal and its deal start epoch. It matches the default expected seal
+	// duration of Lotus miners, which reject deals starting sooner than they
+	// can seal them.
+	MinDealStartOffset = 24 * 60 * 60 / EpochDurationSeconds // 24hs
+
+	// MaxDealDuration is the maximum deal duration accepted in the Filecoin network.
+	// Original calculation: 540 * EpochsInADay.
+	MaxDealDuration = 540 * (24 * 60 * 60 / EpochDurationSeconds)
+
 	// CidUndef is a magic value to represent an undefined cid as a string.
 	CidUndef = "CID_UNDEF"
 
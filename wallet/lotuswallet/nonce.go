@@ -0,0 +1,75 @@
+package lotuswallet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// Option configures a Module.
+type Option func(*Module)
+
+// WithNonceManagement enables assigning message nonces in the module
+// instead of the Lotus node. Concurrent sends from the same address get
+// sequential nonces.
+func WithNonceManagement(enabled bool) Option {
+	return func(m *Module) {
+		if enabled {
+			m.nonces = newNonceManager()
+		}
+	}
+}
+
+// nonceManager serializes the pushes of messages from the same address.
+// The nonce of each message is the next one of the Lotus mpool, which
+// considers pending messages, so it stays right even if other messages
+// of the address are pushed outside the manager, and failed pushes don't
+// leave gaps.
+type nonceManager struct {
+	lock  sync.Mutex
+	addrs map[address.Address]*sync.Mutex
+}
+
+func newNonceManager() *nonceManager {
+	return &nonceManager{addrs: make(map[address.Address]*sync.Mutex)}
+}
+
+// push assigns the next nonce of the sender to msg, and signs and pushes
+// it to the Lotus mpool. Pushes from the same address are serialized.
+func (nm *nonceManager) push(ctx context.Context, client *api.FullNodeStruct, msg *types.Message) (*types.SignedMessage, error) {
+	nm.lock.Lock()
+	addrLock, ok := nm.addrs[msg.From]
+	if !ok {
+		addrLock = &sync.Mutex{}
+		nm.addrs[msg.From] = addrLock
+	}
+	nm.lock.Unlock()
+
+	addrLock.Lock()
+	defer addrLock.Unlock()
+	n, err := client.MpoolGetNonce(ctx, msg.From)
+	if err != nil {
+		return nil, fmt.Errorf("getting nonce from mpool: %s", err)
+	}
+	return signAndPush(ctx, client, msg, n)
+}
+
+func signAndPush(ctx context.Context, client *api.FullNodeStruct, msg *types.Message, nonce uint64) (*types.SignedMessage, error) {
+	msg.Nonce = nonce
+	msg, err := client.GasEstimateMessageGas(ctx, msg, nil, types.EmptyTSK)
+	if err != nil {
+		return nil, fmt.Errorf("estimating message gas: %s", err)
+	}
+	smsg, err := client.WalletSignMessage(ctx, msg.From, msg)
+	if err != nil {
+		return nil, fmt.Errorf("signing message: %s", err)
+	}
+	if _, err := client.MpoolPush(ctx, smsg); err != nil {
+		return nil, fmt.Errorf("pushing message with nonce %d: %s", nonce, err)
+	}
+	return smsg, nil
+}
@@ -0,0 +1,140 @@
+package lotuswallet
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceManagementConcurrentSends(t *testing.T) {
+	t.Parallel()
+
+	mp := &mpoolMock{}
+	m := newModuleWithMpool(mp)
+
+	const sends = 50
+	errs := make(chan error, sends)
+	for i := 0; i < sends; i++ {
+		go func() {
+			_, err := m.SendFil(context.Background(), "t01000", "t01001", big.NewInt(1))
+			errs <- err
+		}()
+	}
+	for i := 0; i < sends; i++ {
+		require.NoError(t, <-errs)
+	}
+
+	nonces := mp.nonces()
+	require.Len(t, nonces, sends)
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	for i, n := range nonces {
+		require.Equal(t, uint64(i), n)
+	}
+	require.Equal(t, sends, mp.nonceQueries)
+}
+
+func TestNonceManagementGapRecovery(t *testing.T) {
+	t.Parallel()
+
+	mp := &mpoolMock{failNonce: 2}
+	m := newModuleWithMpool(mp)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		_, err := m.SendFil(ctx, "t01000", "t01001", big.NewInt(1))
+		if i == 2 {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+	}
+
+	// The failed nonce is reused, so there're no gaps.
+	require.Equal(t, []uint64{0, 1, 2, 3}, mp.nonces())
+}
+
+func TestNonceManagementExternalMessages(t *testing.T) {
+	t.Parallel()
+
+	mp := &mpoolMock{}
+	m := newModuleWithMpool(mp)
+	ctx := context.Background()
+
+	_, err := m.SendFil(ctx, "t01000", "t01001", big.NewInt(1))
+	require.NoError(t, err)
+	// A message of the address is pushed by the Lotus node, e.g. to
+	// reserve market funds for a deal.
+	_, err = mp.push(ctx, &types.SignedMessage{Message: types.Message{Nonce: 1}})
+	require.NoError(t, err)
+
+	// The next nonce comes from the mpool, so it doesn't collide.
+	_, err = m.SendFil(ctx, "t01000", "t01001", big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 2}, mp.nonces())
+}
+
+func newModuleWithMpool(mp *mpoolMock) *Module {
+	var client api.FullNodeStruct
+	client.Internal.MpoolGetNonce = mp.getNonce
+	client.Internal.GasEstimateMessageGas = func(_ context.Context, msg *types.Message, _ *api.MessageSendSpec, _ types.TipSetKey) (*types.Message, error) {
+		return msg, nil
+	}
+	client.Internal.WalletSignMessage = func(_ context.Context, _ address.Address, msg *types.Message) (*types.SignedMessage, error) {
+		return &types.SignedMessage{Message: *msg}, nil
+	}
+	client.Internal.MpoolPush = mp.push
+	cb := func(context.Context) (*api.FullNodeStruct, func(), error) {
+		return &client, func() {}, nil
+	}
+	m := &Module{clientBuilder: cb}
+	WithNonceManagement(true)(m)
+	m.initMetrics()
+	return m
+}
+
+// mpoolMock keeps pushed messages of a single address, and fails the
+// first push of failNonce if it isn't zero.
+type mpoolMock struct {
+	lock         sync.Mutex
+	failNonce    uint64
+	failed       bool
+	pushed       []uint64
+	nonceQueries int
+}
+
+func (mp *mpoolMock) getNonce(context.Context, address.Address) (uint64, error) {
+	mp.lock.Lock()
+	defer mp.lock.Unlock()
+	mp.nonceQueries++
+	return uint64(len(mp.pushed)), nil
+}
+
+func (mp *mpoolMock) push(_ context.Context, smsg *types.SignedMessage) (cid.Cid, error) {
+	mp.lock.Lock()
+	defer mp.lock.Unlock()
+	n := smsg.Message.Nonce
+	if mp.failNonce != 0 && n == mp.failNonce && !mp.failed {
+		mp.failed = true
+		return cid.Undef, errors.New("mpool push failed")
+	}
+	if n != uint64(len(mp.pushed)) {
+		return cid.Undef, errors.New("unexpected nonce")
+	}
+	mp.pushed = append(mp.pushed, n)
+	return cid.Undef, nil
+}
+
+func (mp *mpoolMock) nonces() []uint64 {
+	mp.lock.Lock()
+	defer mp.lock.Unlock()
+	return append([]uint64(nil), mp.pushed...)
+}
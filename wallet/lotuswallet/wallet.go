@@ -37,19 +37,23 @@ type Module struct {
 	iAmount       *big.Int
 	masterAddr    address.Address
 	networkName   string
+	nonces        *nonceManager
 
 	metricCreated  metric.Int64Counter
 	metricTransfer metric.Int64ValueRecorder
 }
 
 // New creates a new wallet module.
-func New(clientBuilder lotus.ClientBuilder, maddr address.Address, iam big.Int, autocreate bool, networkName string) (*Module, error) {
+func New(clientBuilder lotus.ClientBuilder, maddr address.Address, iam big.Int, autocreate bool, networkName string, opts ...Option) (*Module, error) {
 	m := &Module{
 		clientBuilder: clientBuilder,
 		iAmount:       &iam,
 		masterAddr:    maddr,
 		networkName:   networkName,
 	}
+	for _, o := range opts {
+		o(m)
+	}
 
 	if maddr == address.Undef && autocreate {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
@@ -68,6 +72,7 @@ func New(clientBuilder lotus.ClientBuilder, maddr address.Address, iam big.Int,
 			clientBuilder: clientBuilder,
 			iAmount:       &iam,
 			masterAddr:    maddr,
+			nonces:        m.nonces,
 		}
 	}
 	m.initMetrics()
@@ -125,7 +130,7 @@ func (m *Module) NewAddress(ctx context.Context, typ string) (string, error) {
 				To:    addr,
 				Value: types.BigInt{Int: m.iAmount},
 			}
-			smsg, err := client.MpoolPushMessage(context.Background(), msg, nil)
+			smsg, err := m.pushMessage(context.Background(), client, msg)
 			if err != nil {
 				log.Errorf("transferring funds to new address: %s", err)
 				return
@@ -238,7 +243,7 @@ func (m *Module) SendFil(ctx context.Context, from string, to string, amount *bi
 	}
 	defer cls()
 
-	sm, err := client.MpoolPushMessage(ctx, msg, nil)
+	sm, err := m.pushMessage(ctx, client, msg)
 	if err != nil {
 		return cid.Cid{}, err
 	}
@@ -248,6 +253,16 @@ func (m *Module) SendFil(ctx context.Context, from string, to string, amount *bi
 	return sm.Message.Cid(), err
 }
 
+// pushMessage signs and pushes a message to the Lotus mpool. The nonce is
+// assigned by the module if nonce management is enabled, or else by the
+// Lotus node.
+func (m *Module) pushMessage(ctx context.Context, client *api.FullNodeStruct, msg *types.Message) (*types.SignedMessage, error) {
+	if m.nonces != nil {
+		return m.nonces.push(ctx, client, msg)
+	}
+	return client.MpoolPushMessage(ctx, msg, nil)
+}
+
 // FundFromFaucet make a faucet call to fund the provided wallet address.
 func (m *Module) FundFromFaucet(ctx context.Context, addr string) error {
 	faucet, ok := networkFaucet[m.networkName]